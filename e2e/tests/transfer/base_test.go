@@ -265,7 +265,7 @@ func (s *TransferTestSuite) TestSendEnabledParam() {
 
 	t.Run("change send enabled parameter to disabled", func(t *testing.T) {
 		if isSelfManagingParams {
-			msg := transfertypes.NewMsgUpdateParams(govModuleAddress.String(), transfertypes.NewParams(false, true))
+			msg := transfertypes.NewMsgUpdateParams(govModuleAddress.String(), transfertypes.NewParams(false, true, transfertypes.DefaultMaxMemoLength, transfertypes.DefaultMaxReceiverLength))
 			s.ExecuteGovProposalV1(ctx, msg, chainA, chainAWallet, 1)
 		} else {
 			changes := []paramsproposaltypes.ParamChange{
@@ -356,7 +356,7 @@ func (s *TransferTestSuite) TestReceiveEnabledParam() {
 
 	t.Run("change receive enabled parameter to disabled ", func(t *testing.T) {
 		if isSelfManagingParams {
-			msg := transfertypes.NewMsgUpdateParams(govModuleAddress.String(), transfertypes.NewParams(false, false))
+			msg := transfertypes.NewMsgUpdateParams(govModuleAddress.String(), transfertypes.NewParams(false, false, transfertypes.DefaultMaxMemoLength, transfertypes.DefaultMaxReceiverLength))
 			s.ExecuteGovProposalV1(ctx, msg, chainA, chainAWallet, 1)
 		} else {
 			changes := []paramsproposaltypes.ParamChange{
@@ -14,12 +14,14 @@ import (
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 const (
 	// The controller chain channel version
 	flagVersion               = "version"
 	flagRelativePacketTimeout = "relative-packet-timeout"
+	flagOrdering              = "ordering"
 )
 
 func newRegisterInterchainAccountCmd() *cobra.Command {
@@ -29,7 +31,8 @@ func newRegisterInterchainAccountCmd() *cobra.Command {
 		Long: strings.TrimSpace(`Register an account on the counterparty chain via the 
 connection id from the source chain. Connection identifier should be for the source chain 
 and the interchain account will be created on the counterparty chain. Callers are expected to 
-provide the appropriate application version string via {version} flag. Generates a new 
+provide the appropriate application version string via {version} flag. The channel ordering 
+may be set via {ordering} flag and defaults to ORDER_ORDERED. Generates a new 
 port identifier using the provided owner string, binds to the port identifier and claims 
 the associated capability.`),
 		Args: cobra.ExactArgs(1),
@@ -46,13 +49,24 @@ the associated capability.`),
 				return err
 			}
 
-			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version)
+			orderString, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			order, found := channeltypes.Order_value[strings.ToUpper(orderString)]
+			if !found {
+				return fmt.Errorf("invalid channel ordering: %s", orderString)
+			}
+
+			msg := types.NewMsgRegisterInterchainAccountWithOrdering(connectionID, owner, version, channeltypes.Order(order))
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	cmd.Flags().String(flagOrdering, channeltypes.ORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s, %s", channeltypes.ORDERED.String(), channeltypes.ORDERED_ALLOW_TIMEOUT.String()))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -14,7 +14,7 @@ import (
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be ORDERED or ORDERED_ALLOW_TIMEOUT, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
@@ -30,8 +30,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if !icatypes.IsSupportedOrdering(order) {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT, order)
 	}
 
 	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
@@ -71,6 +71,10 @@ func (k Keeper) OnChanOpenInit(
 			return "", errorsmod.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
+		if channel.Ordering != order {
+			return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "order cannot change when reopening a channel expected %s, got %s", channel.Ordering, order)
+		}
+
 		appVersion, found := k.GetAppVersion(ctx, portID, activeChannelID)
 		if !found {
 			panic(fmt.Sprintf("active channel mapping set for %s, but channel does not exist in channel store", activeChannelID))
@@ -92,6 +92,13 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT",
+			func() {
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
 		{
 			"invalid order - UNORDERED",
 			func() {
@@ -99,6 +106,25 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"invalid order - previous active channel has different ordering",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+				path.EndpointA.SetChannel(closedChannel)
+
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			false,
+		},
 		{
 			"invalid port ID",
 			func() {
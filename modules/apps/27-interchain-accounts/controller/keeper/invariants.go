@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// RegisterInvariants registers all interchain accounts controller invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.SubModuleName, "active-channels",
+		ActiveChannelsInvariant(k))
+}
+
+// ActiveChannelsInvariant checks that every active channel of the interchain accounts controller is an existing channel
+// on the connection of the active channel.
+func ActiveChannelsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+			channel, found := k.channelKeeper.GetChannel(ctx, activeChannel.PortId, activeChannel.ChannelId)
+			if !found || len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != activeChannel.ConnectionId {
+				broken = true
+				msg += fmt.Sprintf("\tactive channel (connection ID: %s, port ID: %s, channel ID: %s)\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.SubModuleName,
+			"active channels",
+			fmt.Sprintf("found active channel(s) which do not exist on their connection:\n%s", msg)), broken
+	}
+}
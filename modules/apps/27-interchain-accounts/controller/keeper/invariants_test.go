@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func (suite *KeeperTestSuite) TestActiveChannelsInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"fails with active channel which does not exist",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, channeltypes.FormatChannelIdentifier(100))
+			},
+			false,
+		},
+		{
+			"fails with active channel on a different connection",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), connectiontypes.FormatConnectionIdentifier(100), TestPortID, path.EndpointA.ChannelID)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			out, broken := keeper.ActiveChannelsInvariant(&suite.chainA.GetSimApp().ICAControllerKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 )
 
@@ -39,7 +40,12 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 
 	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
 
-	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version)
+	ordering := msg.Ordering
+	if ordering == channeltypes.NONE {
+		ordering = channeltypes.ORDERED
+	}
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version, ordering)
 	if err != nil {
 		s.Logger(ctx).Error("error registering interchain account", "error", err.Error())
 		return nil, err
@@ -54,7 +54,7 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 }
 
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. ORDERED_ALLOW_TIMEOUT channels remain open after a packet times out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 )
@@ -26,6 +27,14 @@ func NewMsgRegisterInterchainAccount(connectionID, owner, version string) *MsgRe
 	}
 }
 
+// NewMsgRegisterInterchainAccountWithOrdering creates a new instance of MsgRegisterInterchainAccount with the provided channel ordering
+func NewMsgRegisterInterchainAccountWithOrdering(connectionID, owner, version string, ordering channeltypes.Order) *MsgRegisterInterchainAccount {
+	msg := NewMsgRegisterInterchainAccount(connectionID, owner, version)
+	msg.Ordering = ordering
+
+	return msg
+}
+
 // ValidateBasic implements sdk.Msg
 func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
@@ -36,6 +45,10 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
 	}
 
+	if msg.Ordering != channeltypes.NONE && !icatypes.IsSupportedOrdering(msg.Ordering) {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT, msg.Ordering)
+	}
+
 	return nil
 }
 
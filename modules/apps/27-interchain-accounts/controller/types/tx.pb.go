@@ -10,7 +10,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
-	types "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	types1 "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	types "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -32,9 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgRegisterInterchainAccount defines the payload for Msg/RegisterAccount
 type MsgRegisterInterchainAccount struct {
-	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	Version      string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Owner        string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Version      string      `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Ordering     types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -125,9 +127,9 @@ func (m *MsgRegisterInterchainAccountResponse) GetPortId() string {
 
 // MsgSendTx defines the payload for Msg/SendTx
 type MsgSendTx struct {
-	Owner        string                            `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	ConnectionId string                            `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	PacketData   types.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	Owner        string                             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string                             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PacketData   types1.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
@@ -319,47 +321,49 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xde, 0x91, 0x52, 0xec, 0x80, 0xa2, 0x1b, 0x12, 0xca, 0x06, 0x17, 0x52, 0x3d, 0x20, 0x09,
-	0x3b, 0x69, 0x35, 0x21, 0xc1, 0x78, 0x10, 0xf0, 0xd0, 0x98, 0x26, 0xcd, 0x8a, 0x09, 0xf1, 0x60,
-	0x33, 0x9d, 0x9d, 0x6c, 0x47, 0x76, 0x67, 0xd6, 0x9d, 0xd9, 0x15, 0x6e, 0xc6, 0x8b, 0xc6, 0x83,
-	0xf1, 0xea, 0x8d, 0x3f, 0x81, 0xff, 0x42, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x07, 0x3c, 0xfb, 0x17,
-	0x98, 0xfd, 0xd1, 0x2d, 0x2a, 0x12, 0x2c, 0xdc, 0xfa, 0xde, 0xeb, 0xf7, 0xbd, 0xef, 0x7b, 0xf3,
-	0xf6, 0xc1, 0x07, 0xac, 0x4b, 0x10, 0x0e, 0x02, 0x8f, 0x11, 0xac, 0x98, 0xe0, 0x12, 0x31, 0xae,
-	0x68, 0x48, 0x7a, 0x98, 0xf1, 0x0e, 0x26, 0x44, 0x44, 0x5c, 0x49, 0x44, 0x04, 0x57, 0xa1, 0xf0,
-	0x3c, 0x1a, 0xa2, 0xb8, 0x8e, 0xd4, 0xb6, 0x15, 0x84, 0x42, 0x09, 0xbd, 0xc1, 0xba, 0xc4, 0x3a,
-	0x09, 0xb6, 0x4e, 0x01, 0x5b, 0x03, 0xb0, 0x15, 0xd7, 0x8d, 0x29, 0x57, 0xb8, 0x22, 0x85, 0xa3,
-	0xe4, 0x57, 0xc6, 0x64, 0xdc, 0x3f, 0x97, 0x8c, 0xb8, 0x8e, 0x02, 0x4c, 0xb6, 0xa8, 0xca, 0x51,
-	0x6b, 0x43, 0x88, 0x3f, 0xa1, 0x26, 0x23, 0x99, 0x26, 0x42, 0xfa, 0x42, 0x22, 0x5f, 0xba, 0x49,
-	0xdd, 0x97, 0x6e, 0x56, 0xa8, 0xbd, 0x03, 0x70, 0xb6, 0x25, 0x5d, 0x9b, 0xba, 0x4c, 0x2a, 0x1a,
-	0x36, 0x0b, 0xea, 0x47, 0x19, 0xb3, 0x3e, 0x05, 0x47, 0xc5, 0x6b, 0x4e, 0xc3, 0x2a, 0x98, 0x07,
-	0x0b, 0x15, 0x3b, 0x0b, 0xf4, 0xdb, 0xf0, 0x1a, 0x11, 0x9c, 0x53, 0x92, 0x28, 0xea, 0x30, 0xa7,
-	0x7a, 0x25, 0xad, 0x4e, 0x0c, 0x92, 0x4d, 0x47, 0xaf, 0xc2, 0xb1, 0x98, 0x86, 0x92, 0x09, 0x5e,
-	0x1d, 0x49, 0xcb, 0xfd, 0x70, 0x45, 0x7f, 0xbf, 0x3b, 0xa7, 0xfd, 0xd8, 0x9d, 0xd3, 0xde, 0x1e,
-	0xef, 0x2d, 0x66, 0x94, 0xb5, 0x17, 0xf0, 0xce, 0x59, 0x42, 0x6c, 0x2a, 0x03, 0xc1, 0x25, 0xd5,
-	0x6f, 0x41, 0x48, 0x7a, 0x98, 0x73, 0xea, 0x25, 0x7d, 0x33, 0x55, 0x95, 0x3c, 0xd3, 0x74, 0xf4,
-	0x69, 0x38, 0x16, 0x88, 0x50, 0x0d, 0x34, 0x95, 0x93, 0xb0, 0xe9, 0xd4, 0x7e, 0x02, 0x58, 0x69,
-	0x49, 0xf7, 0x29, 0xe5, 0xce, 0xc6, 0xf6, 0x45, 0x6c, 0x6d, 0xc1, 0xf1, 0xec, 0x81, 0x3a, 0x0e,
-	0x56, 0x38, 0xb5, 0x36, 0xde, 0x58, 0xb7, 0xce, 0xb5, 0x26, 0x71, 0xdd, 0xfa, 0xcb, 0x59, 0x3b,
-	0x25, 0x5b, 0xc7, 0x0a, 0xaf, 0x96, 0xf6, 0xbf, 0xcd, 0x69, 0x36, 0x0c, 0x8a, 0x8c, 0x7e, 0x17,
-	0xde, 0x08, 0xa9, 0x87, 0x15, 0x8b, 0x69, 0x47, 0x31, 0x9f, 0x8a, 0x48, 0x55, 0x4b, 0xf3, 0x60,
-	0xa1, 0x64, 0x4f, 0xf6, 0xf3, 0x1b, 0x59, 0xfa, 0xd4, 0xa1, 0x22, 0x78, 0xb3, 0xf0, 0x5c, 0x4c,
-	0xd0, 0x80, 0x57, 0x25, 0x7d, 0x15, 0x51, 0x4e, 0x68, 0x6a, 0xbf, 0x64, 0x17, 0x71, 0xed, 0x33,
-	0x80, 0x93, 0x2d, 0xe9, 0x3e, 0x0b, 0x1c, 0xac, 0x68, 0x1b, 0x87, 0xd8, 0x97, 0xfa, 0x2c, 0xac,
-	0xe0, 0x48, 0xf5, 0x44, 0xc8, 0xd4, 0x4e, 0x7f, 0xe0, 0x45, 0x42, 0xdf, 0x84, 0xe5, 0x20, 0xfd,
-	0x5f, 0x3a, 0xac, 0xf1, 0xc6, 0x8a, 0xf5, 0xff, 0x1f, 0x8c, 0x95, 0x75, 0xca, 0xfd, 0xe7, 0x7c,
-	0x2b, 0xd7, 0x13, 0x23, 0x83, 0x4e, 0xb5, 0x19, 0x38, 0xfd, 0x87, 0xb4, 0xbe, 0xa5, 0xc6, 0x87,
-	0x12, 0x1c, 0x69, 0x49, 0x57, 0xff, 0x02, 0xe0, 0xcc, 0xbf, 0x77, 0xb9, 0x3d, 0x8c, 0xb4, 0xb3,
-	0x96, 0xd2, 0xd8, 0xbc, 0x6c, 0xc6, 0xe2, 0x91, 0x3e, 0x02, 0x58, 0xce, 0x77, 0xf5, 0xe1, 0x90,
-	0x4d, 0x32, 0xb8, 0xf1, 0xf8, 0x42, 0xf0, 0x42, 0xd0, 0x2e, 0x80, 0x13, 0xbf, 0xad, 0xc5, 0xda,
-	0x90, 0xbc, 0x27, 0x49, 0x8c, 0x27, 0x97, 0x40, 0xd2, 0x97, 0x68, 0x8c, 0xbe, 0x39, 0xde, 0x5b,
-	0x04, 0xab, 0x2f, 0xf7, 0x0f, 0x4d, 0x70, 0x70, 0x68, 0x82, 0xef, 0x87, 0x26, 0xf8, 0x74, 0x64,
-	0x6a, 0x07, 0x47, 0xa6, 0xf6, 0xf5, 0xc8, 0xd4, 0x9e, 0xb7, 0x5d, 0xa6, 0x7a, 0x51, 0xd7, 0x22,
-	0xc2, 0x47, 0xf9, 0x45, 0x64, 0x5d, 0xb2, 0xe4, 0x0a, 0x14, 0x2f, 0x23, 0x5f, 0x38, 0x91, 0x47,
-	0x65, 0x72, 0x6b, 0x25, 0x6a, 0x2c, 0x2f, 0x0d, 0x74, 0x2c, 0x9d, 0x76, 0x66, 0xd5, 0x4e, 0x40,
-	0x65, 0xb7, 0x9c, 0x9e, 0xd1, 0x7b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x19, 0x0f, 0x13,
-	0x63, 0x06, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xfc, 0x28, 0x85, 0x0e, 0xfc, 0x40, 0x37, 0x24, 0x94, 0x0d, 0x16, 0xac, 0x1e, 0x90,
+	0x84, 0x99, 0xb4, 0x1a, 0x49, 0x30, 0x1e, 0x04, 0x3c, 0x34, 0xa6, 0xb1, 0xa9, 0x98, 0x10, 0x0f,
+	0x36, 0xd3, 0xd9, 0xc9, 0x76, 0xa4, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xb8, 0x19, 0x4f, 0xc6, 0x83,
+	0xf1, 0xea, 0x8d, 0x8f, 0xc0, 0x37, 0xf0, 0x28, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x03, 0x9e, 0xfd,
+	0x04, 0x66, 0xff, 0x74, 0x8b, 0x8a, 0x04, 0x0b, 0xb7, 0x7d, 0xdf, 0x99, 0xe7, 0x79, 0x9f, 0xe7,
+	0x9d, 0x77, 0x5f, 0x78, 0x8f, 0xb7, 0x28, 0x26, 0xbe, 0xdf, 0xe1, 0x94, 0x18, 0x2e, 0x85, 0xc6,
+	0x5c, 0x18, 0xa6, 0x68, 0x9b, 0x70, 0xd1, 0x24, 0x94, 0xca, 0xae, 0x30, 0x1a, 0x53, 0x29, 0x8c,
+	0x92, 0x9d, 0x0e, 0x53, 0x38, 0x28, 0x63, 0xb3, 0x8d, 0x7c, 0x25, 0x8d, 0xb4, 0x2a, 0xbc, 0x45,
+	0xd1, 0x49, 0x30, 0x3a, 0x05, 0x8c, 0xfa, 0x60, 0x14, 0x94, 0xed, 0x29, 0x57, 0xba, 0x32, 0x82,
+	0xe3, 0xf0, 0x2b, 0x66, 0xb2, 0xef, 0x9c, 0x4b, 0x46, 0x50, 0xc6, 0x3e, 0xa1, 0x5b, 0xcc, 0x24,
+	0xa8, 0xb5, 0x01, 0xc4, 0xf7, 0xa3, 0x84, 0x64, 0x9a, 0x4a, 0xed, 0x49, 0x8d, 0x3d, 0xed, 0x86,
+	0xe7, 0x9e, 0x76, 0x93, 0x83, 0xeb, 0x21, 0x3b, 0x95, 0x8a, 0x61, 0xda, 0x26, 0x42, 0xb0, 0x4e,
+	0x04, 0x8f, 0x3f, 0xe3, 0x2b, 0xa5, 0x4f, 0x00, 0xce, 0xd6, 0xb4, 0xdb, 0x60, 0x2e, 0xd7, 0x86,
+	0xa9, 0x6a, 0x5a, 0xfd, 0x41, 0x5c, 0xdc, 0x9a, 0x82, 0xc3, 0xf2, 0x95, 0x60, 0xaa, 0x00, 0xe6,
+	0xc1, 0x42, 0xbe, 0x11, 0x07, 0xd6, 0x0d, 0xf8, 0x3f, 0x95, 0x42, 0x30, 0x1a, 0x8a, 0x6e, 0x72,
+	0xa7, 0xf0, 0x5f, 0x74, 0x3a, 0xde, 0x4f, 0x56, 0x1d, 0xab, 0x00, 0x47, 0x02, 0xa6, 0x34, 0x97,
+	0xa2, 0x30, 0x14, 0x1d, 0xf7, 0x42, 0xeb, 0x2e, 0x1c, 0x95, 0xca, 0x61, 0x8a, 0x0b, 0xb7, 0x90,
+	0x9d, 0x07, 0x0b, 0x13, 0x15, 0x1b, 0x85, 0x2f, 0x11, 0x6a, 0x45, 0x3d, 0x81, 0x41, 0x19, 0x3d,
+	0x0e, 0x2f, 0x35, 0xd2, 0xbb, 0x2b, 0xd6, 0xdb, 0xdd, 0xb9, 0xcc, 0xf7, 0xdd, 0xb9, 0xcc, 0x9b,
+	0xe3, 0xbd, 0xc5, 0x58, 0x4a, 0xe9, 0x39, 0xbc, 0x79, 0x96, 0x81, 0x06, 0xd3, 0xbe, 0x14, 0x9a,
+	0x59, 0xd7, 0x20, 0x4c, 0x98, 0x43, 0xbd, 0xb1, 0x9b, 0x7c, 0x92, 0xa9, 0x3a, 0xd6, 0x34, 0x1c,
+	0xf1, 0xa5, 0x32, 0x7d, 0x2f, 0xb9, 0x30, 0xac, 0x3a, 0xa5, 0x1f, 0x00, 0xe6, 0x6b, 0xda, 0x7d,
+	0xc2, 0x84, 0xb3, 0xb1, 0x7d, 0x91, 0x76, 0x6c, 0xc1, 0xb1, 0xf8, 0xed, 0x9b, 0x0e, 0x31, 0x24,
+	0x6a, 0xc9, 0x58, 0x65, 0x1d, 0x9d, 0x6b, 0x02, 0x83, 0x32, 0xfa, 0xc3, 0x59, 0x3d, 0x22, 0x5b,
+	0x27, 0x86, 0xac, 0x66, 0xf7, 0xbf, 0xce, 0x65, 0x1a, 0xd0, 0x4f, 0x33, 0xd6, 0x2d, 0x78, 0x45,
+	0xb1, 0x0e, 0x31, 0x3c, 0x60, 0x4d, 0xc3, 0x3d, 0x26, 0xbb, 0x26, 0xea, 0x74, 0xb6, 0x31, 0xd9,
+	0xcb, 0x6f, 0xc4, 0xe9, 0x53, 0x9b, 0x8a, 0xe1, 0xd5, 0xd4, 0x73, 0xda, 0x41, 0x1b, 0x8e, 0x6a,
+	0xf6, 0xb2, 0xcb, 0x04, 0x65, 0x91, 0xfd, 0x6c, 0x23, 0x8d, 0x4b, 0x1f, 0x01, 0x9c, 0xac, 0x69,
+	0xf7, 0xa9, 0xef, 0x10, 0xc3, 0xea, 0x44, 0x11, 0x4f, 0x5b, 0xb3, 0x30, 0x4f, 0xba, 0xa6, 0x2d,
+	0x15, 0x37, 0x3b, 0xbd, 0x86, 0xa7, 0x09, 0x6b, 0x13, 0xe6, 0xfc, 0xe8, 0x5e, 0xd4, 0xac, 0xb1,
+	0xca, 0x0a, 0xfa, 0xf7, 0x7f, 0x11, 0xc5, 0x95, 0x12, 0xff, 0x09, 0xdf, 0xca, 0x44, 0x68, 0xa4,
+	0x5f, 0xa9, 0x34, 0x03, 0xa7, 0x7f, 0x93, 0xd6, 0xb3, 0x54, 0x79, 0x97, 0x85, 0x43, 0x35, 0xed,
+	0x5a, 0x9f, 0x01, 0x9c, 0xf9, 0xfb, 0x3f, 0x50, 0x1f, 0x44, 0xda, 0x59, 0x43, 0x69, 0x6f, 0x5e,
+	0x36, 0x63, 0xfa, 0x48, 0xef, 0x01, 0xcc, 0x25, 0xb3, 0x7a, 0x7f, 0xc0, 0x22, 0x31, 0xdc, 0x7e,
+	0x78, 0x21, 0x78, 0x2a, 0x68, 0x17, 0xc0, 0xf1, 0x5f, 0xc6, 0x62, 0x6d, 0x40, 0xde, 0x93, 0x24,
+	0xf6, 0xa3, 0x4b, 0x20, 0xe9, 0x49, 0xb4, 0x87, 0x5f, 0x1f, 0xef, 0x2d, 0x82, 0xd5, 0x17, 0xfb,
+	0x87, 0x45, 0x70, 0x70, 0x58, 0x04, 0xdf, 0x0e, 0x8b, 0xe0, 0xc3, 0x51, 0x31, 0x73, 0x70, 0x54,
+	0xcc, 0x7c, 0x39, 0x2a, 0x66, 0x9e, 0xd5, 0x5d, 0x6e, 0xda, 0xdd, 0x16, 0xa2, 0xd2, 0xc3, 0xc9,
+	0xb2, 0xe5, 0x2d, 0xba, 0xe4, 0x4a, 0x1c, 0x2c, 0x63, 0x4f, 0x3a, 0xdd, 0x0e, 0xd3, 0xe1, 0x1a,
+	0xd7, 0xb8, 0xb2, 0xbc, 0xd4, 0xd7, 0xb1, 0x74, 0xda, 0x06, 0x37, 0x3b, 0x3e, 0xd3, 0xad, 0x5c,
+	0xb4, 0x7e, 0x6f, 0xff, 0x1c, 0x00, 0xfb, 0xe6, 0xb5, 0x42, 0xbe, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -540,6 +544,11 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.Ordering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -773,6 +782,9 @@ func (m *MsgRegisterInterchainAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
 	return n
 }
 
@@ -982,6 +994,25 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17,6 +17,9 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdQueryInterchainAccounts(),
+		GetCmdQueryInterchainAccount(),
+		GetCmdQueryActiveChannels(),
 		GetCmdPacketEvents(),
 	)
 
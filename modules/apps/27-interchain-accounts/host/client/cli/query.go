@@ -49,6 +49,119 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryInterchainAccounts returns the command handler for querying all the interchain accounts registered on the host chain.
+func GetCmdQueryInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all the interchain accounts registered on the host chain",
+		Long:    "Query the host submodule for all the interchain accounts and their associated connection and controller port",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccount returns the command handler for querying the interchain account address
+// for a given connection and controller port.
+func GetCmdQueryInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [connection-id] [controller-port-id]",
+		Short:   "Query the interchain account address for a given controller port on a particular connection",
+		Long:    "Query the host submodule for the interchain account address for a given controller port on a particular host connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryActiveChannels returns the command handler for querying all the active channels of the host chain.
+func GetCmdQueryActiveChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channels",
+		Short:   "Query all the active channels of the host chain",
+		Long:    "Query the host submodule for all the active channels, their associated connection and controller port and their channel state",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host active-channels", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryActiveChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActiveChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "active channels")
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
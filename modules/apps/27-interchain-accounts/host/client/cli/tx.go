@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	memoFlag string = "memo"
+	memoFlag     string = "memo"
+	encodingFlag string = "encoding"
 )
 
 func generatePacketDataCmd() *cobra.Command {
@@ -37,7 +38,7 @@ which submits pre-built packet data containing messages to be executed on the ho
             "amount": "1000"
         }
     ]
-}' --memo memo
+}' --memo memo --encoding proto3json
 
 
 %s tx interchain-accounts host generate-packet-data '[{
@@ -74,7 +75,12 @@ which submits pre-built packet data containing messages to be executed on the ho
 				return err
 			}
 
-			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo)
+			encoding, err := cmd.Flags().GetString(encodingFlag)
+			if err != nil {
+				return err
+			}
+
+			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding)
 			if err != nil {
 				return err
 			}
@@ -86,18 +92,19 @@ which submits pre-built packet data containing messages to be executed on the ho
 	}
 
 	cmd.Flags().String(memoFlag, "", "an optional memo to be included in the interchain account packet data")
+	cmd.Flags().String(encodingFlag, icatypes.EncodingProtobuf, fmt.Sprintf("the encoding format used to serialize the messages, must match the channel encoding (%s or %s)", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON))
 	return cmd
 }
 
-// generatePacketData takes in message bytes and a memo and serializes the message into an
+// generatePacketData takes in message bytes, a memo and an encoding and serializes the message into an
 // instance of InterchainAccountPacketData which is returned as bytes.
-func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string) ([]byte, error) {
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo, encoding string) ([]byte, error) {
 	protoMessages, err := convertBytesIntoProtoMessages(cdc, msgBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo)
+	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding)
 }
 
 // convertBytesIntoProtoMessages returns a list of proto messages from bytes. The bytes can be in the form of a single
@@ -128,9 +135,9 @@ func convertBytesIntoProtoMessages(cdc *codec.ProtoCodec, msgBytes []byte) ([]pr
 	return sdkMessages, nil
 }
 
-// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages and a memo.
-func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string) ([]byte, error) {
-	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages)
+// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages, a memo and an encoding.
+func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo, encoding string) ([]byte, error) {
+	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -101,43 +101,46 @@ func TestGeneratePacketData(t *testing.T) {
 		},
 	}
 
-	for _, tc := range tests {
-		tc := tc
-		ir := codectypes.NewInterfaceRegistry()
-		if tc.registerInterfaceFn != nil {
-			tc.registerInterfaceFn(ir)
-		}
+	encodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+	for _, encoding := range encodings {
+		for _, tc := range tests {
+			tc := tc
+			ir := codectypes.NewInterfaceRegistry()
+			if tc.registerInterfaceFn != nil {
+				tc.registerInterfaceFn(ir)
+			}
 
-		cdc := codec.NewProtoCodec(ir)
+			cdc := codec.NewProtoCodec(ir)
 
-		t.Run(tc.name, func(t *testing.T) {
-			bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo)
+			t.Run(fmt.Sprintf("%s with %s encoding", tc.name, encoding), func(t *testing.T) {
+				bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, encoding)
 
-			if tc.expectedPass {
-				require.NoError(t, err)
-				require.NotNil(t, bz)
+				if tc.expectedPass {
+					require.NoError(t, err)
+					require.NotNil(t, bz)
 
-				packetData := icatypes.InterchainAccountPacketData{}
-				err = cdc.UnmarshalJSON(bz, &packetData)
-				require.NoError(t, err)
+					packetData := icatypes.InterchainAccountPacketData{}
+					err = cdc.UnmarshalJSON(bz, &packetData)
+					require.NoError(t, err)
 
-				require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
-				require.Equal(t, tc.memo, packetData.Memo)
+					require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+					require.Equal(t, tc.memo, packetData.Memo)
 
-				data := packetData.Data
-				messages, err := icatypes.DeserializeCosmosTx(cdc, data)
+					data := packetData.Data
+					messages, err := icatypes.DeserializeCosmosTx(cdc, data, encoding)
 
-				require.NoError(t, err)
-				require.NotNil(t, messages)
+					require.NoError(t, err)
+					require.NotNil(t, messages)
 
-				if tc.assertionFn != nil {
-					tc.assertionFn(t, messages)
+					if tc.assertionFn != nil {
+						tc.assertionFn(t, messages)
+					}
+				} else {
+					require.Error(t, err)
+					require.Nil(t, bz)
 				}
-			} else {
-				require.Error(t, err)
-				require.Nil(t, bz)
-			}
-		})
+			})
+		}
 	}
 }
 
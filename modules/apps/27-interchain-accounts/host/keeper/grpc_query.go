@@ -2,10 +2,22 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -19,3 +31,117 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var interchainAccounts []types.RegisteredInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", icatypes.OwnerKeyPrefix)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, connectionID, err := parsePortConnectionKey(key)
+		if err != nil {
+			return err
+		}
+
+		interchainAccounts = append(interchainAccounts, types.RegisteredInterchainAccount{
+			ConnectionId:   connectionID,
+			PortId:         portID,
+			AccountAddress: string(value),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (k Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: addr,
+	}, nil
+}
+
+// ActiveChannels implements the Query/ActiveChannels gRPC method
+func (k Keeper) ActiveChannels(c context.Context, req *types.QueryActiveChannelsRequest) (*types.QueryActiveChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var activeChannels []types.ActiveChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", icatypes.ActiveChannelKeyPrefix)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, connectionID, err := parsePortConnectionKey(key)
+		if err != nil {
+			return err
+		}
+
+		activeChannel := types.ActiveChannel{
+			ConnectionId: connectionID,
+			PortId:       portID,
+			ChannelId:    string(value),
+		}
+
+		// the channel is stored under the host port on the host chain
+		if channel, found := k.channelKeeper.GetChannel(ctx, icatypes.HostPortID, activeChannel.ChannelId); found {
+			activeChannel.State = channel.State
+		}
+
+		activeChannels = append(activeChannels, activeChannel)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActiveChannelsResponse{
+		ActiveChannels: activeChannels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// parsePortConnectionKey parses a store key of the format {portID}/{connectionID},
+// as used by the active channel and interchain account store prefixes.
+func parsePortConnectionKey(key []byte) (string, string, error) {
+	keySplit := strings.Split(string(key), "/")
+	if len(keySplit) != 2 {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to parse key: %s", key)
+	}
+
+	return keySplit[0], keySplit[1], nil
+}
@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +15,190 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var req *types.QueryInterchainAccountsRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expLen   int
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			0,
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryInterchainAccountsRequest{}
+			},
+			3,
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req = &types.QueryInterchainAccountsRequest{
+					Pagination: &query.PageRequest{
+						Limit: 2,
+					},
+				}
+			},
+			2,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			for i, connectionID := range []string{"connection-0", "connection-1", "connection-2"} {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, TestPortID, ibctesting.TestAccAddress)
+				suite.Require().Equal(i+1, len(suite.chainB.GetSimApp().ICAHostKeeper.GetAllInterchainAccounts(suite.chainB.GetContext())))
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.InterchainAccounts, tc.expLen)
+				suite.Require().Equal(types.RegisteredInterchainAccount{
+					ConnectionId:   "connection-0",
+					PortId:         TestPortID,
+					AccountAddress: ibctesting.TestAccAddress,
+				}, res.InterchainAccounts[0])
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.ConnectionId = "connection-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddress, res.Address)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expActiveChannel := types.ActiveChannel{
+		ConnectionId: path.EndpointB.ConnectionID,
+		PortId:       path.EndpointA.ChannelConfig.PortID,
+		ChannelId:    path.EndpointB.ChannelID,
+		State:        channeltypes.OPEN,
+	}
+	suite.Require().Equal([]types.ActiveChannel{expActiveChannel}, res.ActiveChannels)
+
+	// the channel state is returned alongside the active channel
+	err = path.EndpointB.SetChannelState(channeltypes.CLOSED)
+	suite.Require().NoError(err)
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(sdk.WrapSDKContext(suite.chainB.GetContext()), &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expActiveChannel.State = channeltypes.CLOSED
+	suite.Require().Equal([]types.ActiveChannel{expActiveChannel}, res.ActiveChannels)
+
+	// empty request
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(ctx, nil)
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+}
@@ -27,8 +27,8 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if !icatypes.IsSupportedOrdering(order) {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT, order)
 	}
 
 	if portID != icatypes.HostPortID {
@@ -55,6 +55,10 @@ func (k Keeper) OnChanOpenTry(
 			return "", errorsmod.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
+		if channel.Ordering != order {
+			return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "order cannot change when reopening a channel expected %s, got %s", channel.Ordering, order)
+		}
+
 		appVersion, found := k.GetAppVersion(ctx, portID, activeChannelID)
 		if !found {
 			panic(fmt.Sprintf("active channel mapping set for %s, but channel does not exist in channel store", activeChannelID))
@@ -159,6 +159,13 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				path.EndpointB.SetChannel(*channel)
 			}, false,
 		},
+		{
+			"success - ORDERED_ALLOW_TIMEOUT",
+			func() {
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
 		{
 			"invalid order - UNORDERED",
 			func() {
@@ -166,6 +173,20 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"invalid order - reopening closed active channel with different ordering",
+			func() {
+				// undo setup
+				path.EndpointB.ChannelID = ""
+				err := suite.chainB.App.GetScopedIBCKeeper().ReleaseCapability(suite.chainB.GetContext(), chanCap)
+				suite.Require().NoError(err)
+
+				suite.openAndCloseChannel(path)
+
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			false,
+		},
 		{
 			"invalid port ID",
 			func() {
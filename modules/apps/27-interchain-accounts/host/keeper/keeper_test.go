@@ -232,10 +232,10 @@ func (suite *KeeperTestSuite) TestParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: non-default params", types.NewParams(!types.DefaultHostEnabled, []string{"/cosmos.staking.v1beta1.MsgDelegate"}), true},
-		{"success: set empty byte for allow messages", types.NewParams(true, nil), true},
-		{"failure: set empty string for allow messages", types.NewParams(true, []string{""}), false},
-		{"failure: set space string for allow messages", types.NewParams(true, []string{" "}), false},
+		{"success: non-default params", types.NewParams(!types.DefaultHostEnabled, []string{"/cosmos.staking.v1beta1.MsgDelegate"}, nil), true},
+		{"success: set empty byte for allow messages", types.NewParams(true, nil, nil), true},
+		{"failure: set empty string for allow messages", types.NewParams(true, []string{""}, nil), false},
+		{"failure: set space string for allow messages", types.NewParams(true, []string{" "}, nil), false},
 	}
 
 	for _, tc := range testCases {
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,3 +36,40 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ModuleQuerySafe routes the queries to the keeper's query router if they are module_query_safe
+// and allowed by the host submodule's params.
+func (m msgServer) ModuleQuerySafe(goCtx context.Context, msg *types.MsgModuleQuerySafe) (*types.MsgModuleQuerySafeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowQueries := m.GetParams(ctx).AllowQueries
+
+	responses := make([][]byte, len(msg.Requests))
+	for i, query := range msg.Requests {
+		if !m.isModuleQuerySafe(query.Path) {
+			return nil, errorsmod.Wrapf(types.ErrQueryNotAllowed, "not module query safe: %s", query.Path)
+		}
+
+		if !types.ContainsQueryPath(allowQueries, query.Path) {
+			return nil, errorsmod.Wrapf(types.ErrQueryNotAllowed, "query path not allowed: %s", query.Path)
+		}
+
+		route := m.queryRouter.Route(query.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", query.Path)
+		}
+
+		res, err := route(ctx, abci.RequestQuery{
+			Path: query.Path,
+			Data: query.Data,
+		})
+		if err != nil {
+			m.Logger(ctx).Debug("query failed", "path", query.Path, "error", err)
+			return nil, err
+		}
+
+		responses[i] = res.Value
+	}
+
+	return &types.MsgModuleQuerySafeResponse{Responses: responses, Height: uint64(ctx.BlockHeight())}, nil
+}
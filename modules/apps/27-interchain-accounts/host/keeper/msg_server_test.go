@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
 )
@@ -43,3 +47,128 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestModuleQuerySafe() {
+	var (
+		msg          *types.MsgModuleQuerySafe
+		allowQueries []string
+		expResponses [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				balanceQueryBz, err := banktypes.NewQueryBalanceRequest(suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom).Marshal()
+				suite.Require().NoError(err)
+
+				msg.Requests = []types.QueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: balanceQueryBz,
+					},
+				}
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				expResponse, err := (&banktypes.QueryBalanceResponse{Balance: &balance}).Marshal()
+				suite.Require().NoError(err)
+
+				expResponses = [][]byte{expResponse}
+			},
+			nil,
+		},
+		{
+			"success: multiple queries using the * (allow all module safe queries) param",
+			func() {
+				balanceQueryBz, err := banktypes.NewQueryBalanceRequest(suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom).Marshal()
+				suite.Require().NoError(err)
+
+				paramsQueryBz, err := (&stakingtypes.QueryParamsRequest{}).Marshal()
+				suite.Require().NoError(err)
+
+				msg.Requests = []types.QueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: balanceQueryBz,
+					},
+					{
+						Path: "/cosmos.staking.v1beta1.Query/Params",
+						Data: paramsQueryBz,
+					},
+				}
+				allowQueries = []string{types.AllowAllHostQueries}
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				expBalanceResponse, err := (&banktypes.QueryBalanceResponse{Balance: &balance}).Marshal()
+				suite.Require().NoError(err)
+
+				expParamsResponse, err := (&stakingtypes.QueryParamsResponse{Params: suite.chainA.GetSimApp().StakingKeeper.GetParams(suite.chainA.GetContext())}).Marshal()
+				suite.Require().NoError(err)
+
+				expResponses = [][]byte{expBalanceResponse, expParamsResponse}
+			},
+			nil,
+		},
+		{
+			"failure: query path not allowed by params",
+			func() {
+				allowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+			},
+			types.ErrQueryNotAllowed,
+		},
+		{
+			"failure: query is not module safe",
+			func() {
+				msg.Requests = []types.QueryRequest{
+					{
+						Path: "/ibc.core.client.v1.Query/ClientParams",
+						Data: []byte{},
+					},
+				}
+				allowQueries = []string{types.AllowAllHostQueries}
+			},
+			types.ErrQueryNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			balanceQueryBz, err := banktypes.NewQueryBalanceRequest(suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom).Marshal()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgModuleQuerySafe(suite.chainA.SenderAccount.GetAddress().String(), []types.QueryRequest{
+				{
+					Path: "/cosmos.bank.v1beta1.Query/Balance",
+					Data: balanceQueryBz,
+				},
+			})
+			allowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, types.NewParams(true, []string{types.AllowAllHostMsgs}, allowQueries))
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.ModuleQuerySafe(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(ctx.BlockHeight()), res.Height)
+				suite.Require().Equal(expResponses, res.Responses)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
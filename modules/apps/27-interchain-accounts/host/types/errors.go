@@ -7,4 +7,5 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrQueryNotAllowed       = errorsmod.Register(SubModuleName, 3, "query not allowed")
 )
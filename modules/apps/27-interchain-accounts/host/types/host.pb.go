@@ -29,6 +29,8 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// allow_queries defines a list of module safe gRPC query paths allowed to be executed on a host chain.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,8 +80,74 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+// QueryRequest defines the parameters for a particular query request
+// by an interchain account.
+type QueryRequest struct {
+	// path defines the path of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the payload of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
 func init() {
@@ -87,23 +155,26 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xcf, 0xbf, 0x4a, 0xc5, 0x30,
-	0x14, 0xc7, 0xf1, 0x46, 0xe1, 0xa2, 0xf5, 0xcf, 0xd0, 0xe9, 0x4e, 0xe1, 0x2a, 0x08, 0x77, 0xb0,
-	0x09, 0x57, 0x87, 0xee, 0x82, 0x8b, 0x20, 0x48, 0x47, 0x97, 0x92, 0xa4, 0xa1, 0x0d, 0x24, 0x39,
-	0xa5, 0x27, 0xad, 0xf8, 0x16, 0x3e, 0x96, 0x63, 0x47, 0x47, 0x69, 0x5f, 0x44, 0x5a, 0x05, 0x15,
-	0xee, 0x74, 0xe0, 0x03, 0x3f, 0x38, 0xdf, 0x38, 0x33, 0x52, 0x71, 0xd1, 0x34, 0xd6, 0x28, 0x11,
-	0x0c, 0x78, 0xe4, 0xc6, 0x07, 0xdd, 0xaa, 0x5a, 0x18, 0x5f, 0x08, 0xa5, 0xa0, 0xf3, 0x01, 0x79,
-	0x0d, 0x18, 0x78, 0xbf, 0x5b, 0x2e, 0x6b, 0x5a, 0x08, 0x90, 0x5c, 0x1b, 0xa9, 0xd8, 0xdf, 0x21,
-	0xdb, 0x33, 0x64, 0xcb, 0xa0, 0xdf, 0x5d, 0xe6, 0xf1, 0xea, 0x49, 0xb4, 0xc2, 0x61, 0x72, 0x11,
-	0x9f, 0xce, 0x58, 0x68, 0x2f, 0xa4, 0xd5, 0xe5, 0x9a, 0x6c, 0xc8, 0xf6, 0x28, 0x3f, 0x99, 0xed,
-	0xfe, 0x9b, 0x92, 0xab, 0xf8, 0x5c, 0x58, 0x0b, 0x2f, 0x85, 0xd3, 0x88, 0xa2, 0xd2, 0xb8, 0x3e,
-	0xd8, 0x1c, 0x6e, 0x8f, 0xf3, 0xb3, 0x45, 0x1f, 0x7f, 0xf0, 0xae, 0x7c, 0x1f, 0x29, 0x19, 0x46,
-	0x4a, 0x3e, 0x47, 0x4a, 0xde, 0x26, 0x1a, 0x0d, 0x13, 0x8d, 0x3e, 0x26, 0x1a, 0x3d, 0x3f, 0x54,
-	0x26, 0xd4, 0x9d, 0x64, 0x0a, 0x1c, 0x57, 0x80, 0x0e, 0x90, 0x1b, 0xa9, 0xd2, 0x0a, 0x78, 0x9f,
-	0x71, 0x07, 0x65, 0x67, 0x35, 0xce, 0xd1, 0xc8, 0x6f, 0xb2, 0xf4, 0xf7, 0xed, 0xf4, 0x7f, 0x6f,
-	0x78, 0x6d, 0x34, 0xca, 0xd5, 0x92, 0x7b, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xb5, 0x3b,
-	0x6b, 0x29, 0x01, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x41, 0x4b, 0xfb, 0x30,
+	0x18, 0x06, 0xf0, 0x65, 0xfb, 0x33, 0xfe, 0x8b, 0xd5, 0x43, 0x4e, 0x3d, 0x85, 0x39, 0x11, 0x76,
+	0x70, 0x0d, 0x53, 0x70, 0x77, 0xc1, 0x8b, 0x20, 0x68, 0x8f, 0x5e, 0xca, 0x9b, 0x34, 0xac, 0x81,
+	0xb6, 0xe9, 0xfa, 0xa6, 0x1b, 0xfb, 0x16, 0x7e, 0x2c, 0x8f, 0x3b, 0x7a, 0x94, 0xf5, 0x8b, 0x48,
+	0x53, 0x41, 0x05, 0x4f, 0x79, 0xf8, 0x91, 0x87, 0x17, 0x1e, 0xba, 0x32, 0x52, 0x09, 0xa8, 0xaa,
+	0xdc, 0x28, 0x70, 0xc6, 0x96, 0x28, 0x4c, 0xe9, 0x74, 0xad, 0x32, 0x30, 0x65, 0x02, 0x4a, 0xd9,
+	0xa6, 0x74, 0x28, 0x32, 0x8b, 0x4e, 0x6c, 0x97, 0xfe, 0x8d, 0xaa, 0xda, 0x3a, 0xcb, 0xae, 0x8c,
+	0x54, 0xd1, 0xcf, 0x62, 0xf4, 0x47, 0x31, 0xf2, 0x85, 0xed, 0x72, 0xb6, 0xa3, 0xe3, 0x27, 0xa8,
+	0xa1, 0x40, 0x76, 0x4e, 0x83, 0x0e, 0x13, 0x5d, 0x82, 0xcc, 0x75, 0x1a, 0x92, 0x29, 0x99, 0xff,
+	0x8f, 0x4f, 0x3a, 0xbb, 0xef, 0x89, 0x5d, 0xd2, 0x33, 0xc8, 0x73, 0xbb, 0x4b, 0x0a, 0x8d, 0x08,
+	0x6b, 0x8d, 0xe1, 0x70, 0x3a, 0x9a, 0x4f, 0xe2, 0x53, 0xaf, 0x8f, 0x5f, 0xc8, 0x2e, 0x68, 0x0f,
+	0xc9, 0xa6, 0xd1, 0xb5, 0xd1, 0x18, 0x8e, 0xfc, 0xaf, 0xc0, 0xe3, 0x73, 0x6f, 0xb3, 0x5b, 0x1a,
+	0x74, 0x71, 0x1f, 0xeb, 0x4d, 0xa3, 0xd1, 0x31, 0x46, 0xff, 0x55, 0xe0, 0x32, 0x7f, 0x76, 0x12,
+	0xfb, 0xdc, 0x59, 0x0a, 0x0e, 0xc2, 0xe1, 0x94, 0xcc, 0x83, 0xd8, 0xe7, 0xbb, 0xf4, 0xed, 0xc8,
+	0xc9, 0xe1, 0xc8, 0xc9, 0xc7, 0x91, 0x93, 0xd7, 0x96, 0x0f, 0x0e, 0x2d, 0x1f, 0xbc, 0xb7, 0x7c,
+	0xf0, 0xf2, 0xb0, 0x36, 0x2e, 0x6b, 0x64, 0xa4, 0x6c, 0x21, 0x94, 0xc5, 0xc2, 0xa2, 0x30, 0x52,
+	0x2d, 0xd6, 0x56, 0x6c, 0x57, 0xa2, 0xb0, 0x69, 0x93, 0x6b, 0xec, 0x16, 0x45, 0x71, 0xbd, 0x5a,
+	0x7c, 0x6f, 0xb2, 0xf8, 0x3d, 0xa6, 0xdb, 0x57, 0x1a, 0xe5, 0xd8, 0x6f, 0x79, 0xf3, 0x39, 0x00,
+	0x0e, 0xff, 0x3b, 0x35, 0x86, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +197,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -148,6 +228,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -174,6 +291,29 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
 	return n
 }
 
@@ -264,6 +404,154 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -16,6 +16,9 @@ const (
 
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
+
+	// AllowAllHostQueries holds the string key that allows all module safe queries on interchain accounts host module
+	AllowAllHostQueries = "*"
 )
 
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
@@ -33,3 +36,19 @@ func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 
 	return false
 }
+
+// ContainsQueryPath returns true if the query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	// check that wildcard * option for allowing all queries is the only string in the array, if so, return true
+	if len(allowQueries) == 1 && allowQueries[0] == AllowAllHostQueries {
+		return true
+	}
+
+	for _, v := range allowQueries {
+		if v == path {
+			return true
+		}
+	}
+
+	return false
+}
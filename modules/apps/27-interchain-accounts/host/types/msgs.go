@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,7 +10,10 @@ import (
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 )
 
-var _ sdk.Msg = (*MsgUpdateParams)(nil)
+var (
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgModuleQuerySafe)(nil)
+)
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
@@ -37,3 +42,41 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgModuleQuerySafe creates a new MsgModuleQuerySafe instance
+func NewMsgModuleQuerySafe(signer string, requests []QueryRequest) *MsgModuleQuerySafe {
+	return &MsgModuleQuerySafe{
+		Signer:   signer,
+		Requests: requests,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgModuleQuerySafe) ValidateBasic() error {
+	if len(msg.Requests) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "no queries provided")
+	}
+
+	for i, request := range msg.Requests {
+		if strings.TrimSpace(request.Path) == "" {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "query path cannot be empty at index %d", i)
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgModuleQuerySafe) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
@@ -67,3 +67,68 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgModuleQuerySafeValidateBasic(t *testing.T) {
+	queryRequest := types.QueryRequest{
+		Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+		Data: []byte{},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgModuleQuerySafe
+		expPass bool
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{queryRequest}),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgModuleQuerySafe("signer", []types.QueryRequest{queryRequest}),
+			false,
+		},
+		{
+			"failure: empty query requests",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{}),
+			false,
+		},
+		{
+			"failure: empty query path",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{{Path: " "}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgModuleQuerySafeGetSigners(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expPass bool
+	}{
+		{"success: valid address", sdk.AccAddress(ibctesting.TestAccAddress), true},
+		{"failure: nil address", nil, false},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgModuleQuerySafe(tc.address.String(), []types.QueryRequest{})
+		if tc.expPass {
+			require.Equal(t, []sdk.AccAddress{tc.address}, msg.GetSigners())
+		} else {
+			require.Panics(t, func() {
+				msg.GetSigners()
+			})
+		}
+	}
+}
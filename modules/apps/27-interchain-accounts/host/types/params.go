@@ -11,27 +11,33 @@ const (
 )
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs, allowQueries []string) Params {
 	return Params{
 		HostEnabled:   enableHost,
 		AllowMessages: allowMsgs,
+		AllowQueries:  allowQueries,
 	}
 }
 
-// DefaultParams is the default parameter configuration for the host submodule
+// DefaultParams is the default parameter configuration for the host submodule.
+// No queries are allowed by default.
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, []string{AllowAllHostMsgs})
+	return NewParams(DefaultHostEnabled, []string{AllowAllHostMsgs}, nil)
 }
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	return validateAllowlist(p.AllowQueries)
 }
 
-func validateAllowlist(allowMsgs []string) error {
-	for _, typeURL := range allowMsgs {
-		if strings.TrimSpace(typeURL) == "" {
-			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
+func validateAllowlist(allowlist []string) error {
+	for _, entry := range allowlist {
+		if strings.TrimSpace(entry) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowlist)
 		}
 	}
 
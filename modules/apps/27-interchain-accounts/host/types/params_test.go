@@ -10,7 +10,19 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
-	require.Error(t, types.NewParams(true, []string{""}).Validate())
-	require.Error(t, types.NewParams(true, []string{" "}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{""}, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{" "}, nil).Validate())
+	require.NoError(t, types.NewParams(true, nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}).Validate())
+	require.Error(t, types.NewParams(true, nil, []string{""}).Validate())
+	require.Error(t, types.NewParams(true, nil, []string{" "}).Validate())
+}
+
+func TestContainsQueryPath(t *testing.T) {
+	path := "/cosmos.bank.v1beta1.Query/Balance"
+
+	require.True(t, types.ContainsQueryPath([]string{types.AllowAllHostQueries}, path))
+	require.True(t, types.ContainsQueryPath([]string{"/cosmos.bank.v1beta1.Query/AllBalances", path}, path))
+	require.False(t, types.ContainsQueryPath([]string{"/cosmos.bank.v1beta1.Query/AllBalances"}, path))
+	require.False(t, types.ContainsQueryPath(nil, path))
 }
@@ -6,8 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -111,9 +114,457 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInterchainAccountsRequest is the request type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsRequest) Reset()         { *m = QueryInterchainAccountsRequest{} }
+func (m *QueryInterchainAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryInterchainAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountsResponse is the response type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsResponse struct {
+	// list of interchain accounts registered on the host chain
+	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,1,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsResponse) Reset()         { *m = QueryInterchainAccountsResponse{} }
+func (m *QueryInterchainAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryInterchainAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsResponse) GetInterchainAccounts() []RegisteredInterchainAccount {
+	if m != nil {
+		return m.InterchainAccounts
+	}
+	return nil
+}
+
+func (m *QueryInterchainAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountRequest is the request type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountRequest struct {
+	// host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
+func (m *QueryInterchainAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryInterchainAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryInterchainAccountResponse is the response type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountResponse struct {
+	// interchain account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryInterchainAccountResponse) Reset()         { *m = QueryInterchainAccountResponse{} }
+func (m *QueryInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryActiveChannelsRequest is the request type for the Query/ActiveChannels RPC method.
+type QueryActiveChannelsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveChannelsRequest) Reset()         { *m = QueryActiveChannelsRequest{} }
+func (m *QueryActiveChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveChannelsRequest) ProtoMessage()    {}
+func (*QueryActiveChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{6}
+}
+func (m *QueryActiveChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveChannelsRequest.Merge(m, src)
+}
+func (m *QueryActiveChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryActiveChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryActiveChannelsResponse is the response type for the Query/ActiveChannels RPC method.
+type QueryActiveChannelsResponse struct {
+	// list of active channels of the host chain
+	ActiveChannels []ActiveChannel `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveChannelsResponse) Reset()         { *m = QueryActiveChannelsResponse{} }
+func (m *QueryActiveChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveChannelsResponse) ProtoMessage()    {}
+func (*QueryActiveChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{7}
+}
+func (m *QueryActiveChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveChannelsResponse.Merge(m, src)
+}
+func (m *QueryActiveChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveChannelsResponse) GetActiveChannels() []ActiveChannel {
+	if m != nil {
+		return m.ActiveChannels
+	}
+	return nil
+}
+
+func (m *QueryActiveChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// RegisteredInterchainAccount contains a connection ID, controller port ID and the associated interchain account address
+type RegisteredInterchainAccount struct {
+	// host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// interchain account address
+	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+}
+
+func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchainAccount{} }
+func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*RegisteredInterchainAccount) ProtoMessage()    {}
+func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{8}
+}
+func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredInterchainAccount.Merge(m, src)
+}
+func (m *RegisteredInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredInterchainAccount proto.InternalMessageInfo
+
+func (m *RegisteredInterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RegisteredInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RegisteredInterchainAccount) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+// ActiveChannel contains a connection ID, controller port ID, the associated active channel ID and its state
+type ActiveChannel struct {
+	// host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// active channel identifier on the host chain
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// state of the active channel
+	State types.State `protobuf:"varint,4,opt,name=state,proto3,enum=ibc.core.channel.v1.State" json:"state,omitempty"`
+}
+
+func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
+func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
+func (*ActiveChannel) ProtoMessage()    {}
+func (*ActiveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{9}
+}
+func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveChannel.Merge(m, src)
+}
+func (m *ActiveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveChannel proto.InternalMessageInfo
+
+func (m *ActiveChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ActiveChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ActiveChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ActiveChannel) GetState() types.State {
+	if m != nil {
+		return m.State
+	}
+	return types.UNINITIALIZED
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInterchainAccountsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsRequest")
+	proto.RegisterType((*QueryInterchainAccountsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsResponse")
+	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountRequest")
+	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryActiveChannelsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryActiveChannelsRequest")
+	proto.RegisterType((*QueryActiveChannelsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryActiveChannelsResponse")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.host.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.host.v1.ActiveChannel")
 }
 
 func init() {
@@ -121,27 +572,56 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x1b, 0xc1, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1, 0xb5,
-	0x50, 0x47, 0x75, 0x14, 0x07, 0x75, 0x74, 0x91, 0x5c, 0x1a, 0xae, 0x81, 0x5e, 0x5e, 0x7a, 0xc9,
-	0x1d, 0x74, 0xf5, 0x13, 0x08, 0x7e, 0x24, 0x17, 0x17, 0xa1, 0xe0, 0xe2, 0x28, 0x77, 0x7e, 0x10,
-	0xb9, 0xdc, 0x81, 0x16, 0x45, 0x38, 0x5c, 0x5f, 0xf8, 0xfd, 0xfe, 0xef, 0xff, 0x82, 0x4f, 0x74,
-	0x24, 0xb9, 0xb0, 0x76, 0xa1, 0xa5, 0xf0, 0x1a, 0x8c, 0xe3, 0xda, 0x78, 0x95, 0xca, 0xb9, 0xd0,
-	0xe6, 0x4e, 0x48, 0x09, 0x99, 0xf1, 0x8e, 0xcf, 0xc1, 0x79, 0x9e, 0x8f, 0xf8, 0x32, 0x53, 0xe9,
-	0x8a, 0xd9, 0x14, 0x3c, 0x90, 0x81, 0x8e, 0x24, 0xfb, 0x4e, 0xb2, 0x5f, 0x48, 0x56, 0x91, 0x2c,
-	0x1f, 0xf5, 0x0e, 0x62, 0x80, 0x78, 0xa1, 0xb8, 0xb0, 0x9a, 0x0b, 0x63, 0xc0, 0x37, 0x4c, 0x70,
-	0xf5, 0xa6, 0xad, 0xb6, 0x08, 0xce, 0x00, 0xf6, 0xf7, 0x30, 0xb9, 0xae, 0x76, 0xba, 0x12, 0xa9,
-	0x48, 0xdc, 0x8d, 0x5a, 0x66, 0xca, 0xf9, 0xbe, 0xc4, 0xbb, 0x1b, 0x53, 0x67, 0xc1, 0x38, 0x45,
-	0x2e, 0x71, 0xd7, 0x86, 0xc9, 0x3e, 0x3a, 0x44, 0x47, 0x3b, 0xe3, 0x09, 0x6b, 0x53, 0x81, 0x35,
-	0xb6, 0xc6, 0x31, 0x7e, 0x41, 0x78, 0x3b, 0xa4, 0x90, 0x27, 0x84, 0xbb, 0xf5, 0x23, 0x39, 0x6d,
-	0xa7, 0xfc, 0xb9, 0x7b, 0xef, 0xec, 0x1f, 0x86, 0xba, 0x67, 0x7f, 0x72, 0xff, 0xfa, 0xf1, 0xb8,
-	0xc5, 0xc8, 0x80, 0x37, 0x67, 0xfd, 0xfb, 0x9c, 0x75, 0x9f, 0xf3, 0xd9, 0x73, 0x41, 0xd1, 0xba,
-	0xa0, 0xe8, 0xbd, 0xa0, 0xe8, 0xa1, 0xa4, 0x9d, 0x75, 0x49, 0x3b, 0x6f, 0x25, 0xed, 0xdc, 0x5e,
-	0xc4, 0xda, 0xcf, 0xb3, 0x88, 0x49, 0x48, 0xb8, 0x04, 0x97, 0x80, 0xab, 0xc4, 0xc3, 0x18, 0x78,
-	0x3e, 0xe5, 0x09, 0xcc, 0xb2, 0x85, 0x72, 0x75, 0xcc, 0x78, 0x3a, 0xfc, 0x4a, 0x1a, 0x6e, 0x26,
-	0xf9, 0x95, 0x55, 0x2e, 0xea, 0x86, 0x7f, 0x3b, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x21, 0xba,
-	0xc4, 0xc0, 0x78, 0x02, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xa6, 0x6d, 0x4a, 0xa7, 0xbf, 0xe6, 0x87, 0xd3, 0x82, 0x21, 0xb5, 0x69, 0x8d, 0x60,
+	0x8b, 0xb4, 0x3b, 0x26, 0x56, 0xa2, 0x16, 0xc1, 0x54, 0x50, 0xa3, 0x15, 0x6a, 0xbc, 0x09, 0x12,
+	0x26, 0xb3, 0xe3, 0x66, 0x25, 0xd9, 0xd9, 0xee, 0x4c, 0x02, 0xa5, 0x14, 0x44, 0xfc, 0x00, 0x82,
+	0x57, 0xf1, 0xcb, 0x78, 0x29, 0x9e, 0x8a, 0x5e, 0x3c, 0x89, 0xb4, 0xbd, 0x7a, 0xf3, 0x03, 0xc8,
+	0xfc, 0x49, 0xd3, 0x35, 0x69, 0x6d, 0xda, 0xdc, 0x76, 0xe7, 0xfd, 0xf3, 0xbc, 0xcf, 0xf3, 0xec,
+	0xbc, 0x2c, 0xb8, 0xe5, 0x55, 0x09, 0xc2, 0x41, 0x50, 0xf7, 0x08, 0x16, 0x1e, 0xf3, 0x39, 0xf2,
+	0x7c, 0x41, 0x43, 0x52, 0xc3, 0x9e, 0x5f, 0xc1, 0x84, 0xb0, 0xa6, 0x2f, 0x38, 0xaa, 0x31, 0x2e,
+	0x50, 0x2b, 0x87, 0x36, 0x9a, 0x34, 0xdc, 0xb4, 0x83, 0x90, 0x09, 0x06, 0x17, 0xbd, 0x2a, 0xb1,
+	0x8f, 0x56, 0xda, 0x3d, 0x2a, 0x6d, 0x59, 0x69, 0xb7, 0x72, 0xe9, 0x29, 0x97, 0xb9, 0x4c, 0x15,
+	0x22, 0xf9, 0xa4, 0x7b, 0xa4, 0x2f, 0xb9, 0x8c, 0xb9, 0x75, 0x8a, 0x70, 0xe0, 0x21, 0xec, 0xfb,
+	0x4c, 0x98, 0x4e, 0x3a, 0x7a, 0x8d, 0x30, 0xde, 0x60, 0x1c, 0x55, 0x31, 0xa7, 0x1a, 0x1a, 0xb5,
+	0x72, 0x55, 0x2a, 0x70, 0x0e, 0x05, 0xd8, 0xf5, 0x7c, 0x95, 0x6c, 0x72, 0x0b, 0x7d, 0xf1, 0x50,
+	0x53, 0xe9, 0xc2, 0xcb, 0xb2, 0x90, 0xb0, 0x90, 0x22, 0x52, 0xc3, 0xbe, 0x4f, 0xeb, 0x32, 0x6e,
+	0x1e, 0x75, 0x4a, 0x76, 0x0a, 0xc0, 0x67, 0x12, 0x7d, 0x1d, 0x87, 0xb8, 0xc1, 0xcb, 0x74, 0xa3,
+	0x49, 0xb9, 0xc8, 0x12, 0x30, 0x19, 0x39, 0xe5, 0x01, 0xf3, 0x39, 0x85, 0x6b, 0x20, 0x11, 0xa8,
+	0x93, 0x94, 0x35, 0x67, 0x2d, 0x8c, 0xe7, 0x97, 0xed, 0x7e, 0x74, 0xb2, 0x4d, 0x37, 0xd3, 0x23,
+	0x5b, 0x03, 0x19, 0x05, 0x52, 0x3a, 0x2c, 0x29, 0x9a, 0x0a, 0x33, 0x06, 0x7c, 0x00, 0x40, 0x47,
+	0x0c, 0x83, 0x79, 0xd5, 0xd6, 0xca, 0xd9, 0x52, 0x39, 0x5b, 0x9b, 0x66, 0x94, 0xb3, 0xd7, 0xb1,
+	0x4b, 0x4d, 0x6d, 0xf9, 0x48, 0x65, 0xf6, 0x97, 0x05, 0x66, 0x8f, 0x85, 0x32, 0xdc, 0xde, 0x58,
+	0x60, 0xb2, 0xc7, 0xf0, 0x29, 0x6b, 0x6e, 0x68, 0x61, 0x3c, 0x5f, 0xea, 0x8f, 0x69, 0x99, 0xba,
+	0x1e, 0x17, 0x34, 0xa4, 0x4e, 0x17, 0xe2, 0xea, 0xf0, 0xce, 0x8f, 0xd9, 0x58, 0x19, 0x7a, 0x5d,
+	0xa3, 0xc0, 0x87, 0x11, 0xba, 0x71, 0x45, 0x77, 0xfe, 0x9f, 0x74, 0xf5, 0xfc, 0x11, 0xbe, 0x2f,
+	0xc1, 0x4c, 0x6f, 0xba, 0x6d, 0x61, 0xaf, 0x80, 0x09, 0xc2, 0x7c, 0x9f, 0x12, 0x99, 0x5e, 0xf1,
+	0x1c, 0xa5, 0xed, 0x58, 0xf9, 0xbf, 0xce, 0x61, 0xc9, 0x81, 0x17, 0xc1, 0x68, 0xc0, 0x42, 0x21,
+	0xc3, 0x71, 0x15, 0x4e, 0xc8, 0xd7, 0x92, 0x93, 0xbd, 0x73, 0x9c, 0x71, 0x87, 0x62, 0xa6, 0xc0,
+	0x28, 0x76, 0x9c, 0x90, 0x72, 0x6e, 0x3a, 0xb7, 0x5f, 0xb3, 0x0e, 0x48, 0xab, 0xda, 0x22, 0x11,
+	0x5e, 0x8b, 0xde, 0xd7, 0xdf, 0xe2, 0xc0, 0x0d, 0xff, 0x6a, 0x81, 0xe9, 0x9e, 0x30, 0x66, 0xbe,
+	0xd7, 0xe0, 0x7f, 0xac, 0x22, 0x15, 0x73, 0x1b, 0xda, 0x3e, 0xaf, 0xf4, 0xe7, 0x73, 0xa4, 0xbd,
+	0x71, 0x36, 0x89, 0x23, 0x98, 0x83, 0x73, 0xf5, 0x9d, 0x05, 0xa6, 0x4f, 0xf8, 0xb0, 0xce, 0x67,
+	0x2a, 0x9c, 0x97, 0x92, 0xa8, 0x46, 0x95, 0xb6, 0x75, 0x43, 0x2a, 0x21, 0x69, 0x8e, 0x8b, 0xc6,
+	0xc1, 0x4f, 0x16, 0x98, 0x88, 0xf0, 0x3e, 0x27, 0xf0, 0x0c, 0x00, 0xc6, 0x04, 0x19, 0xd3, 0x98,
+	0x63, 0xe6, 0xa4, 0xe4, 0xc0, 0xeb, 0x60, 0x84, 0x0b, 0x2c, 0x68, 0x6a, 0x78, 0xce, 0x5a, 0x48,
+	0xe6, 0xd3, 0xca, 0x20, 0xb9, 0xd3, 0x6c, 0x93, 0x23, 0x7d, 0x78, 0x2e, 0x33, 0xca, 0x3a, 0x31,
+	0xff, 0x65, 0x14, 0x8c, 0x28, 0xf3, 0xe1, 0x67, 0x0b, 0x24, 0xf4, 0xd2, 0x81, 0xf7, 0xfa, 0x33,
+	0xb6, 0x7b, 0x27, 0xa6, 0x8b, 0xe7, 0xe8, 0xa0, 0xdd, 0xcc, 0x2e, 0xbf, 0xfd, 0x76, 0xf0, 0x21,
+	0x6e, 0xc3, 0x45, 0x64, 0x36, 0xfa, 0xc9, 0x9b, 0x5c, 0xef, 0x49, 0xf8, 0xdb, 0x02, 0xb0, 0x7b,
+	0x71, 0xc1, 0xb5, 0x33, 0xcc, 0x73, 0xec, 0xaa, 0x4d, 0x3f, 0x1d, 0x50, 0x37, 0xc3, 0xb4, 0xa8,
+	0x98, 0xae, 0xc0, 0xdb, 0xa7, 0x63, 0xda, 0x23, 0x06, 0x3f, 0xc6, 0xc1, 0x85, 0xee, 0x8f, 0xfc,
+	0xc9, 0x20, 0xe6, 0x6c, 0x93, 0x5e, 0x1b, 0x4c, 0x33, 0xc3, 0xb9, 0xae, 0x38, 0xbf, 0x82, 0xce,
+	0xe9, 0x38, 0x77, 0x6e, 0x07, 0x47, 0x5b, 0x91, 0xfb, 0xb3, 0x8d, 0xe4, 0xd5, 0xe0, 0x68, 0xcb,
+	0x5c, 0x98, 0xed, 0x1e, 0x7d, 0xe0, 0x81, 0x05, 0x92, 0xd1, 0xed, 0x06, 0x1f, 0x9d, 0x81, 0x4e,
+	0xcf, 0x3d, 0x9c, 0x2e, 0x0d, 0xa0, 0x93, 0x51, 0xe5, 0xae, 0x52, 0xa5, 0x00, 0x6f, 0x9e, 0x4e,
+	0x95, 0xbf, 0xd6, 0xf2, 0xaa, 0xb3, 0xb3, 0x97, 0xb1, 0x76, 0xf7, 0x32, 0xd6, 0xcf, 0xbd, 0x8c,
+	0xf5, 0x7e, 0x3f, 0x13, 0xdb, 0xdd, 0xcf, 0xc4, 0xbe, 0xef, 0x67, 0x62, 0x2f, 0x1e, 0xbb, 0x9e,
+	0xa8, 0x35, 0xab, 0x36, 0x61, 0x0d, 0x64, 0x7e, 0xa6, 0xbc, 0x2a, 0x59, 0x72, 0x19, 0x6a, 0x15,
+	0x50, 0x83, 0x39, 0xcd, 0x3a, 0xe5, 0x1a, 0x2f, 0x5f, 0x58, 0xea, 0x40, 0x2e, 0x45, 0x21, 0xc5,
+	0x66, 0x40, 0x79, 0x35, 0xa1, 0x7e, 0x86, 0x6e, 0xfc, 0x19, 0x00, 0xa7, 0x15, 0x7a, 0x53, 0x32,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +638,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InterchainAccounts returns all the interchain accounts registered on the host chain
+	InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error)
+	// InterchainAccount returns the interchain account address for a given connection and controller port
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// ActiveChannels returns all the active channels of the host chain and their channel state
+	ActiveChannels(ctx context.Context, in *QueryActiveChannelsRequest, opts ...grpc.CallOption) (*QueryActiveChannelsResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +663,43 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error) {
+	out := new(QueryInterchainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveChannels(ctx context.Context, in *QueryActiveChannelsRequest, opts ...grpc.CallOption) (*QueryActiveChannelsResponse, error) {
+	out := new(QueryActiveChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ActiveChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InterchainAccounts returns all the interchain accounts registered on the host chain
+	InterchainAccounts(context.Context, *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error)
+	// InterchainAccount returns the interchain account address for a given connection and controller port
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// ActiveChannels returns all the active channels of the host chain and their channel state
+	ActiveChannels(context.Context, *QueryActiveChannelsRequest) (*QueryActiveChannelsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +709,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccounts(ctx context.Context, req *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccounts not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
+func (*UnimplementedQueryServer) ActiveChannels(ctx context.Context, req *QueryActiveChannelsRequest) (*QueryActiveChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveChannels not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +741,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterchainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccounts(ctx, req.(*QueryInterchainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ActiveChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveChannels(ctx, req.(*QueryActiveChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +803,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InterchainAccounts",
+			Handler:    _Query_InterchainAccounts_Handler,
+		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "ActiveChannels",
+			Handler:    _Query_ActiveChannels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -273,57 +867,1268 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterchainAccounts) > 0 {
+		for iNdEx := len(m.InterchainAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActiveChannels) > 0 {
+		for iNdEx := len(m.ActiveChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainAccounts) > 0 {
+		for _, e := range m.InterchainAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveChannels) > 0 {
+		for _, e := range m.ActiveChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccounts = append(m.InterchainAccounts, RegisteredInterchainAccount{})
+			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveChannels = append(m.ActiveChannels, ActiveChannel{})
+			if err := m.ActiveChannels[len(m.ActiveChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,12 +2151,108 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredInterchainAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -373,7 +2274,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,17 +2297,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -416,28 +2317,107 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= types.State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
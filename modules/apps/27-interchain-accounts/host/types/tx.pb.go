@@ -123,9 +123,121 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgModuleQuerySafe defines the payload for Msg/ModuleQuerySafe
+type MsgModuleQuerySafe struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// requests defines the module safe queries to execute.
+	Requests []QueryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *MsgModuleQuerySafe) Reset()         { *m = MsgModuleQuerySafe{} }
+func (m *MsgModuleQuerySafe) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleQuerySafe) ProtoMessage()    {}
+func (*MsgModuleQuerySafe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{2}
+}
+func (m *MsgModuleQuerySafe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleQuerySafe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleQuerySafe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModuleQuerySafe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleQuerySafe.Merge(m, src)
+}
+func (m *MsgModuleQuerySafe) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleQuerySafe) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleQuerySafe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleQuerySafe proto.InternalMessageInfo
+
+func (m *MsgModuleQuerySafe) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgModuleQuerySafe) GetRequests() []QueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// MsgModuleQuerySafeResponse defines the response for Msg/ModuleQuerySafe
+type MsgModuleQuerySafeResponse struct {
+	// height at which the responses were queried
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// protobuf encoded responses for each query
+	Responses [][]byte `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *MsgModuleQuerySafeResponse) Reset()         { *m = MsgModuleQuerySafeResponse{} }
+func (m *MsgModuleQuerySafeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleQuerySafeResponse) ProtoMessage()    {}
+func (*MsgModuleQuerySafeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{3}
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleQuerySafeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleQuerySafeResponse.Merge(m, src)
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleQuerySafeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleQuerySafeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleQuerySafeResponse proto.InternalMessageInfo
+
+func (m *MsgModuleQuerySafeResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgModuleQuerySafeResponse) GetResponses() [][]byte {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgModuleQuerySafe)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe")
+	proto.RegisterType((*MsgModuleQuerySafeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse")
 }
 
 func init() {
@@ -133,29 +245,36 @@ func init() {
 }
 
 var fileDescriptor_fa437afde7f1e7ae = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xd2, 0xc9, 0x4c, 0x4a, 0xd6, 0x43, 0xd6, 0xa6, 0x87, 0x45, 0x9b, 0x1e, 0x48, 0x9b,
-	0x5e, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0xa3, 0x3e, 0x88, 0x05, 0x31, 0x43,
-	0x4a, 0x3c, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0xb7, 0x38, 0x1d, 0x64, 0x76, 0x6e, 0x71,
-	0x3a, 0x54, 0xc2, 0x9c, 0x24, 0x37, 0x81, 0x2d, 0x01, 0x6b, 0x54, 0x9a, 0xcc, 0xc8, 0xc5, 0xef,
-	0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x24,
-	0xc3, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x19, 0x84, 0x10, 0x10, 0x0a, 0xe2, 0x62, 0x2b, 0x00, 0xab, 0x93, 0x60, 0x52, 0x60, 0xd4,
-	0xe0, 0x36, 0x32, 0xd1, 0x23, 0xc5, 0x63, 0x7a, 0x10, 0x3b, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67,
-	0x08, 0x82, 0x9a, 0x64, 0xc5, 0xd7, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0x0e, 0x25, 0x49, 0x2e, 0x71,
-	0x34, 0x47, 0x05, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x2d, 0x66, 0xe4, 0x62, 0xf6,
-	0x2d, 0x4e, 0x17, 0x9a, 0xc2, 0xc8, 0xc5, 0x83, 0xe2, 0x6a, 0x5b, 0xd2, 0xdc, 0x81, 0x66, 0xbe,
-	0x94, 0x2b, 0x45, 0xda, 0x61, 0xce, 0x93, 0x62, 0x6d, 0x78, 0xbe, 0x41, 0x8b, 0xd1, 0x29, 0xe5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xbc, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xb1, 0x99, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f,
-	0x66, 0xae, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x8a, 0xc9, 0x62, 0x7d, 0x23, 0x73,
-	0x5d, 0x84, 0x0b, 0x74, 0x51, 0x23, 0xb1, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x87,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x56, 0xf9, 0x77, 0x92, 0x02, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6a, 0x14, 0x31,
+	0x18, 0xc7, 0x37, 0x6d, 0x5d, 0x6c, 0xb6, 0x28, 0x0c, 0x62, 0xeb, 0x20, 0x63, 0xd9, 0xd3, 0x52,
+	0xdc, 0x09, 0x5d, 0x95, 0x85, 0x05, 0x41, 0x0a, 0x82, 0x08, 0x0b, 0x3a, 0xe2, 0x45, 0x04, 0xc9,
+	0x64, 0x63, 0x26, 0xd0, 0x99, 0x8c, 0xf9, 0x32, 0x8b, 0x7b, 0x13, 0x9f, 0x40, 0x50, 0xf0, 0xe4,
+	0x3b, 0xf4, 0x1d, 0xbc, 0xf4, 0xd8, 0xa3, 0x27, 0x91, 0xdd, 0x43, 0x5f, 0x43, 0x92, 0x4d, 0xbb,
+	0x76, 0xec, 0x65, 0xe8, 0x6d, 0x92, 0xc9, 0xff, 0xff, 0xff, 0x7d, 0xf9, 0xf2, 0xe1, 0x47, 0x32,
+	0x65, 0x84, 0x96, 0xe5, 0xa1, 0x64, 0xd4, 0x48, 0x55, 0x00, 0x91, 0x85, 0xe1, 0x9a, 0x65, 0x54,
+	0x16, 0xef, 0x28, 0x63, 0xaa, 0x2a, 0x0c, 0x90, 0x4c, 0x81, 0x21, 0xd3, 0x7d, 0x62, 0x3e, 0xc6,
+	0xa5, 0x56, 0x46, 0x05, 0xf7, 0x65, 0xca, 0xe2, 0x7f, 0x65, 0xf1, 0x25, 0xb2, 0xd8, 0xca, 0xe2,
+	0xe9, 0x7e, 0x78, 0x4b, 0x28, 0xa1, 0x9c, 0x90, 0xd8, 0xaf, 0xa5, 0x47, 0xb8, 0xcd, 0x14, 0xe4,
+	0x0a, 0x48, 0x0e, 0xc2, 0x7a, 0xe7, 0x20, 0xfc, 0x8f, 0x61, 0x23, 0x26, 0x17, 0xe2, 0x84, 0xdd,
+	0xaf, 0x08, 0xdf, 0x1c, 0x83, 0x78, 0x5d, 0x4e, 0xa8, 0xe1, 0x2f, 0xa8, 0xa6, 0x39, 0x04, 0x77,
+	0xf1, 0x26, 0xad, 0x4c, 0xa6, 0xb4, 0x34, 0xb3, 0x1d, 0xb4, 0x8b, 0x7a, 0x9b, 0xc9, 0x6a, 0x23,
+	0x48, 0x70, 0xbb, 0x74, 0xe7, 0x76, 0xd6, 0x76, 0x51, 0xaf, 0x33, 0x78, 0x18, 0x37, 0x29, 0x2c,
+	0x5e, 0x66, 0x1c, 0x6c, 0x1c, 0xff, 0xbe, 0xd7, 0x4a, 0xbc, 0xd3, 0xe8, 0xc6, 0xe7, 0xd3, 0xa3,
+	0xbd, 0x55, 0x46, 0xf7, 0x0e, 0xde, 0xae, 0x41, 0x25, 0x1c, 0x4a, 0x55, 0x00, 0xef, 0x7e, 0x47,
+	0x38, 0x18, 0x83, 0x18, 0xab, 0x49, 0x75, 0xc8, 0x5f, 0x56, 0x5c, 0xcf, 0x5e, 0xd1, 0xf7, 0x3c,
+	0xb8, 0x8d, 0xdb, 0x20, 0x45, 0xc1, 0xb5, 0x07, 0xf6, 0xab, 0xe0, 0x2d, 0xbe, 0xae, 0xf9, 0x87,
+	0x8a, 0x83, 0xb1, 0xbc, 0xeb, 0xbd, 0xce, 0x60, 0xd4, 0x8c, 0xd7, 0x45, 0x24, 0x4b, 0x0b, 0x4f,
+	0x7d, 0xee, 0x38, 0xea, 0x58, 0x6e, 0x1f, 0xd5, 0x4d, 0x70, 0xf8, 0x3f, 0xd8, 0x19, 0xb7, 0x05,
+	0xcc, 0xb8, 0x14, 0x99, 0x71, 0x80, 0x1b, 0x89, 0x5f, 0xd9, 0xcb, 0xd6, 0xfe, 0xcc, 0x92, 0x70,
+	0x2b, 0x59, 0x6d, 0x0c, 0x7e, 0xae, 0xe1, 0xf5, 0x31, 0x88, 0xe0, 0x1b, 0xc2, 0x5b, 0x17, 0x7a,
+	0xf4, 0xb8, 0x59, 0x15, 0xb5, 0xdb, 0x0c, 0x9f, 0x5e, 0x49, 0x7e, 0x5e, 0xd4, 0x0f, 0xfb, 0x7a,
+	0x6a, 0x9d, 0x78, 0xd2, 0xd8, 0xba, 0xe6, 0x10, 0x3e, 0xbb, 0xaa, 0xc3, 0x19, 0x5f, 0x78, 0xed,
+	0xd3, 0xe9, 0xd1, 0x1e, 0x3a, 0x98, 0x1c, 0xcf, 0x23, 0x74, 0x32, 0x8f, 0xd0, 0x9f, 0x79, 0x84,
+	0xbe, 0x2c, 0xa2, 0xd6, 0xc9, 0x22, 0x6a, 0xfd, 0x5a, 0x44, 0xad, 0x37, 0xcf, 0x85, 0x34, 0x59,
+	0x95, 0xc6, 0x4c, 0xe5, 0xc4, 0xcf, 0x96, 0x4c, 0x59, 0x5f, 0x28, 0x32, 0x1d, 0x92, 0xdc, 0xb9,
+	0x82, 0x9d, 0x2b, 0x20, 0x83, 0x61, 0x7f, 0x05, 0xd1, 0xbf, 0x38, 0x52, 0x66, 0x56, 0x72, 0x48,
+	0xdb, 0x6e, 0xa2, 0x1e, 0xfc, 0x1d, 0x00, 0x9a, 0xc7, 0xff, 0xa2, 0x20, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +291,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
+	ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error)
 }
 
 type msgClient struct {
@@ -191,10 +312,21 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error) {
+	out := new(MsgModuleQuerySafeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/ModuleQuerySafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
+	ModuleQuerySafe(context.Context, *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -204,6 +336,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ModuleQuerySafe(ctx context.Context, req *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleQuerySafe not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -227,6 +362,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModuleQuerySafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModuleQuerySafe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModuleQuerySafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/ModuleQuerySafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModuleQuerySafe(ctx, req.(*MsgModuleQuerySafe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -235,6 +388,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ModuleQuerySafe",
+			Handler:    _Msg_ModuleQuerySafe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
@@ -303,6 +460,87 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgModuleQuerySafe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleQuerySafe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleQuerySafe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModuleQuerySafeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleQuerySafeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleQuerySafeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Responses[iNdEx])
+			copy(dAtA[i:], m.Responses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Responses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -338,6 +576,43 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgModuleQuerySafe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModuleQuerySafeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Responses) > 0 {
+		for _, b := range m.Responses {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -509,6 +784,223 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
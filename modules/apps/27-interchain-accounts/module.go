@@ -131,7 +131,14 @@ func (am AppModule) InitModule(ctx sdk.Context, controllerParams controllertypes
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	if am.controllerKeeper != nil {
+		controllerkeeper.RegisterInvariants(ir, am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hostkeeper.RegisterInvariants(ir, am.hostKeeper)
+	}
 }
 
 // RegisterServices registers module services
@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	controllertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRegisterInterchainAccount = "op_weight_msg_register_interchain_account" //nolint:gosec
+
+	DefaultWeightMsgRegisterInterchainAccount = 10
+)
+
+// WeightedOperations returns all the interchain accounts module operations with their respective weights.
+// Interchain accounts are registered over the 09-localhost connection of the simulated chain, which hosts
+// both the controller and the host of the accounts.
+func WeightedOperations(
+	appParams simtypes.AppParams, registry codectypes.InterfaceRegistry, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simulation.WeightedOperations {
+	cdc := codec.NewProtoCodec(registry)
+
+	var weightMsgRegisterInterchainAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterInterchainAccount, &weightMsgRegisterInterchainAccount, nil,
+		func(_ *rand.Rand) { weightMsgRegisterInterchainAccount = DefaultWeightMsgRegisterInterchainAccount },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgRegisterInterchainAccount, SimulateMsgRegisterInterchainAccount(cdc, ak, bk)),
+	}
+}
+
+// SimulateMsgRegisterInterchainAccount generates a MsgRegisterInterchainAccount for a random owner on the localhost
+// connection. The channel handshake of the interchain account is completed by the ibc core operations.
+func SimulateMsgRegisterInterchainAccount(cdc *codec.ProtoCodec, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		ordering := []channeltypes.Order{channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT}[r.Intn(2)]
+		msg := controllertypes.NewMsgRegisterInterchainAccountWithOrdering(exported.LocalhostConnectionID, owner.Address.String(), "", ordering)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       sdk.MsgTypeURL(msg),
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		opMsg, _, err := ibcsims.DeliverMsg(txCtx)
+		return opMsg, nil, err
+	}
+}
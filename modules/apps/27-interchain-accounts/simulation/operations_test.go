@@ -0,0 +1,47 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/simulation"
+	"github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestSimulateMsgRegisterInterchainAccount(t *testing.T) {
+	orderings := make(map[channeltypes.Order]bool)
+
+	// the seeds cover both of the orderings supported by interchain account channels
+	for seed := int64(1); seed <= 4; seed++ {
+		coordinator := ibctesting.NewCoordinator(t, 1)
+		chain := coordinator.GetChain(ibctesting.GetChainID(1))
+
+		app := chain.GetSimApp()
+		cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+
+		opMsg, _, err := simulation.SimulateMsgRegisterInterchainAccount(cdc, app.AccountKeeper, app.BankKeeper)(
+			rand.New(rand.NewSource(seed)), app.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID,
+		)
+		require.NoError(t, err)
+		require.True(t, opMsg.OK, opMsg.Comment)
+
+		// the channel handshake of the interchain account is initialised over the localhost connection
+		channels := ibcsims.GetLocalhostChannels(chain.GetContext(), app.IBCKeeper)
+		require.Len(t, channels, 1)
+		require.True(t, strings.HasPrefix(channels[0].PortId, types.ControllerPortPrefix))
+		require.Equal(t, types.HostPortID, channels[0].Counterparty.PortId)
+		require.Equal(t, channeltypes.INIT, channels[0].State)
+
+		orderings[channels[0].Ordering] = true
+	}
+
+	require.Equal(t, map[channeltypes.Order]bool{channeltypes.ORDERED: true, channeltypes.ORDERED_ALLOW_TIMEOUT: true}, orderings)
+}
@@ -26,9 +26,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
-// bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []proto.Message) (bz []byte, err error) {
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// depending on the encoding type passed in. The marshaled bytes are returned. Only the ProtoCodec
+// is supported for serializing messages. Both protobuf and proto3 JSON are supported.
+func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
 	if _, ok := cdc.(*codec.ProtoCodec); !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
@@ -47,26 +48,46 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []proto.Message) (bz []byte,
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
-	if err != nil {
-		return nil, err
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = cdc.Marshal(cosmosTx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "cannot marshal CosmosTx with protobuf")
+		}
+	case EncodingProto3JSON:
+		bz, err = cdc.MarshalJSON(cosmosTx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot marshal CosmosTx with proto3 json")
+		}
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
 	}
 
 	return bz, nil
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
-// deserialization.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes into a slice of sdk.Msg's.
+// The transaction bytes are unmarshaled depending on the encoding type passed in. The sdk.Msg's are
+// unpacked from Any's and returned. Only the ProtoCodec is supported for message deserialization.
+// Both protobuf and proto3 JSON are supported.
+func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// only ProtoCodec is supported
 	if _, ok := cdc.(*codec.ProtoCodec); !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
-		return nil, err
+	switch encoding {
+	case EncodingProtobuf:
+		if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(err, "cannot unmarshal CosmosTx with protobuf")
+		}
+	case EncodingProto3JSON:
+		if err := cdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json")
+		}
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
 	}
 
 	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	sdkmath "cosmossdk.io/math"
@@ -106,39 +108,77 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 		},
 	}
 
-	for _, tc := range testCases {
-		tc := tc
+	encodings := []string{types.EncodingProtobuf, types.EncodingProto3JSON}
+	for _, encoding := range encodings {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s with %s encoding", tc.name, encoding), func() {
+				bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs, encoding)
+				if !tc.expPass && err != nil {
+					// unregistered types cannot be marshaled to proto3 json
+					suite.Require().Equal(types.EncodingProto3JSON, encoding)
+					return
+				}
+				suite.Require().NoError(err, tc.name)
+
+				msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bz, encoding)
+				if tc.expPass {
+					suite.Require().NoError(err, tc.name)
+				} else {
+					suite.Require().Error(err, tc.name)
+				}
+
+				for i, msg := range msgs {
+					suite.Require().Equal(tc.msgs[i], msg)
+				}
+			})
+		}
+
+		// test serializing non sdk.Msg type
+		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []proto.Message{&banktypes.MsgSendResponse{}}, encoding)
+		suite.Require().NoError(err)
+		suite.Require().NotEmpty(bz)
+
+		// test deserializing unknown bytes
+		_, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bz, encoding)
+		suite.Require().Error(err) // unregistered type
+
+		// test deserializing unknown bytes
+		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"), encoding)
+		suite.Require().Error(err)
+		suite.Require().Empty(msgs)
+	}
 
-		suite.Run(tc.name, func() {
-			bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
-			suite.Require().NoError(err, tc.name)
+	// test unsupported encoding
+	bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []proto.Message{&banktypes.MsgSend{}}, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Nil(bz)
 
-			msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bz)
-			if tc.expPass {
-				suite.Require().NoError(err, tc.name)
-			} else {
-				suite.Require().Error(err, tc.name)
-			}
-
-			for i, msg := range msgs {
-				suite.Require().Equal(tc.msgs[i], msg)
-			}
-		})
+	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"), "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(msgs)
+}
+
+// TestProto3JSONEncoding asserts that the proto3 json encoded CosmosTx can be
+// constructed by a non-Cosmos controller without access to protobuf tooling.
+func (suite *TypesTestSuite) TestProto3JSONEncoding() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	msg := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdkmath.NewInt(100))),
 	}
 
-	// test serializing non sdk.Msg type
-	bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []proto.Message{&banktypes.MsgSendResponse{}})
+	bz, err := types.SerializeCosmosTx(cdc, []proto.Message{msg}, types.EncodingProto3JSON)
 	suite.Require().NoError(err)
-	suite.Require().NotEmpty(bz)
 
-	// test deserializing unknown bytes
-	_, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, bz)
-	suite.Require().Error(err) // unregistered type
+	expected := fmt.Sprintf(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"bananas","amount":"100"}]}]}`, TestOwnerAddress, TestOwnerAddress)
+	suite.Require().Equal(expected, string(bz))
 
-	// test deserializing unknown bytes
-	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
-	suite.Require().Error(err)
-	suite.Require().Empty(msgs)
+	msgs, err := types.DeserializeCosmosTx(cdc, []byte(expected), types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{msg}, msgs)
 }
 
 // unregistered bytes causes amino to panic.
@@ -148,11 +188,11 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	cdc := codec.NewLegacyAmino()
 	marshaler := codec.NewAminoCodec(cdc)
 
-	msgs, err := types.SerializeCosmosTx(marshaler, []proto.Message{&banktypes.MsgSend{}})
+	msgs, err := types.SerializeCosmosTx(marshaler, []proto.Message{&banktypes.MsgSend{}}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 
-	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0})
+	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 }
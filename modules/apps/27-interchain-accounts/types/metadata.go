@@ -6,11 +6,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
@@ -50,6 +53,16 @@ func NewDefaultMetadataString(controllerConnectionID, hostConnectionID string) s
 	return string(ModuleCdc.MustMarshalJSON(&metadata))
 }
 
+// MetadataFromVersion parses Metadata from a JSON encoded version string.
+func MetadataFromVersion(versionString string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(versionString), &metadata); err != nil {
+		return Metadata{}, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return metadata, nil
+}
+
 // IsPreviousMetadataEqual compares a metadata to a previous version string set in a channel struct.
 // It ensures all fields are equal except the Address string
 func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
@@ -129,6 +142,12 @@ func ValidateHostMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, connecti
 	return nil
 }
 
+// IsSupportedOrdering returns true if the provided channel ordering may be used by interchain account channels, otherwise false.
+// Interchain account channels must be ORDERED or ORDERED_ALLOW_TIMEOUT.
+func IsSupportedOrdering(order channeltypes.Order) bool {
+	return order == channeltypes.ORDERED || order == channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
 // isSupportedEncoding returns true if the provided encoding is supported, otherwise false
 func isSupportedEncoding(encoding string) bool {
 	for _, enc := range getSupportedEncoding() {
@@ -142,7 +161,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -152,6 +152,13 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3json encoding",
+			func() {
+				metadata.Encoding = types.EncodingProto3JSON
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -293,6 +300,13 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3json encoding",
+			func() {
+				metadata.Encoding = types.EncodingProto3JSON
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -406,3 +420,15 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMetadataFromVersion() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProto3JSON, types.TxTypeSDKMultiMsg)
+
+	parsed, err := types.MetadataFromVersion(string(types.ModuleCdc.MustMarshalJSON(&metadata)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, parsed)
+
+	parsed, err = types.MetadataFromVersion("invalid-metadata")
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+	suite.Require().Equal(types.Metadata{}, parsed)
+}
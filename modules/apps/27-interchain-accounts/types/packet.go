@@ -1,17 +1,26 @@
 package types
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 256
 
+var (
+	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
+)
+
 var (
 	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
 	// to the current block height of the counterparty chain provided by the client state. The
@@ -48,6 +57,39 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
 }
 
+// GetPacketSender returns the sender address of the interchain accounts packet data. It is obtained from the source port ID.
+// Under normal operation, the source port ID is the controller port ID, which is prefixed with the interchain accounts
+// controller port prefix followed by the owner address. An empty string is returned if the port ID is not prefixed.
+func (InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
+	icaOwner, found := strings.CutPrefix(sourcePortID, ControllerPortPrefix)
+	if !found {
+		return ""
+	}
+
+	return icaOwner
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) interface{} {
+	if len(iapd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(iapd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
 func (ct CosmosTx) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ct))
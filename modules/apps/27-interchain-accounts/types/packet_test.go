@@ -82,3 +82,24 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestGetCustomPacketData() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: `{"src_callback": {"address": "addr"}}`,
+	}
+
+	suite.Require().Equal(map[string]interface{}{"address": "addr"}, packetData.GetCustomPacketData("src_callback"))
+	suite.Require().Nil(packetData.GetCustomPacketData("dest_callback"))
+
+	packetData.Memo = "memo"
+	suite.Require().Nil(packetData.GetCustomPacketData("src_callback"))
+}
+
+func (suite *TypesTestSuite) TestGetPacketSender() {
+	packetData := types.InterchainAccountPacketData{}
+
+	suite.Require().Equal("owner", packetData.GetPacketSender(types.ControllerPortPrefix+"owner"))
+	suite.Require().Empty(packetData.GetPacketSender(types.HostPortID))
+}
@@ -146,7 +146,7 @@ func (suite *FeeTestSuite) TestFeeInterchainAccounts() {
 		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
 	}
 
-	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msgDelegate})
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msgDelegate}, icatypes.EncodingProtobuf)
 	suite.Require().NoError(err)
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
@@ -155,15 +155,16 @@ func (suite *FeeTestSuite) TestFeeInterchainAccounts() {
 	}
 
 	// ensure chainB is allowed to execute stakingtypes.MsgDelegate
-	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)})
+	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)}, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	// build the interchain accounts packet
 	packet := buildInterchainAccountsPacket(path, icaPacketData.GetBytes(), 1)
 
-	// write packet commitment to state on chainA and commit state
+	// write packet commitment and next send sequence to state on chainA and commit state
 	commitment := channeltypes.CommitPacket(suite.chainA.GetSimApp().AppCodec(), packet)
 	suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, commitment)
+	suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
 	suite.chainA.NextBlock()
 
 	err = path.RelayPacket(packet)
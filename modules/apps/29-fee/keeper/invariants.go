@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+)
+
+// RegisterInvariants registers all fee invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "fee-escrow",
+		FeeEscrowInvariant(k))
+}
+
+// FeeEscrowInvariant checks that the balance of the fee module account is not smaller
+// than the total of the packet fees stored in escrow.
+func FeeEscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedEscrowed sdk.Coins
+		for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+			for _, packetFee := range identifiedFees.PacketFees {
+				expectedEscrowed = expectedEscrowed.Add(packetFee.Fee.Total()...)
+			}
+		}
+
+		actualEscrowed := k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress())
+
+		// the actual escrowed amount must be greater than or equal to the expected amount for all denominations
+		if !actualEscrowed.IsAllGTE(expectedEscrowed) {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"fee escrow",
+				fmt.Sprintf("fee module account balance is lower than the packet fees in escrow:\nactual escrowed: %s\nexpected escrowed: %s", actualEscrowed, expectedEscrowed)), true
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func (suite *KeeperTestSuite) TestFeeEscrowInvariant() {
+	var fee types.Fee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with multiple packet fees in escrow",
+			func() {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 100)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			true,
+		},
+		{
+			"fails with packet fees in escrow greater than the escrow balance",
+			func() {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 100)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			false,
+		},
+		{
+			"success with escrow balance greater than the packet fees in escrow",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// escrow a packet fee for a packet sent on the fee enabled channel
+			sequence, err := suite.path.EndpointA.SendPacket(suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil))
+
+			_, err = suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			out, broken := keeper.FeeEscrowInvariant(&suite.chainA.GetSimApp().IBCFeeKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+				suite.Require().Empty(out)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
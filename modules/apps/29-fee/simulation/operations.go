@@ -0,0 +1,235 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgChannelOpenInit           = "op_weight_msg_fee_channel_open_init"       //nolint:gosec
+	OpWeightMsgPayPacketFeeAsync         = "op_weight_msg_pay_packet_fee_async"        //nolint:gosec
+	OpWeightMsgRegisterPayee             = "op_weight_msg_register_payee"              //nolint:gosec
+	OpWeightMsgRegisterCounterpartyPayee = "op_weight_msg_register_counterparty_payee" //nolint:gosec
+
+	DefaultWeightMsgChannelOpenInit           = 10
+	DefaultWeightMsgPayPacketFeeAsync         = 50
+	DefaultWeightMsgRegisterPayee             = 20
+	DefaultWeightMsgRegisterCounterpartyPayee = 20
+)
+
+// TransferKeeper defines the expected transfer keeper used to retrieve the port fee enabled channels are opened on.
+type TransferKeeper interface {
+	GetPort(ctx sdk.Context) string
+}
+
+// WeightedOperations returns all the 29-fee module operations with their respective weights. Fee enabled transfer
+// channels are opened over the 09-localhost connection of the simulated chain, and the packets sent over them are
+// incentivized.
+func WeightedOperations(
+	appParams simtypes.AppParams, registry codectypes.InterfaceRegistry, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper,
+	transferKeeper TransferKeeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simulation.WeightedOperations {
+	cdc := codec.NewProtoCodec(registry)
+
+	var (
+		weightMsgChannelOpenInit           int
+		weightMsgPayPacketFeeAsync         int
+		weightMsgRegisterPayee             int
+		weightMsgRegisterCounterpartyPayee int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenInit, &weightMsgChannelOpenInit, nil,
+		func(_ *rand.Rand) { weightMsgChannelOpenInit = DefaultWeightMsgChannelOpenInit },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgPayPacketFeeAsync, &weightMsgPayPacketFeeAsync, nil,
+		func(_ *rand.Rand) { weightMsgPayPacketFeeAsync = DefaultWeightMsgPayPacketFeeAsync },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterPayee, &weightMsgRegisterPayee, nil,
+		func(_ *rand.Rand) { weightMsgRegisterPayee = DefaultWeightMsgRegisterPayee },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterCounterpartyPayee, &weightMsgRegisterCounterpartyPayee, nil,
+		func(_ *rand.Rand) { weightMsgRegisterCounterpartyPayee = DefaultWeightMsgRegisterCounterpartyPayee },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgChannelOpenInit, SimulateMsgChannelOpenInit(cdc, transferKeeper, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgPayPacketFeeAsync, SimulateMsgPayPacketFeeAsync(cdc, k, ibcKeeper, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgRegisterPayee, SimulateMsgRegisterPayee(cdc, k, ibcKeeper, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgRegisterCounterpartyPayee, SimulateMsgRegisterCounterpartyPayee(cdc, k, ibcKeeper, ak, bk)),
+	}
+}
+
+// SimulateMsgChannelOpenInit generates a MsgChannelOpenInit for a fee enabled transfer channel on the localhost connection.
+func SimulateMsgChannelOpenInit(
+	cdc *codec.ProtoCodec, transferKeeper TransferKeeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		version := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{
+			FeeVersion: types.Version,
+			AppVersion: transfertypes.SupportedVersions[r.Intn(len(transfertypes.SupportedVersions))],
+		}))
+
+		portID := transferKeeper.GetPort(ctx)
+		msg := channeltypes.NewMsgChannelOpenInit(
+			portID, version, channeltypes.UNORDERED, []string{exported.LocalhostConnectionID}, portID, simAccount.Address.String(),
+		)
+
+		opMsg, _, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk, nil))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgPayPacketFeeAsync generates a MsgPayPacketFeeAsync incentivizing a random in-flight packet sent over a
+// fee enabled channel on the localhost connection.
+func SimulateMsgPayPacketFeeAsync(
+	cdc *codec.ProtoCodec, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgPayPacketFeeAsync{})
+
+		var packetIDs []channeltypes.PacketId
+		for _, channel := range feeEnabledChannels(ctx, k, ibcKeeper) {
+			for _, commitment := range ibcKeeper.ChannelKeeper.GetAllPacketCommitmentsAtChannel(ctx, channel.PortId, channel.ChannelId) {
+				packetIDs = append(packetIDs, channeltypes.NewPacketID(commitment.PortId, commitment.ChannelId, commitment.Sequence))
+			}
+		}
+
+		if len(packetIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no in-flight packet on a fee enabled channel found"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account has no spendable coins"), nil, nil
+		}
+
+		// each of the fees is funded with at most a third of the spendable balance of a random denomination
+		coin := spendable[r.Intn(len(spendable))]
+		maxFee := coin.Amount.QuoRaw(3)
+		if !maxFee.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient spendable coins"), nil, nil
+		}
+
+		fee := types.NewFee(
+			sdk.NewCoins(sdk.NewCoin(coin.Denom, simtypes.RandomAmount(r, maxFee))),
+			sdk.NewCoins(sdk.NewCoin(coin.Denom, simtypes.RandomAmount(r, maxFee))),
+			sdk.NewCoins(sdk.NewCoin(coin.Denom, simtypes.RandomAmount(r, maxFee))),
+		)
+		if fee.Validate() != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all fees are zero"), nil, nil
+		}
+
+		packetFee := types.NewPacketFee(fee, simAccount.Address.String(), nil)
+		msg := types.NewMsgPayPacketFeeAsync(packetIDs[r.Intn(len(packetIDs))], packetFee)
+
+		opMsg, _, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk, fee.Total()))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgRegisterPayee generates a MsgRegisterPayee registering a random account as the payee of the
+// relayer for a fee enabled channel on the localhost connection.
+func SimulateMsgRegisterPayee(
+	cdc *codec.ProtoCodec, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterPayee{})
+
+		channels := feeEnabledChannels(ctx, k, ibcKeeper)
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fee enabled channel found"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		payee, _ := simtypes.RandomAcc(r, accs)
+		if relayer.Address.Equals(payee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "relayer and payee must not be equal"), nil, nil
+		}
+
+		msg := types.NewMsgRegisterPayee(channel.PortId, channel.ChannelId, relayer.Address.String(), payee.Address.String())
+
+		opMsg, _, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, relayer, ak, bk, nil))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgRegisterCounterpartyPayee generates a MsgRegisterCounterpartyPayee registering a random account as the
+// counterparty payee of the relayer for a fee enabled channel on the localhost connection.
+func SimulateMsgRegisterCounterpartyPayee(
+	cdc *codec.ProtoCodec, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		channels := feeEnabledChannels(ctx, k, ibcKeeper)
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRegisterCounterpartyPayee{}), "no fee enabled channel found"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		counterpartyPayee, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgRegisterCounterpartyPayee(channel.PortId, channel.ChannelId, relayer.Address.String(), counterpartyPayee.Address.String())
+
+		opMsg, _, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, relayer, ak, bk, nil))
+		return opMsg, nil, err
+	}
+}
+
+// feeEnabledChannels returns the open fee enabled channels on the localhost connection.
+func feeEnabledChannels(ctx sdk.Context, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcsims.GetLocalhostChannels(ctx, ibcKeeper) {
+		if channel.State == channeltypes.OPEN && k.IsFeeEnabled(ctx, channel.PortId, channel.ChannelId) {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
+
+// newOperationInput returns the operation input delivering the provided message signed by the simulation account.
+func newOperationInput(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec, msg sdk.Msg,
+	simAccount simtypes.Account, ak simulation.AccountKeeper, bk simulation.BankKeeper, coinsSpentInMsg sdk.Coins,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		CoinsSpentInMsg: coinsSpentInMsg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+}
@@ -0,0 +1,79 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/29-fee/simulation"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestSimulateFeeOperations(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	app := chain.GetSimApp()
+	cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+
+	// no payee is registered until a fee enabled channel is open
+	opMsg, _, err := simulation.SimulateMsgRegisterPayee(cdc, app.IBCFeeKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper)(
+		rand.New(rand.NewSource(1)), app.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID,
+	)
+	require.NoError(t, err)
+	require.False(t, opMsg.OK)
+
+	runOperation(t, chain, simulation.SimulateMsgChannelOpenInit(cdc, app.TransferKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenTry(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenAck(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenConfirm(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+
+	channels := ibcsims.GetLocalhostChannels(chain.GetContext(), app.IBCKeeper)
+	require.Len(t, channels, 2)
+	for _, channel := range channels {
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.True(t, app.IBCFeeKeeper.IsFeeEnabled(chain.GetContext(), channel.PortId, channel.ChannelId))
+	}
+
+	runOperation(t, chain, simulation.SimulateMsgRegisterPayee(cdc, app.IBCFeeKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, simulation.SimulateMsgRegisterCounterpartyPayee(cdc, app.IBCFeeKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+
+	coordinator.CommitBlock(chain)
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		channels[0].PortId, channels[0].ChannelId, ibctesting.TestCoin,
+		chain.SenderAccount.GetAddress().String(), chain.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(), uint64(chain.GetContext().BlockTime().UnixNano())+transfertypes.DefaultRelativePacketTimeoutTimestamp, "",
+	)
+	res, err := chain.SendMsgs(msgTransfer)
+	require.NoError(t, err)
+
+	packet, err := ibcsims.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	// the in-flight packet is incentivized
+	runOperation(t, chain, simulation.SimulateMsgPayPacketFeeAsync(cdc, app.IBCFeeKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+
+	_, found := app.IBCFeeKeeper.GetFeesInEscrow(chain.GetContext(), channeltypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	require.True(t, found)
+}
+
+// runOperation runs the simulation operation against the chain and requires the generated message to be delivered.
+func runOperation(t *testing.T, chain *ibctesting.TestChain, op simtypes.Operation) []simtypes.FutureOperation {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(1))
+	opMsg, futureOps, err := op(r, chain.App.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID)
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+
+	return futureOps
+}
@@ -0,0 +1,157 @@
+package ibccallbacks_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibccallbacks "github.com/cosmos/ibc-go/v7/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v7/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+const (
+	// maxCallbackGas is the maximum gas a callback may consume
+	maxCallbackGas = uint64(1_000_000)
+
+	// callbackAddress is the address the mock contract keeper is called back on
+	callbackAddress = "cosmos1callbackaddress"
+)
+
+// contractBehaviour defines how the mockContractKeeper handles a callback.
+type contractBehaviour int
+
+const (
+	behaviourSuccess contractBehaviour = iota
+	behaviourError
+	behaviourPanic
+	behaviourOutOfGas
+)
+
+var errContract = errors.New("contract error")
+
+var _ types.ContractKeeper = (*mockContractKeeper)(nil)
+
+// mockContractKeeper records the callbacks executed by the middleware. Each callback
+// writes to the store so that tests can assert that state changes are reverted on failure.
+type mockContractKeeper struct {
+	storeKey  storetypes.StoreKey
+	behaviour contractBehaviour
+	counters  map[types.CallbackType]int
+}
+
+func newMockContractKeeper(storeKey storetypes.StoreKey) *mockContractKeeper {
+	return &mockContractKeeper{
+		storeKey: storeKey,
+		counters: make(map[types.CallbackType]int),
+	}
+}
+
+func (k *mockContractKeeper) IBCSendPacketCallback(
+	ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, _, _ string,
+) error {
+	return k.execute(ctx, types.CallbackTypeSendPacket)
+}
+
+func (k *mockContractKeeper) IBCOnAcknowledgementPacketCallback(
+	ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, _, _ string,
+) error {
+	return k.execute(ctx, types.CallbackTypeAcknowledgementPacket)
+}
+
+func (k *mockContractKeeper) IBCOnTimeoutPacketCallback(
+	ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, _, _ string,
+) error {
+	return k.execute(ctx, types.CallbackTypeTimeoutPacket)
+}
+
+func (k *mockContractKeeper) IBCReceivePacketCallback(
+	ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _ string,
+) error {
+	return k.execute(ctx, types.CallbackTypeReceivePacket)
+}
+
+func (k *mockContractKeeper) execute(ctx sdk.Context, callbackType types.CallbackType) error {
+	k.counters[callbackType]++
+	ctx.KVStore(k.storeKey).Set(stateKey(callbackType), []byte{1})
+
+	switch k.behaviour {
+	case behaviourError:
+		return errContract
+	case behaviourPanic:
+		panic("contract panic")
+	case behaviourOutOfGas:
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "mock contract out of gas")
+	}
+
+	return nil
+}
+
+func stateKey(callbackType types.CallbackType) []byte {
+	return []byte(fmt.Sprintf("mock_callback/%s", callbackType))
+}
+
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	// the transfer keeper of chainA sends packets through the callbacks middleware
+	transferKeeperA transferkeeper.Keeper
+	middlewareA     ibccallbacks.IBCMiddleware
+	middlewareB     ibccallbacks.IBCMiddleware
+
+	contractKeeperA *mockContractKeeper
+	contractKeeperB *mockContractKeeper
+}
+
+func (s *CallbacksTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	s.coordinator.Setup(s.path)
+
+	s.contractKeeperA = newMockContractKeeper(s.chainA.GetSimApp().GetKey(transfertypes.StoreKey))
+	s.contractKeeperB = newMockContractKeeper(s.chainB.GetSimApp().GetKey(transfertypes.StoreKey))
+
+	s.transferKeeperA = s.chainA.GetSimApp().TransferKeeper
+	s.middlewareA = ibccallbacks.NewIBCMiddleware(
+		transfer.NewIBCModule(s.transferKeeperA), s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.contractKeeperA, maxCallbackGas,
+	)
+	s.transferKeeperA.WithICS4Wrapper(s.middlewareA)
+
+	s.middlewareB = ibccallbacks.NewIBCMiddleware(
+		transfer.NewIBCModule(s.chainB.GetSimApp().TransferKeeper), s.chainB.GetSimApp().IBCKeeper.ChannelKeeper, s.contractKeeperB, maxCallbackGas,
+	)
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+// callbackMemo returns a memo requesting a callback under the given key with the given gas limit.
+func callbackMemo(callbackKey string, gasLimit uint64) string {
+	return fmt.Sprintf(`{"%s": {"address": "%s", "gas_limit": "%d"}}`, callbackKey, callbackAddress, gasLimit)
+}
@@ -0,0 +1,12 @@
+/*
+Package ibccallbacks implements a middleware which notifies a ContractKeeper of the lifecycle
+of packets sent or received by the underlying application. Packets opt in to callbacks by
+specifying the callback address, and optionally a gas limit, within the data held on behalf
+of other applications by the underlying application's packet data (e.g. the memo field of
+transfer and interchain accounts packets).
+
+Callbacks are executed with a gas limit bounded by the maximum callback gas configured for
+the middleware. Errors, panics and out of gas errors of acknowledgement, timeout and receive
+callbacks revert the callback's state changes but do not affect the packet lifecycle.
+*/
+package ibccallbacks
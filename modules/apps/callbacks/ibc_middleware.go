@@ -0,0 +1,443 @@
+package ibccallbacks
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the callbacks middleware given the
+// underlying application. It notifies a ContractKeeper of the packet lifecycle events of
+// packets whose data requests a callback.
+type IBCMiddleware struct {
+	app         types.CallbacksCompatibleModule
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	contractKeeper types.ContractKeeper
+
+	// maxCallbackGas defines the maximum amount of gas that a callback can consume
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+// The underlying application must implement the required callback interfaces.
+func NewIBCMiddleware(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper types.ContractKeeper, maxCallbackGas uint64,
+) IBCMiddleware {
+	packetDataUnmarshalerApp, ok := app.(types.CallbacksCompatibleModule)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*types.CallbacksCompatibleModule)(nil)))
+	}
+
+	if ics4Wrapper == nil {
+		panic(fmt.Errorf("ICS4Wrapper cannot be nil"))
+	}
+
+	if contractKeeper == nil {
+		panic(fmt.Errorf("contract keeper cannot be nil"))
+	}
+
+	if maxCallbackGas == 0 {
+		panic(fmt.Errorf("maxCallbackGas cannot be zero"))
+	}
+
+	return IBCMiddleware{
+		app:            packetDataUnmarshalerApp,
+		ics4Wrapper:    ics4Wrapper,
+		contractKeeper: contractKeeper,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
+// middleware's creation to set the middleware which is above this module in
+// the IBC application stack.
+func (im *IBCMiddleware) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	im.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (im IBCMiddleware) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return im.ics4Wrapper
+}
+
+// SendPacket implements source callbacks for sending packets.
+// It defers to the underlying application and then calls the contract callback.
+// If the contract callback returns an error, panics, or runs out of gas, then
+// the packet send is rejected.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	seq, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(im.app, data, sourcePort, ctx.GasMeter().GasRemaining(), im.maxCallbackGas)
+	// SendPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return seq, nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCSendPacketCallback(
+			cachedCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	err = im.processCallback(ctx, types.CallbackTypeSendPacket, callbackData, callbackExecutor)
+	// contract keeper is allowed to reject the packet send.
+	if err != nil {
+		return 0, err
+	}
+
+	types.EmitCallbackEvent(ctx, sourcePort, sourceChannel, seq, types.CallbackTypeSendPacket, callbackData, nil)
+	return seq, nil
+}
+
+// OnAcknowledgementPacket implements source callbacks for acknowledgement packets.
+// It defers to the underlying application and then calls the contract callback.
+// If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
+// reverted via a panic.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	// we first call the underlying app to handle the acknowledgement
+	err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+	)
+	// OnAcknowledgementPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(
+			cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = im.processCallback(ctx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(
+		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		types.CallbackTypeAcknowledgementPacket, callbackData, err,
+	)
+
+	return nil
+}
+
+// OnTimeoutPacket implements timeout source callbacks for the ibc-callbacks middleware.
+// It defers to the underlying application and then calls the contract callback.
+// If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
+// reverted via a panic.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	err := im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+	)
+	// OnTimeoutPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = im.processCallback(ctx, types.CallbackTypeTimeoutPacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(
+		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		types.CallbackTypeTimeoutPacket, callbackData, err,
+	)
+
+	return nil
+}
+
+// OnRecvPacket implements the ReceivePacket destination callbacks for the ibc-callbacks middleware during
+// synchronous packet acknowledgement.
+// It defers to the underlying application and then calls the contract callback.
+// If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
+// reverted via a panic.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	// if ack is nil (asynchronous acknowledgements), then the callback will be handled in WriteAcknowledgement
+	// if ack is not successful, all state changes are reverted. If a packet cannot be received, then there is
+	// no need to execute a callback on the receiving chain.
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	callbackData, err := types.GetDestCallbackData(
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+	)
+	// OnRecvPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return ack
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = im.processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(
+		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		types.CallbackTypeReceivePacket, callbackData, err,
+	)
+
+	return ack
+}
+
+// WriteAcknowledgement implements the ReceivePacket destination callbacks for the ibc-callbacks middleware
+// during asynchronous packet acknowledgement.
+// It defers to the underlying application and then calls the contract callback.
+// If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
+// reverted via a panic.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	err := im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	if err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetDestCallbackData(
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+	)
+	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = im.processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(
+		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		types.CallbackTypeReceivePacket, callbackData, err,
+	)
+
+	return nil
+}
+
+// processCallback executes the callbackExecutor and reverts contract changes if the callbackExecutor fails.
+//
+// Error Precedence and Returns:
+//   - oogErr: Takes the highest precedence. If the callback runs out of gas, an error wrapped with types.ErrCallbackOutOfGas is returned.
+//   - panicErr: Takes the second-highest precedence. If a panic occurs and it is not propagated, an error wrapped with types.ErrCallbackPanic is returned.
+//   - callbackErr: If the callbackExecutor returns an error, it is returned as-is.
+//
+// panics if
+//   - the callback ran out of gas and the execution gas limit was lower than the commit gas limit,
+//     in which case the transaction may be retried with a higher gas limit.
+func (IBCMiddleware) processCallback(
+	ctx sdk.Context, callbackType types.CallbackType,
+	callbackData types.CallbackData, callbackExecutor func(sdk.Context) error,
+) (err error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(callbackData.ExecutionGasLimit))
+
+	defer func() {
+		// consume the minimum of g.consumed and g.limit
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+
+		// recover from all panics, panics are not allowed to block the packet lifecycle
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrCallbackPanic, "ibc %s callback panicked with: %v", callbackType, r)
+		}
+
+		// if the callback ran out of gas and the transaction did not provide enough gas to honour
+		// the callback gas limit, then the out of gas panic is propagated so that the transaction
+		// may be retried with a higher gas limit
+		if cachedCtx.GasMeter().IsOutOfGas() {
+			if callbackData.AllowRetry() {
+				panic(storetypes.ErrorOutOfGas{
+					Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", callbackType, callbackData.CommitGasLimit),
+				})
+			}
+			err = errorsmod.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas", callbackType)
+		}
+	}()
+
+	err = callbackExecutor(cachedCtx)
+	if err == nil {
+		writeFn()
+	}
+
+	return err
+}
+
+// OnChanOpenInit defers to the underlying application
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, channelOrdering, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry defers to the underlying application
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string, portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, channelOrdering, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck defers to the underlying application
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit defers to the underlying application
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface. Callbacks has no version,
+// so the call is deferred to the underlying application.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData defers to the underlying app to unmarshal the packet data.
+// This function implements the optional PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	return im.app.UnmarshalPacketData(bz)
+}
@@ -0,0 +1,333 @@
+package ibccallbacks_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibccallbacks "github.com/cosmos/ibc-go/v7/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v7/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+var timeoutHeight = clienttypes.NewHeight(1, 1000)
+
+// transfer sends a transfer from chainA through the callbacks middleware and returns the sent packet.
+func (s *CallbacksTestSuite) transfer(ctx sdk.Context, memo string) (channeltypes.Packet, error) {
+	coin := ibctesting.TestCoin
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, coin,
+		s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, memo,
+	)
+
+	res, err := s.transferKeeperA.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	packetData := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), msg.Sender, msg.Receiver, memo)
+	return channeltypes.NewPacket(
+		packetData.GetBytes(), res.Sequence,
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	), nil
+}
+
+func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
+	s.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(ibcmock.IBCModule{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.contractKeeperA, maxCallbackGas)
+	}, "underlying application must implement PacketDataUnmarshaler")
+
+	s.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(s.middlewareA, nil, s.contractKeeperA, maxCallbackGas)
+	}, "ics4Wrapper cannot be nil")
+
+	s.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(s.middlewareA, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, nil, maxCallbackGas)
+	}, "contract keeper cannot be nil")
+
+	s.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(s.middlewareA, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.contractKeeperA, 0)
+	}, "max callback gas cannot be zero")
+}
+
+func (s *CallbacksTestSuite) TestSendPacket() {
+	testCases := []struct {
+		name        string
+		memo        string
+		behaviour   contractBehaviour
+		expCallback bool
+		expPass     bool
+	}{
+		{"success", callbackMemo(types.SourceCallbackKey, 100_000), behaviourSuccess, true, true},
+		{"success: no callback requested", "", behaviourSuccess, false, true},
+		{"success: only destination callback requested", callbackMemo(types.DestinationCallbackKey, 100_000), behaviourSuccess, false, true},
+		{"failure: contract returns error", callbackMemo(types.SourceCallbackKey, 100_000), behaviourError, true, false},
+		{"failure: contract panics", callbackMemo(types.SourceCallbackKey, 100_000), behaviourPanic, true, false},
+		{"failure: contract runs out of gas", callbackMemo(types.SourceCallbackKey, 100_000), behaviourOutOfGas, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.contractKeeperA.behaviour = tc.behaviour
+
+			ctx := s.chainA.GetContext()
+			_, err := s.transfer(ctx, tc.memo)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+
+			expCount := 0
+			if tc.expCallback {
+				expCount = 1
+			}
+			s.Require().Equal(expCount, s.contractKeeperA.counters[types.CallbackTypeSendPacket])
+			s.Require().Equal(tc.expCallback && tc.expPass, ctx.KVStore(s.contractKeeperA.storeKey).Has(stateKey(types.CallbackTypeSendPacket)))
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		memo   string
+		ack    []byte
+		gasCtx func(sdk.Context) sdk.Context
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		behaviour    contractBehaviour
+		expCallback  bool
+		expCommitted bool
+		expPanic     bool
+		expError     bool
+	}{
+		{
+			"success", func() {}, behaviourSuccess, true, true, false, false,
+		},
+		{
+			"success: no callback requested", func() { memo = "" }, behaviourSuccess, false, false, false, false,
+		},
+		{
+			"success: contract error does not block acknowledgement", func() {}, behaviourError, true, false, false, false,
+		},
+		{
+			"success: contract panic does not block acknowledgement", func() {}, behaviourPanic, true, false, false, false,
+		},
+		{
+			"success: contract out of gas does not block acknowledgement", func() {}, behaviourOutOfGas, true, false, false, false,
+		},
+		{
+			"failure: out of gas with insufficient transaction gas is retried",
+			func() {
+				gasCtx = func(ctx sdk.Context) sdk.Context {
+					return ctx.WithGasMeter(storetypes.NewGasMeter(50_000))
+				}
+			},
+			behaviourOutOfGas, true, false, true, false,
+		},
+		{
+			"failure: underlying application returns error",
+			func() {
+				ack = []byte("invalid acknowledgement")
+			},
+			behaviourSuccess, false, false, false, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			memo = callbackMemo(types.SourceCallbackKey, 100_000)
+			ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+			gasCtx = func(ctx sdk.Context) sdk.Context { return ctx }
+
+			tc.malleate()
+
+			packet, err := s.transfer(s.chainA.GetContext(), memo)
+			s.Require().NoError(err)
+
+			s.contractKeeperA.behaviour = tc.behaviour
+			ctx := gasCtx(s.chainA.GetContext())
+
+			onAck := func() error {
+				return s.middlewareA.OnAcknowledgementPacket(ctx, packet, ack, s.chainA.SenderAccount.GetAddress())
+			}
+
+			if tc.expPanic {
+				s.Require().Panics(func() { _ = onAck() })
+				return
+			}
+
+			err = onAck()
+			if tc.expError {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			expCount := 0
+			if tc.expCallback {
+				expCount = 1
+			}
+			s.Require().Equal(expCount, s.contractKeeperA.counters[types.CallbackTypeAcknowledgementPacket])
+			s.Require().Equal(tc.expCommitted, ctx.KVStore(s.contractKeeperA.storeKey).Has(stateKey(types.CallbackTypeAcknowledgementPacket)))
+
+			if tc.expCallback {
+				s.assertCallbackEvent(ctx, types.EventTypeSourceCallback, types.CallbackTypeAcknowledgementPacket, tc.expCommitted)
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
+	testCases := []struct {
+		name         string
+		behaviour    contractBehaviour
+		expCommitted bool
+	}{
+		{"success", behaviourSuccess, true},
+		{"success: contract error does not block timeout", behaviourError, false},
+		{"success: contract panic does not block timeout", behaviourPanic, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			packet, err := s.transfer(s.chainA.GetContext(), callbackMemo(types.SourceCallbackKey, 100_000))
+			s.Require().NoError(err)
+
+			s.contractKeeperA.behaviour = tc.behaviour
+			ctx := s.chainA.GetContext()
+
+			err = s.middlewareA.OnTimeoutPacket(ctx, packet, s.chainA.SenderAccount.GetAddress())
+			s.Require().NoError(err)
+
+			s.Require().Equal(1, s.contractKeeperA.counters[types.CallbackTypeTimeoutPacket])
+			s.Require().Equal(tc.expCommitted, ctx.KVStore(s.contractKeeperA.storeKey).Has(stateKey(types.CallbackTypeTimeoutPacket)))
+			s.assertCallbackEvent(ctx, types.EventTypeSourceCallback, types.CallbackTypeTimeoutPacket, tc.expCommitted)
+		})
+	}
+}
+
+// newRecvPacket returns a transfer packet sent from chainA to chainB with the given memo.
+func (s *CallbacksTestSuite) newRecvPacket(memo string) channeltypes.Packet {
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		ibctesting.TestCoin.Denom, ibctesting.TestCoin.Amount.String(),
+		s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), memo,
+	)
+
+	return channeltypes.NewPacket(
+		packetData.GetBytes(), 1,
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	)
+}
+
+func (s *CallbacksTestSuite) TestOnRecvPacket() {
+	testCases := []struct {
+		name         string
+		memo         string
+		behaviour    contractBehaviour
+		expCallback  bool
+		expCommitted bool
+	}{
+		{"success", callbackMemo(types.DestinationCallbackKey, 100_000), behaviourSuccess, true, true},
+		{"success: no callback requested", "", behaviourSuccess, false, false},
+		{"success: only source callback requested", callbackMemo(types.SourceCallbackKey, 100_000), behaviourSuccess, false, false},
+		{"success: contract error does not block receive", callbackMemo(types.DestinationCallbackKey, 100_000), behaviourError, true, false},
+		{"success: contract out of gas does not block receive", callbackMemo(types.DestinationCallbackKey, 100_000), behaviourOutOfGas, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.contractKeeperB.behaviour = tc.behaviour
+
+			ctx := s.chainB.GetContext()
+			ack := s.middlewareB.OnRecvPacket(ctx, s.newRecvPacket(tc.memo), s.chainB.SenderAccount.GetAddress())
+			s.Require().True(ack.Success())
+
+			expCount := 0
+			if tc.expCallback {
+				expCount = 1
+			}
+			s.Require().Equal(expCount, s.contractKeeperB.counters[types.CallbackTypeReceivePacket])
+			s.Require().Equal(tc.expCommitted, ctx.KVStore(s.contractKeeperB.storeKey).Has(stateKey(types.CallbackTypeReceivePacket)))
+
+			if tc.expCallback {
+				s.assertCallbackEvent(ctx, types.EventTypeDestinationCallback, types.CallbackTypeReceivePacket, tc.expCommitted)
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
+	ctx := s.chainB.GetContext()
+	packet := s.newRecvPacket(callbackMemo(types.DestinationCallbackKey, 100_000))
+
+	chanCap, ok := s.chainB.GetSimApp().ScopedTransferKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	s.Require().True(ok)
+
+	err := s.middlewareB.WriteAcknowledgement(ctx, chanCap, packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	s.Require().NoError(err)
+
+	s.Require().Equal(1, s.contractKeeperB.counters[types.CallbackTypeReceivePacket])
+	s.Require().True(ctx.KVStore(s.contractKeeperB.storeKey).Has(stateKey(types.CallbackTypeReceivePacket)))
+
+	// the acknowledgement cannot be written twice
+	err = s.middlewareB.WriteAcknowledgement(ctx, chanCap, packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	s.Require().ErrorIs(err, channeltypes.ErrAcknowledgementExists)
+	s.Require().Equal(1, s.contractKeeperB.counters[types.CallbackTypeReceivePacket])
+
+	// an invalid capability is rejected before the callback is executed
+	err = s.middlewareB.WriteAcknowledgement(ctx, capabilitytypes.NewCapability(100), packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	s.Require().Error(err)
+	s.Require().Equal(1, s.contractKeeperB.counters[types.CallbackTypeReceivePacket])
+}
+
+// assertCallbackEvent asserts that a callback event of the given type was emitted with the expected result.
+func (s *CallbacksTestSuite) assertCallbackEvent(ctx sdk.Context, eventType string, callbackType types.CallbackType, success bool) {
+	expResult := types.AttributeValueCallbackFailure
+	if success {
+		expResult = types.AttributeValueCallbackSuccess
+	}
+
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		if event.Type != eventType {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+
+		if attributes[types.AttributeKeyCallbackType] == callbackType.String() {
+			s.Require().Equal(callbackAddress, attributes[types.AttributeKeyCallbackAddress])
+			s.Require().Equal(expResult, attributes[types.AttributeKeyCallbackResult])
+			return
+		}
+	}
+
+	s.Fail("callback event not found", callbackType)
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+/*
+The callbacks middleware reads the callback information from the packet data of the underlying
+application. Applications which carry a memo (e.g. transfer and interchain accounts) expose it
+through the PacketDataProvider interface. The memo must be a JSON object of the following form:
+
+	{
+	  "src_callback": {
+	    "address": "callbackAddrString",
+	    // optional
+	    "gas_limit": "userDefinedGasLimitString",
+	  },
+	  "dest_callback": {
+	    "address": "callbackAddrString",
+	    // optional
+	    "gas_limit": "userDefinedGasLimitString",
+	  }
+	}
+
+The source callback is executed on send, acknowledgement and timeout of the packet on the sending
+chain, the destination callback is executed once the acknowledgement is written on the receiving chain.
+*/
+
+// CallbackData is the callback data parsed from the packet.
+type CallbackData struct {
+	// CallbackAddress is the address of the callee.
+	CallbackAddress string
+	// ExecutionGasLimit is the gas limit which will be used for the callback execution.
+	ExecutionGasLimit uint64
+	// SenderAddress is the sender of the packet. This is passed to the contract keeper
+	// to verify that the packet sender is the same as the callback address if desired.
+	// This address is empty during destination callback execution.
+	SenderAddress string
+	// CommitGasLimit is the gas needed to commit the callback even if the callback
+	// execution fails due to out of gas.
+	// This parameter is only used in event emissions, or logging.
+	CommitGasLimit uint64
+}
+
+// GetSourceCallbackData parses the packet data and returns the source callback data.
+func GetSourceCallbackData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packetData []byte, srcPortID string, remainingGas, maxGas uint64,
+) (CallbackData, error) {
+	return getCallbackData(packetDataUnmarshaler, packetData, srcPortID, remainingGas, maxGas, SourceCallbackKey)
+}
+
+// GetDestCallbackData parses the packet data and returns the destination callback data.
+func GetDestCallbackData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packetData []byte, srcPortID string, remainingGas, maxGas uint64,
+) (CallbackData, error) {
+	return getCallbackData(packetDataUnmarshaler, packetData, srcPortID, remainingGas, maxGas, DestinationCallbackKey)
+}
+
+// getCallbackData parses the packet data and returns the callback data stored under the given callback key.
+// The packet sender is only retrieved for source callbacks.
+func getCallbackData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packetData []byte, srcPortID string, remainingGas,
+	maxGas uint64, callbackKey string,
+) (CallbackData, error) {
+	// unmarshal packet data
+	unmarshaledData, err := packetDataUnmarshaler.UnmarshalPacketData(packetData)
+	if err != nil {
+		return CallbackData{}, errorsmod.Wrap(ErrInvalidCallbackData, err.Error())
+	}
+
+	packetDataProvider, ok := unmarshaledData.(ibcexported.PacketDataProvider)
+	if !ok {
+		return CallbackData{}, ErrNotPacketDataProvider
+	}
+
+	callbackData, ok := packetDataProvider.GetCustomPacketData(callbackKey).(map[string]interface{})
+	if callbackData == nil || !ok {
+		return CallbackData{}, ErrCallbackKeyNotFound
+	}
+
+	// get the callback address from the callback data
+	callbackAddress := getCallbackAddress(callbackData)
+	if strings.TrimSpace(callbackAddress) == "" {
+		return CallbackData{}, ErrCallbackAddressNotFound
+	}
+
+	// retrieve packet sender from packet data if possible and if needed
+	var packetSender string
+	if callbackKey == SourceCallbackKey {
+		packetData, ok := unmarshaledData.(ibcexported.PacketData)
+		if ok {
+			packetSender = packetData.GetPacketSender(srcPortID)
+		}
+	}
+
+	// get the gas limit from the callback data
+	executionGasLimit, commitGasLimit := computeExecAndCommitGasLimit(callbackData, remainingGas, maxGas)
+
+	return CallbackData{
+		CallbackAddress:   callbackAddress,
+		ExecutionGasLimit: executionGasLimit,
+		SenderAddress:     packetSender,
+		CommitGasLimit:    commitGasLimit,
+	}, nil
+}
+
+// computeExecAndCommitGasLimit computes the execution and commit gas limits for the callback.
+// The commit gas limit is the user defined gas limit capped by the maximum callback gas allowed
+// by the middleware. The execution gas limit is the commit gas limit capped by the gas remaining
+// in the transaction.
+func computeExecAndCommitGasLimit(callbackData map[string]interface{}, remainingGas, maxGas uint64) (uint64, uint64) {
+	// get the gas limit from the callback data
+	commitGasLimit := getUserDefinedGasLimit(callbackData)
+
+	// ensure user defined gas limit does not exceed the max gas limit
+	if commitGasLimit == 0 || commitGasLimit > maxGas {
+		commitGasLimit = maxGas
+	}
+
+	// account for the remaining gas in the context being less than the desired gas limit for the callback execution
+	// in this case, the callback execution may be retried upon failure
+	executionGasLimit := commitGasLimit
+	if remainingGas < executionGasLimit {
+		executionGasLimit = remainingGas
+	}
+
+	return executionGasLimit, commitGasLimit
+}
+
+// getUserDefinedGasLimit returns the custom gas limit provided for callbacks if it is
+// in the callback data. It is assumed that callback data is not nil.
+// If no gas limit is specified or the gas limit is improperly formatted, 0 is returned.
+//
+// The memo is expected to specify the user defined gas limit in the following format:
+// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
+//
+// Note: the user defined gas limit must be set as a string and not a json number.
+func getUserDefinedGasLimit(callbackData map[string]interface{}) uint64 {
+	// the gas limit must be specified as a string and not a json number
+	gasLimit, ok := callbackData[UserDefinedGasLimitKey].(string)
+	if !ok {
+		return 0
+	}
+
+	userGas, err := strconv.ParseUint(gasLimit, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return userGas
+}
+
+// getCallbackAddress returns the callback address if it is specified in the callback data.
+// It is assumed that callback data is not nil.
+// If no callback address is specified or the memo is improperly formatted, an empty string is returned.
+//
+// The memo is expected to contain the callback address in the following format:
+// { "{callbackKey}": { "address": {stringCallbackAddress}}
+func getCallbackAddress(callbackData map[string]interface{}) string {
+	callbackAddress, ok := callbackData[CallbackAddressKey].(string)
+	if !ok {
+		return ""
+	}
+
+	return callbackAddress
+}
+
+// AllowRetry returns true if the callback execution gas limit is less than the commit gas limit.
+// In that case the callback ran with less gas than the user requested, because the transaction
+// did not provide enough gas, and a failure due to an out of gas error may be retried by resubmitting
+// the transaction with more gas.
+func (c CallbackData) AllowRetry() bool {
+	return c.ExecutionGasLimit < c.CommitGasLimit
+}
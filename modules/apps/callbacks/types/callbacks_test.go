@@ -0,0 +1,171 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	icacontroller "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+const (
+	sender   = "sender"
+	receiver = "receiver"
+	maxGas   = uint64(1_000_000)
+)
+
+func TestGetSourceCallbackData(t *testing.T) {
+	var (
+		unmarshaler  porttypes.PacketDataUnmarshaler
+		packetData   []byte
+		srcPortID    string
+		remainingGas uint64
+	)
+
+	transferPacketData := func(memo string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(ibctesting.TestCoin.Denom, "1000", sender, receiver, memo).GetBytes()
+	}
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		expCallbackData types.CallbackData
+		expErr          error
+	}{
+		{
+			"success: user defined gas limit",
+			func() {
+				packetData = transferPacketData(`{"src_callback": {"address": "callback", "gas_limit": "50000"}}`)
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: 50_000, SenderAddress: sender, CommitGasLimit: 50_000},
+			nil,
+		},
+		{
+			"success: no user defined gas limit defaults to max gas",
+			func() {
+				packetData = transferPacketData(`{"src_callback": {"address": "callback"}}`)
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas},
+			nil,
+		},
+		{
+			"success: user defined gas limit capped by max gas",
+			func() {
+				packetData = transferPacketData(fmt.Sprintf(`{"src_callback": {"address": "callback", "gas_limit": "%d"}}`, maxGas+1))
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas},
+			nil,
+		},
+		{
+			"success: json number gas limit is ignored",
+			func() {
+				packetData = transferPacketData(`{"src_callback": {"address": "callback", "gas_limit": 50000}}`)
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas},
+			nil,
+		},
+		{
+			"success: execution gas limited by remaining gas",
+			func() {
+				packetData = transferPacketData(`{"src_callback": {"address": "callback", "gas_limit": "50000"}}`)
+				remainingGas = 10_000
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: 10_000, SenderAddress: sender, CommitGasLimit: 50_000},
+			nil,
+		},
+		{
+			"success: interchain accounts packet sender is the port owner",
+			func() {
+				unmarshaler = icacontroller.IBCMiddleware{}
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: `{"src_callback": {"address": "callback"}}`,
+				}.GetBytes()
+				srcPortID = icatypes.ControllerPortPrefix + "owner"
+			},
+			types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: maxGas, SenderAddress: "owner", CommitGasLimit: maxGas},
+			nil,
+		},
+		{
+			"failure: empty memo",
+			func() {
+				packetData = transferPacketData("")
+			},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: memo is not a json object",
+			func() {
+				packetData = transferPacketData("memo")
+			},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: only destination callback",
+			func() {
+				packetData = transferPacketData(`{"dest_callback": {"address": "callback"}}`)
+			},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: empty callback address",
+			func() {
+				packetData = transferPacketData(`{"src_callback": {"address": " "}}`)
+			},
+			types.CallbackData{},
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"failure: invalid packet data",
+			func() {
+				packetData = []byte("invalid packet data")
+			},
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+	}
+
+	for _, tc := range testCases {
+		unmarshaler = transfer.IBCModule{}
+		srcPortID = transfertypes.PortID
+		remainingGas = maxGas * 2
+
+		tc.malleate()
+
+		callbackData, err := types.GetSourceCallbackData(unmarshaler, packetData, srcPortID, remainingGas, maxGas)
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+		require.Equal(t, tc.expCallbackData, callbackData, tc.name)
+	}
+}
+
+func TestGetDestCallbackData(t *testing.T) {
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		ibctesting.TestCoin.Denom, "1000", sender, receiver, `{"dest_callback": {"address": "callback", "gas_limit": "50000"}}`,
+	).GetBytes()
+
+	callbackData, err := types.GetDestCallbackData(transfer.IBCModule{}, packetData, transfertypes.PortID, maxGas, maxGas)
+	require.NoError(t, err)
+
+	// the packet sender is not provided to destination callbacks
+	require.Equal(t, types.CallbackData{CallbackAddress: "callback", ExecutionGasLimit: 50_000, CommitGasLimit: 50_000}, callbackData)
+	require.False(t, callbackData.AllowRetry())
+
+	_, err = types.GetSourceCallbackData(transfer.IBCModule{}, packetData, transfertypes.PortID, maxGas, maxGas)
+	require.ErrorIs(t, err, types.ErrCallbackKeyNotFound)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ibccallbacks sentinel errors
+var (
+	ErrNotPacketDataProvider   = errorsmod.Register(ModuleName, 2, "packet data is not a packet data provider")
+	ErrCallbackKeyNotFound     = errorsmod.Register(ModuleName, 3, "callback key not found in packet data")
+	ErrCallbackAddressNotFound = errorsmod.Register(ModuleName, 4, "callback address not found in packet data")
+	ErrCallbackOutOfGas        = errorsmod.Register(ModuleName, 5, "callback out of gas")
+	ErrCallbackPanic           = errorsmod.Register(ModuleName, 6, "callback panic")
+	ErrInvalidCallbackData     = errorsmod.Register(ModuleName, 7, "invalid callback data")
+)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// CallbacksCompatibleModule is an interface that combines the IBCModule and PacketDataUnmarshaler
+// interfaces to assert that the underlying application supports both.
+type CallbacksCompatibleModule interface {
+	porttypes.IBCModule
+	porttypes.PacketDataUnmarshaler
+}
+
+// ContractKeeper defines the entry points exposed to the callbacks middleware by a module
+// executing smart contracts or other packet lifecycle handlers on behalf of the callback address.
+//
+// The middleware executes each entry point within a cached context whose gas meter is limited by the
+// callback gas limit. State changes are only committed if the entry point returns without error.
+// With the exception of IBCSendPacketCallback, errors and panics do not affect the packet lifecycle.
+type ContractKeeper interface {
+	// IBCSendPacketCallback is called in the source chain when a PacketSend is executed. The
+	// packetSenderAddress is determined by the underlying module, and may be empty if the sender is
+	// unknown or undefined. The contract is expected to handle the callback within the user defined
+	// gas limit, and handle any errors, or panics gracefully.
+	// If an error is returned, the transaction will be reverted by the callbacks middleware, and the
+	// packet will not be sent.
+	IBCSendPacketCallback(
+		ctx sdk.Context,
+		sourcePort string,
+		sourceChannel string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		packetData []byte,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnAcknowledgementPacketCallback is called in the source chain when a packet acknowledgement
+	// is received. The packetSenderAddress is determined by the underlying module, and may be empty if
+	// the sender is unknown or undefined. The contract is expected to handle the callback within the
+	// user defined gas limit, and handle any errors, or panics gracefully.
+	// If an error is returned, state will be reverted by the callbacks middleware.
+	IBCOnAcknowledgementPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnTimeoutPacketCallback is called in the source chain when a packet is not received before
+	// the timeout height. The packetSenderAddress is determined by the underlying module, and may be
+	// empty if the sender is unknown or undefined. The contract is expected to handle the callback
+	// within the user defined gas limit, and handle any error, out of gas, or panics gracefully.
+	// If an error is returned, state will be reverted by the callbacks middleware.
+	IBCOnTimeoutPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCReceivePacketCallback is called in the destination chain when a packet acknowledgement is written.
+	// The contract is expected to handle the callback within the user defined gas limit, and handle any errors,
+	// out of gas, or panics gracefully.
+	// If an error is returned, state will be reverted by the callbacks middleware.
+	IBCReceivePacketCallback(
+		ctx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+	) error
+}
@@ -0,0 +1,33 @@
+package types
+
+const (
+	// ModuleName defines the callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the key used to store the source callback data in the packet memo.
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the key used to store the destination callback data in the packet memo.
+	DestinationCallbackKey = "dest_callback"
+
+	// CallbackAddressKey is the key used to store the callback address in the callback data.
+	CallbackAddressKey = "address"
+
+	// UserDefinedGasLimitKey is the key used to store the user defined gas limit in the callback data.
+	UserDefinedGasLimitKey = "gas_limit"
+)
+
+// CallbackType defines the type of a packet lifecycle callback.
+type CallbackType string
+
+const (
+	CallbackTypeSendPacket            CallbackType = "send_packet"
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+)
+
+// String returns the string representation of the callback type.
+func (ct CallbackType) String() string {
+	return string(ct)
+}
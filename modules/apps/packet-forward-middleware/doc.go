@@ -0,0 +1,13 @@
+/*
+Package packetforward implements a middleware which forwards received ICS-20 transfers to
+another chain. Transfers opt in to forwarding by specifying the next receiver, port and channel,
+and optionally a timeout, a number of retries and the memo of the forwarded transfer, under the
+forward key of the transfer memo. Nesting forward metadata within the next memo allows funds to
+be routed over multiple hops with a single transfer.
+
+The acknowledgement of a received transfer is written asynchronously once the forwarded transfer
+is acknowledged. If the forwarded transfer fails or times out with no retries remaining, the
+receipt of the funds is reverted and an error acknowledgement is written, such that the original
+sender is refunded by the sending chain.
+*/
+package packetforward
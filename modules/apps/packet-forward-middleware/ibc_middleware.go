@@ -0,0 +1,299 @@
+package packetforward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/keeper"
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware given the
+// underlying transfer application. Received transfers whose memo holds forward metadata are
+// forwarded to the next chain and acknowledged once the forwarded packet is acknowledged.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit defers to the underlying application
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, channelOrdering, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry defers to the underlying application
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string, portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, channelOrdering, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck defers to the underlying application
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit defers to the underlying application
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the memo of the transfer packet data holds forward metadata, the funds are received by the
+// hold address for the packet and forwarded as specified by the metadata. The acknowledgement is
+// written asynchronously once the forwarded packet is acknowledged or times out. Forward metadata
+// may not be set on packets carrying forwarding information, which are forwarded by the transfer
+// module.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	data, err := im.keeper.UnmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, found, err := types.GetForwardMetadata(data.Memo)
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the funds of packets carrying forwarding information are forwarded by the transfer module
+	if data.ShouldBeForwarded() {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrInvalidForwardMetadata, "forward metadata cannot be set on packets carrying forwarding information"))
+	}
+
+	// the funds are received by the hold address until they are forwarded
+	holdData := data
+	holdData.Receiver = types.GetHoldAddress(packet.DestinationChannel, data.Sender).String()
+	holdData.Memo = ""
+
+	holdPacketData, err := im.keeper.MarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), holdData)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	holdPacket := packet
+	holdPacket.Data = holdPacketData
+
+	ack := im.app.OnRecvPacket(ctx, holdPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardPacket(ctx, packet, data, *metadata); err != nil {
+		im.keeper.Logger(ctx).Error("failed to forward packet", "error", err.Error())
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written once the forwarded packet is acknowledged or times out
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// If the packet was forwarded on behalf of a received packet, the acknowledgement is written
+// for the received packet.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	// the underlying application refunds the hold address upon an error acknowledgement
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// If the packet was forwarded on behalf of a received packet, the forwarded packet is resent
+// while retries remain. Otherwise an error acknowledgement is written for the received packet.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	// the underlying application refunds the hold address
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+
+	if inFlightPacket.RetriesRemaining > 0 {
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := im.keeper.RetryForwardPacket(cacheCtx, packet, inFlightPacket)
+		if err == nil {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			return nil
+		}
+
+		im.keeper.Logger(ctx).Error("failed to retry forwarded packet", "error", err.Error())
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrForwardTimeout, "packet sequence %d", packet.Sequence))
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, inFlightPacket, ack)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	return im.keeper.GetICS4Wrapper().SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.keeper.GetICS4Wrapper().WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.keeper.GetICS4Wrapper().GetAppVersion(ctx, portID, channelID)
+}
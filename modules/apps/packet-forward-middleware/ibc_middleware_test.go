@@ -0,0 +1,435 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAToB = newTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAToB)
+
+	suite.pathBToC = newTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// forwardMemo returns a memo forwarding the packet received on chainB to the given receiver on chainC.
+func (suite *PacketForwardTestSuite) forwardMemo(receiver string, options string) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"%s}}`,
+		receiver, suite.pathBToC.EndpointA.ChannelConfig.PortID, suite.pathBToC.EndpointA.ChannelID, options,
+	)
+}
+
+// transferFromA sends a transfer from chainA to chainB with the given memo and returns the sent packet.
+func (suite *PacketForwardTestSuite) transferFromA(coin sdk.Coin, memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathAToB.EndpointB.UpdateClient())
+
+	return packet
+}
+
+// recvOnB receives the packet sent from chainA on chainB and returns the packet forwarded to chainC.
+func (suite *PacketForwardTestSuite) recvOnB(packet channeltypes.Packet) channeltypes.Packet {
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is not written until the forwarded packet is acknowledged
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathBToC.EndpointB.UpdateClient())
+
+	return forwardPacket
+}
+
+// relayAckToA relays the acknowledgement written on chainB for the packet sent from chainA.
+func (suite *PacketForwardTestSuite) relayAckToA(packet channeltypes.Packet, ack []byte) {
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+	)
+	suite.Require().True(found)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacket() {
+	amount := sdkmath.NewInt(100)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	packet := suite.transferFromA(coin, suite.forwardMemo(receiver.String(), ""))
+	forwardPacket := suite.recvOnB(packet)
+
+	var forwardData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(forwardPacket.GetData(), &forwardData))
+	suite.Require().Equal(receiver.String(), forwardData.Receiver)
+	suite.Require().Equal(types.GetHoldAddress(packet.DestinationChannel, suite.chainA.SenderAccount.GetAddress().String()).String(), forwardData.Sender)
+	suite.Require().Empty(forwardData.Memo)
+
+	_, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourceChannel, forwardPacket.SourcePort, forwardPacket.Sequence)
+	suite.Require().True(found)
+
+	res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwardPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathBToC.EndpointA.AcknowledgePacket(forwardPacket, ack))
+
+	_, found = suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourceChannel, forwardPacket.SourcePort, forwardPacket.Sequence)
+	suite.Require().False(found)
+
+	suite.relayAckToA(packet, ack)
+
+	// the funds are escrowed on chainB and the receiver on chainC holds the vouchers
+	voucherDenomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+	escrowAddress := transfertypes.GetEscrowAddress(forwardPacket.SourcePort, forwardPacket.SourceChannel)
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, voucherDenomOnB.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+
+	voucherDenomOnC := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(forwardPacket.DestinationPort, forwardPacket.DestinationChannel, voucherDenomOnB.GetFullDenomPath()))
+	balance = suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, voucherDenomOnC.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketMultiHop() {
+	amount := sdkmath.NewInt(100)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// forward the packet to chainC and back to chainB
+	next := fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
+		receiver, suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+	)
+	memo := suite.forwardMemo(suite.chainC.SenderAccount.GetAddress().String(), fmt.Sprintf(`,"next":%q`, next))
+
+	packet := suite.transferFromA(coin, memo)
+	forwardPacket := suite.recvOnB(packet)
+
+	res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwardPacket)
+	suite.Require().NoError(err)
+
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	returnPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+
+	res, err = suite.pathBToC.EndpointA.RecvPacketWithResult(returnPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathBToC.EndpointB.AcknowledgePacket(returnPacket, ack))
+
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathBToC.EndpointA.AcknowledgePacket(forwardPacket, ack))
+
+	suite.relayAckToA(packet, ack)
+
+	// the vouchers returned to chainB are unescrowed to the receiver
+	voucherDenomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenomOnB.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+
+	totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), voucherDenomOnB.IBCDenom())
+	suite.Require().True(totalEscrow.Amount.IsZero())
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketErrorAcknowledgement() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	// the forwarded packet fails to be received on chainC
+	packet := suite.transferFromA(coin, suite.forwardMemo("invalid address", ""))
+	forwardPacket := suite.recvOnB(packet)
+
+	res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwardPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathBToC.EndpointA.AcknowledgePacket(forwardPacket, ack))
+
+	suite.relayAckToA(packet, ack)
+
+	// the vouchers received on chainB are burned and the sender on chainA is refunded
+	voucherDenomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+	supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenomOnB.IBCDenom())
+	suite.Require().True(supply.Amount.IsZero())
+
+	totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), voucherDenomOnB.IBCDenom())
+	suite.Require().True(totalEscrow.Amount.IsZero())
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeout() {
+	testCases := []struct {
+		name    string
+		retries uint8
+	}{
+		{
+			"no retries: error acknowledgement is written",
+			0,
+		},
+		{
+			"retries remaining: forwarded packet is resent",
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+			memo := suite.forwardMemo(suite.chainC.SenderAccount.GetAddress().String(), fmt.Sprintf(`,"timeout":"1s","retries":%d`, tc.retries))
+			packet := suite.transferFromA(coin, memo)
+			forwardPacket := suite.recvOnB(packet)
+
+			// advance chainC past the timeout of the forwarded packet
+			suite.coordinator.CommitBlock(suite.chainC)
+			suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+
+			proof, proofHeight := suite.chainC.QueryProof(host.PacketReceiptKey(forwardPacket.DestinationPort, forwardPacket.DestinationChannel, forwardPacket.Sequence))
+			msg := channeltypes.NewMsgTimeout(forwardPacket, 1, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			_, err := suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			pfmKeeper := suite.chainB.GetSimApp().PacketForwardKeeper
+			_, found := pfmKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourceChannel, forwardPacket.SourcePort, forwardPacket.Sequence)
+			suite.Require().False(found)
+
+			if tc.retries > 0 {
+				inFlightPacket, found := pfmKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.SourceChannel, forwardPacket.SourcePort, forwardPacket.Sequence+1)
+				suite.Require().True(found)
+				suite.Require().Equal(int32(tc.retries-1), inFlightPacket.RetriesRemaining)
+
+				_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+					suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+				)
+				suite.Require().False(found)
+
+				return
+			}
+
+			ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardTimeout)
+			suite.relayAckToA(packet, ack.Acknowledgement())
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(originalBalance, balance)
+		})
+	}
+}
+
+func (suite *PacketForwardTestSuite) TestOnRecvPacketWithoutForward() {
+	testCases := []struct {
+		name       string
+		memo       string
+		expSuccess bool
+	}{
+		{
+			"success: empty memo",
+			"",
+			true,
+		},
+		{
+			"success: memo is not a JSON object",
+			"forward",
+			true,
+		},
+		{
+			"success: memo has no forward metadata",
+			`{"wasm":{}}`,
+			true,
+		},
+		{
+			"failure: invalid forward metadata",
+			`{"forward":{"port":"transfer","channel":"channel-1"}}`,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			packet := suite.transferFromA(coin, tc.memo)
+
+			res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			// the acknowledgement is written synchronously
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom.IBCDenom())
+			suite.Require().Equal(tc.expSuccess, balance.Amount.Equal(coin.Amount))
+		})
+	}
+}
+
+func (suite *PacketForwardTestSuite) TestOnRecvPacketWithForwarding() {
+	suite.setupV2Paths()
+
+	data := transfertypes.NewFungibleTokenPacketDataV2(
+		[]transfertypes.Token{transfertypes.NewToken(sdk.DefaultBondDenom, "100")},
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(),
+		suite.forwardMemo(suite.chainC.SenderAccount.GetAddress().String(), ""),
+	)
+	data.Forwarding = transfertypes.NewForwardingPacketData("", transfertypes.NewHop(suite.pathBToC.EndpointA.ChannelConfig.PortID, suite.pathBToC.EndpointA.ChannelID))
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID,
+		suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 110), 0,
+	)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+	suite.Require().True(ok)
+
+	// the packet is rejected rather than being forwarded by both the middleware and the transfer module
+	ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().False(ack.Success())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom.IBCDenom()).IsZero())
+}
+
+func (suite *PacketForwardTestSuite) TestRetryForwardPacketWithForwarding() {
+	suite.setupV2Paths()
+
+	holdAddress := types.GetHoldAddress(suite.pathAToB.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), holdAddress, sdk.NewCoins(coin)))
+
+	// the packet which timed out carries the remaining hops and the destination memo
+	forwarding := transfertypes.NewForwardingPacketData("destination memo", transfertypes.NewHop(ibctesting.TransferPort, "channel-5"))
+	data := transfertypes.NewFungibleTokenPacketDataV2(
+		[]transfertypes.Token{transfertypes.NewToken(coin.Denom, coin.Amount.String())},
+		holdAddress.String(), suite.chainC.SenderAccount.GetAddress().String(), "",
+	)
+	data.Forwarding = forwarding
+
+	timedOutPacket := channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		suite.pathBToC.EndpointA.ChannelConfig.PortID, suite.pathBToC.EndpointA.ChannelID,
+		suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(), 1,
+	)
+
+	inFlightPacket := types.InFlightPacket{
+		OriginalSenderAddress: holdAddress.String(),
+		RefundChannelId:       suite.pathAToB.EndpointB.ChannelID,
+		RefundPortId:          suite.pathAToB.EndpointB.ChannelConfig.PortID,
+		PacketSrcChannelId:    suite.pathAToB.EndpointA.ChannelID,
+		PacketSrcPortId:       suite.pathAToB.EndpointA.ChannelConfig.PortID,
+		PacketTimeoutHeight:   "1-110",
+		RefundSequence:        1,
+		RetriesRemaining:      1,
+		Timeout:               uint64(types.DefaultForwardTimeout.Nanoseconds()),
+	}
+
+	ctx := suite.chainB.GetContext()
+	suite.Require().NoError(suite.chainB.GetSimApp().PacketForwardKeeper.RetryForwardPacket(ctx, timedOutPacket, inFlightPacket))
+
+	retriedPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+
+	var retriedData transfertypes.FungibleTokenPacketDataV2
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(retriedPacket.GetData(), &retriedData))
+	suite.Require().Equal(forwarding, retriedData.Forwarding)
+	suite.Require().Empty(retriedData.Memo)
+}
+
+// setupV2Paths sets up ics20-2 paths from chainA to chainB and from chainB to chainC.
+func (suite *PacketForwardTestSuite) setupV2Paths() {
+	suite.pathAToB = newTransferPath(suite.chainA, suite.chainB)
+	suite.pathAToB.EndpointA.ChannelConfig.Version = transfertypes.V2
+	suite.pathAToB.EndpointB.ChannelConfig.Version = transfertypes.V2
+	suite.coordinator.Setup(suite.pathAToB)
+
+	suite.pathBToC = newTransferPath(suite.chainB, suite.chainC)
+	suite.pathBToC.EndpointA.ChannelConfig.Version = transfertypes.V2
+	suite.pathBToC.EndpointB.ChannelConfig.Version = transfertypes.V2
+	suite.coordinator.Setup(suite.pathBToC)
+}
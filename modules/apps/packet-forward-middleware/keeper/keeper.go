@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper used by the keeper to write acknowledgements.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetInFlightPacket returns the in flight packet stored for the forwarded packet sent over the
+// given channel and port with the given sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RefundPacketKey(channelID, portID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+
+	return inFlightPacket, true
+}
+
+// SetInFlightPacket stores the in flight packet for the forwarded packet sent over the given
+// channel and port with the given sequence.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RefundPacketKey(channelID, portID, sequence), k.cdc.MustMarshal(&inFlightPacket))
+}
+
+// DeleteInFlightPacket removes the in flight packet stored for the forwarded packet sent over the
+// given channel and port with the given sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RefundPacketKey(channelID, portID, sequence))
+}
+
+// GetAllInFlightPackets returns all in flight packets keyed by their refund packet key.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) map[string]types.InFlightPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	inFlightPackets := make(map[string]types.InFlightPacket)
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlightPacket types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlightPacket)
+
+		inFlightPackets[string(iterator.Key())] = inFlightPacket
+	}
+
+	return inFlightPackets
+}
+
+// InitGenesis initializes the packet forward middleware state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	store := ctx.KVStore(k.storeKey)
+	for key, inFlightPacket := range state.InFlightPackets {
+		inFlightPacket := inFlightPacket
+		store.Set([]byte(key), k.cdc.MustMarshal(&inFlightPacket))
+	}
+}
+
+// ExportGenesis returns the packet forward middleware exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllInFlightPackets(ctx))
+}
+
+// emitForwardEvent emits an event for a packet forwarded on behalf of the given in flight packet.
+func emitForwardEvent(ctx sdk.Context, eventType, receiver, portID, channelID string, sequence uint64, inFlightPacket types.InFlightPacket) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyForwardReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundPort, inFlightPacket.RefundPortId),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, fmt.Sprintf("%d", inFlightPacket.RefundSequence)),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprintf("%d", inFlightPacket.RetriesRemaining)),
+		),
+	)
+}
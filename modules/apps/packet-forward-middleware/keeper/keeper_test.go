@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestInFlightPackets() {
+	keeper := suite.chainA.GetSimApp().PacketForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetInFlightPacket(ctx, ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+	suite.Require().False(found)
+
+	inFlightPacket := types.InFlightPacket{
+		RefundChannelId: ibctesting.FirstChannelID,
+		RefundPortId:    ibctesting.TransferPort,
+		RefundSequence:  1,
+	}
+	keeper.SetInFlightPacket(ctx, ibctesting.FirstChannelID, ibctesting.TransferPort, 1, inFlightPacket)
+
+	stored, found := keeper.GetInFlightPacket(ctx, ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(inFlightPacket, stored)
+
+	keeper.DeleteInFlightPacket(ctx, ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+
+	_, found = keeper.GetInFlightPacket(ctx, ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	inFlightPackets := make(map[string]types.InFlightPacket)
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		key := types.RefundPacketKey(ibctesting.FirstChannelID, ibctesting.TransferPort, sequence)
+		inFlightPackets[string(key)] = types.InFlightPacket{
+			OriginalSenderAddress: suite.chainA.SenderAccount.GetAddress().String(),
+			RefundChannelId:       ibctesting.FirstChannelID,
+			RefundPortId:          ibctesting.TransferPort,
+			PacketSrcChannelId:    ibctesting.FirstChannelID,
+			PacketSrcPortId:       ibctesting.TransferPort,
+			PacketTimeoutHeight:   "1-100",
+			PacketData:            []byte("data"),
+			RefundSequence:        sequence,
+		}
+	}
+
+	genesis := types.NewGenesisState(inFlightPackets)
+	suite.Require().NoError(genesis.Validate())
+
+	keeper := suite.chainA.GetSimApp().PacketForwardKeeper
+	keeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	stored, found := keeper.GetInFlightPacket(suite.chainA.GetContext(), ibctesting.FirstChannelID, ibctesting.TransferPort, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(inFlightPackets[string(types.RefundPacketKey(ibctesting.FirstChannelID, ibctesting.TransferPort, 2))], stored)
+
+	suite.Require().Equal(genesis, keeper.ExportGenesis(suite.chainA.GetContext()))
+}
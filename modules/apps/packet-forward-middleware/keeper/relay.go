@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+// ForwardPacket forwards the funds received in the given packet, which are expected to be held by
+// the hold address for the packet, as specified by the forward metadata. The received packet is
+// stored as in flight until the forwarded packet is acknowledged or times out.
+func (k Keeper) ForwardPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketDataV2, metadata types.ForwardMetadata) error {
+	tokens := sdk.NewCoins()
+	for _, token := range data.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		denom := getDenomForThisChain(packet.DestinationPort, packet.DestinationChannel, packet.SourcePort, packet.SourceChannel, token.Denom)
+		tokens = tokens.Add(sdk.NewCoin(denom, amount))
+	}
+
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	holdAddress := types.GetHoldAddress(packet.DestinationChannel, data.Sender)
+
+	inFlightPacket := types.InFlightPacket{
+		OriginalSenderAddress:  holdAddress.String(),
+		RefundChannelId:        packet.DestinationChannel,
+		RefundPortId:           packet.DestinationPort,
+		PacketSrcChannelId:     packet.SourceChannel,
+		PacketSrcPortId:        packet.SourcePort,
+		PacketTimeoutTimestamp: packet.TimeoutTimestamp,
+		PacketTimeoutHeight:    packet.TimeoutHeight.String(),
+		PacketData:             packet.Data,
+		RefundSequence:         packet.Sequence,
+		RetriesRemaining:       int32(metadata.GetRetries()),
+		Timeout:                uint64(metadata.GetTimeout().Nanoseconds()),
+	}
+
+	sequence, err := k.sendForwardPacket(ctx, inFlightPacket, tokens, metadata.Receiver, metadata.Port, metadata.Channel, memo, nil)
+	if err != nil {
+		return err
+	}
+
+	emitForwardEvent(ctx, types.EventTypeForwardPacket, metadata.Receiver, metadata.Port, metadata.Channel, sequence, inFlightPacket)
+
+	return nil
+}
+
+// RetryForwardPacket resends a forwarded packet which timed out. The funds of the timed out packet
+// are expected to have been refunded to the hold address. The memo and forwarding information of
+// the timed out packet are carried by the resent packet.
+func (k Keeper) RetryForwardPacket(ctx sdk.Context, packet channeltypes.Packet, inFlightPacket types.InFlightPacket) error {
+	if inFlightPacket.RetriesRemaining <= 0 {
+		return errorsmod.Wrap(types.ErrForwardTimeout, "no retries remaining")
+	}
+
+	data, err := k.UnmarshalPacketData(ctx, packet.SourcePort, packet.SourceChannel, packet.GetData())
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	tokens := sdk.NewCoins()
+	for _, token := range data.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		// the denomination of the sent packet is the full denomination path on this chain
+		tokens = tokens.Add(sdk.NewCoin(transfertypes.ParseDenomTrace(token.Denom).IBCDenom(), amount))
+	}
+
+	inFlightPacket.RetriesRemaining--
+	sequence, err := k.sendForwardPacket(ctx, inFlightPacket, tokens, data.Receiver, packet.SourcePort, packet.SourceChannel, data.Memo, data.Forwarding)
+	if err != nil {
+		return err
+	}
+
+	emitForwardEvent(ctx, types.EventTypeForwardRetry, data.Receiver, packet.SourcePort, packet.SourceChannel, sequence, inFlightPacket)
+
+	return nil
+}
+
+// WriteAcknowledgementForForwardedPacket writes the acknowledgement of a forwarded packet to the
+// received packet it was forwarded on behalf of. If the acknowledgement is an error, the funds
+// are expected to have been refunded to the hold address and the effects of receiving the packet
+// are reverted, such that the sending chain may safely refund the original sender.
+func (k Keeper) WriteAcknowledgementForForwardedPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	if !ack.Success() {
+		if err := k.revertReceive(ctx, inFlightPacket); err != nil {
+			return err
+		}
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(inFlightPacket.PacketTimeoutHeight)
+	if err != nil {
+		return err
+	}
+
+	packet := channeltypes.NewPacket(
+		inFlightPacket.PacketData,
+		inFlightPacket.RefundSequence,
+		inFlightPacket.PacketSrcPortId,
+		inFlightPacket.PacketSrcChannelId,
+		inFlightPacket.RefundPortId,
+		inFlightPacket.RefundChannelId,
+		timeoutHeight,
+		inFlightPacket.PacketTimeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardAcknowledge,
+			sdk.NewAttribute(types.AttributeKeyRefundPort, inFlightPacket.RefundPortId),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
+		),
+	)
+
+	return nil
+}
+
+// sendForwardPacket transfers the tokens from the hold address of the in flight packet and stores
+// the in flight packet under the sequence of the sent packet. Multiple tokens and forwarding
+// information may only be sent over ics20-2 channels.
+func (k Keeper) sendForwardPacket(
+	ctx sdk.Context, inFlightPacket types.InFlightPacket, tokens sdk.Coins,
+	receiver, portID, channelID, memo string, forwarding *transfertypes.ForwardingPacketData,
+) (uint64, error) {
+	timeout := time.Duration(inFlightPacket.Timeout)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	var msg *transfertypes.MsgTransfer
+	if len(tokens) == 1 {
+		msg = transfertypes.NewMsgTransfer(
+			portID, channelID, tokens[0], inFlightPacket.OriginalSenderAddress, receiver,
+			clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+		)
+	} else {
+		msg = transfertypes.NewMsgTransferWithTokens(
+			portID, channelID, tokens, inFlightPacket.OriginalSenderAddress, receiver,
+			clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+		)
+	}
+
+	if forwarding != nil {
+		// the memo of a packet carrying forwarding information is the destination memo
+		msg.Memo = forwarding.DestinationMemo
+		msg.ForwardingHops = forwarding.Hops
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, channelID, portID, res.Sequence, inFlightPacket)
+
+	return res.Sequence, nil
+}
+
+// revertReceive reverts the effects of receiving the in flight packet. Vouchers minted upon receive
+// are burned and native tokens unescrowed upon receive are escrowed again.
+func (k Keeper) revertReceive(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	data, err := k.UnmarshalPacketData(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, inFlightPacket.PacketData)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	holdAddress, err := sdk.AccAddressFromBech32(inFlightPacket.OriginalSenderAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, packetToken := range data.Tokens {
+		amount, ok := sdkmath.NewIntFromString(packetToken.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", packetToken.Amount)
+		}
+
+		denom := getDenomForThisChain(
+			inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId,
+			inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId,
+			packetToken.Denom,
+		)
+		token := sdk.NewCoin(denom, amount)
+
+		if transfertypes.ReceiverChainIsSource(inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId, packetToken.Denom) {
+			escrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+			if err := k.bankKeeper.SendCoins(ctx, holdAddress, escrowAddress, sdk.NewCoins(token)); err != nil {
+				return err
+			}
+
+			currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.GetDenom())
+			k.transferKeeper.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
+
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holdAddress, transfertypes.ModuleName, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalPacketData unmarshals the transfer packet data according to the application version
+// of the given channel, such that ics20-1 and ics20-2 packets are forwarded alike.
+func (k Keeper) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, error) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketData(bz, appVersion)
+}
+
+// MarshalPacketData marshals the transfer packet data according to the application version of
+// the given channel.
+func (k Keeper) MarshalPacketData(ctx sdk.Context, portID, channelID string, data transfertypes.FungibleTokenPacketDataV2) ([]byte, error) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return transfertypes.MarshalPacketData(data, appVersion)
+}
+
+// getDenomForThisChain returns the denomination on this chain of the denomination of a packet
+// received over the given port and channel from the given counterparty port and channel.
+func getDenomForThisChain(portID, channelID, counterpartyPortID, counterpartyChannelID, denom string) string {
+	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPortID, counterpartyChannelID)
+	if strings.HasPrefix(denom, counterpartyPrefix) {
+		// the token originated on this chain and the denomination is unwound
+		return transfertypes.ParseDenomTrace(denom[len(counterpartyPrefix):]).IBCDenom()
+	}
+
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(portID, channelID, denom)).IBCDenom()
+}
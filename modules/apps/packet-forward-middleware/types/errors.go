@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = errorsmod.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardTransfer        = errorsmod.Register(ModuleName, 3, "failed to forward transfer packet")
+	ErrForwardTimeout         = errorsmod.Register(ModuleName, 4, "forwarded packet timed out")
+	ErrInvalidInFlightPacket  = errorsmod.Register(ModuleName, 5, "invalid in flight packet")
+)
@@ -0,0 +1,18 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForwardPacket      = "forward_packet"
+	EventTypeForwardAcknowledge = "forward_acknowledgement"
+	EventTypeForwardRetry       = "forward_retry"
+
+	AttributeKeyForwardReceiver = "forward_receiver"
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyRefundPort      = "refund_port"
+	AttributeKeyRefundChannel   = "refund_channel"
+	AttributeKeyRefundSequence  = "refund_sequence"
+	AttributeKeyAckSuccess      = "success"
+	AttributeKeyRetries         = "retries_remaining"
+)
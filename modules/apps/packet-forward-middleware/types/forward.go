@@ -0,0 +1,172 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+/*
+Packets are forwarded if the memo of the received FungibleTokenPacketData holds a JSON object
+with a forward key, for example:
+
+	{
+	  "forward": {
+	    "receiver": "cosmos1...",
+	    "port": "transfer",
+	    "channel": "channel-1",
+	    // optional, relative timeout of the forwarded packet
+	    "timeout": "10m",
+	    // optional, number of times the forwarded packet is resent after timing out
+	    "retries": 2,
+	    // optional, memo of the forwarded packet. It may be a JSON object or a string holding a JSON object.
+	    "next": {"forward": {...}}
+	  }
+	}
+*/
+
+var (
+	// DefaultForwardTimeout is the default relative timeout of forwarded packets.
+	DefaultForwardTimeout = 10 * time.Minute
+
+	// DefaultForwardRetries is the default number of times forwarded packets are resent after timing out.
+	DefaultForwardRetries = uint8(0)
+)
+
+// PacketMetadata is the structure of the packet memo understood by the packet forward middleware.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines where and how a received transfer is forwarded.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver,omitempty"`
+	Port     string          `json:"port,omitempty"`
+	Channel  string          `json:"channel,omitempty"`
+	Timeout  Duration        `json:"timeout,omitempty"`
+	Retries  *uint8          `json:"retries,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration which is unmarshaled from either a duration string (e.g. "10m")
+// or a number of nanoseconds.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler. The duration is marshaled as a duration string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(time.Duration(v))
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid duration: %s", string(bz))
+	}
+
+	return nil
+}
+
+// GetForwardMetadata returns the forward metadata held in the memo. The returned boolean is
+// false if the memo does not request the packet to be forwarded, in which case the packet is
+// handled by the underlying application. An error is returned if the memo requests the packet
+// to be forwarded but the forward metadata is invalid.
+func GetForwardMetadata(memo string) (*ForwardMetadata, bool, error) {
+	jsonObject := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return nil, false, nil
+	}
+
+	bz, found := jsonObject[ForwardMetadataKey]
+	if !found {
+		return nil, false, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(bz, &metadata); err != nil {
+		return nil, true, errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return &metadata, true, nil
+}
+
+// Validate performs a basic validation of the forward metadata.
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid port ID: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid channel ID: %s", err)
+	}
+	if m.Timeout < 0 {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "timeout cannot be negative")
+	}
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet.
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of times the forwarded packet is resent after timing out.
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return DefaultForwardRetries
+	}
+
+	return *m.Retries
+}
+
+// NextMemo returns the memo of the forwarded packet. The next field may either hold a JSON object
+// or a string holding a JSON object. An empty string is returned if no next memo is specified.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	if len(m.Next) == 0 || string(m.Next) == "null" {
+		return "", nil
+	}
+
+	next := []byte(m.Next)
+
+	var nextString string
+	if err := json.Unmarshal(next, &nextString); err == nil {
+		next = []byte(nextString)
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal(next, &jsonObject); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "next must be a JSON object: %s", err)
+	}
+
+	return string(next), nil
+}
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+)
+
+func TestGetForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		expFound   bool
+		expPass    bool
+		expTimeout time.Duration
+		expRetries uint8
+		expNext    string
+	}{
+		{
+			"success: defaults",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`,
+			true, true, types.DefaultForwardTimeout, types.DefaultForwardRetries, "",
+		},
+		{
+			"success: timeout as duration string",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","timeout":"1h","retries":2}}`,
+			true, true, time.Hour, 2, "",
+		},
+		{
+			"success: timeout in nanoseconds",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","timeout":60000000000}}`,
+			true, true, time.Minute, types.DefaultForwardRetries, "",
+		},
+		{
+			"success: next as JSON object",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","next":{"forward":{}}}}`,
+			true, true, types.DefaultForwardTimeout, types.DefaultForwardRetries, `{"forward":{}}`,
+		},
+		{
+			"success: next as JSON string",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","next":"{\"forward\":{}}"}}`,
+			true, true, types.DefaultForwardTimeout, types.DefaultForwardRetries, `{"forward":{}}`,
+		},
+		{
+			"not found: empty memo",
+			"",
+			false, true, 0, 0, "",
+		},
+		{
+			"not found: memo is not a JSON object",
+			"memo",
+			false, true, 0, 0, "",
+		},
+		{
+			"not found: memo has no forward key",
+			`{"wasm":{}}`,
+			false, true, 0, 0, "",
+		},
+		{
+			"failure: forward is not an object",
+			`{"forward":"channel-0"}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: empty receiver",
+			`{"forward":{"port":"transfer","channel":"channel-0"}}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: invalid port",
+			`{"forward":{"receiver":"cosmos1receiver","port":"","channel":"channel-0"}}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: invalid channel",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"c"}}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: invalid timeout",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","timeout":"1 hour"}}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: negative timeout",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","timeout":"-1h"}}`,
+			true, false, 0, 0, "",
+		},
+		{
+			"failure: next is not a JSON object",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","next":"memo"}}`,
+			true, false, 0, 0, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := types.GetForwardMetadata(tc.memo)
+			require.Equal(t, tc.expFound, found)
+
+			if !tc.expPass {
+				require.ErrorIs(t, err, types.ErrInvalidForwardMetadata)
+				return
+			}
+
+			require.NoError(t, err)
+			if !tc.expFound {
+				require.Nil(t, metadata)
+				return
+			}
+
+			require.Equal(t, tc.expTimeout, metadata.GetTimeout())
+			require.Equal(t, tc.expRetries, metadata.GetRetries())
+
+			next, err := metadata.NextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.expNext, next)
+		})
+	}
+}
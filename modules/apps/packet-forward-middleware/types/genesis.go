@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets map[string]InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without in flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: make(map[string]InFlightPacket),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	for key, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid in flight packet for key %s", key)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the in flight packet fields.
+func (p InFlightPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.RefundPortId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid refund port ID: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(p.RefundChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid refund channel ID: %s", err)
+	}
+	if err := host.PortIdentifierValidator(p.PacketSrcPortId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid packet source port ID: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(p.PacketSrcChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid packet source channel ID: %s", err)
+	}
+	if p.RefundSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "refund sequence cannot be 0")
+	}
+	if len(p.PacketData) == 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "packet data cannot be empty")
+	}
+	if p.RetriesRemaining < 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "retries remaining cannot be negative")
+	}
+	if _, err := clienttypes.ParseHeight(p.PacketTimeoutHeight); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid packet timeout height: %s", err)
+	}
+
+	return nil
+}
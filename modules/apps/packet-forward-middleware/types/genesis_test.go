@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/packet-forward-middleware/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var inFlightPacket types.InFlightPacket
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid refund channel",
+			func() {
+				inFlightPacket.RefundChannelId = "c"
+			},
+			false,
+		},
+		{
+			"invalid packet source port",
+			func() {
+				inFlightPacket.PacketSrcPortId = ""
+			},
+			false,
+		},
+		{
+			"refund sequence is zero",
+			func() {
+				inFlightPacket.RefundSequence = 0
+			},
+			false,
+		},
+		{
+			"empty packet data",
+			func() {
+				inFlightPacket.PacketData = nil
+			},
+			false,
+		},
+		{
+			"negative retries remaining",
+			func() {
+				inFlightPacket.RetriesRemaining = -1
+			},
+			false,
+		},
+		{
+			"invalid packet timeout height",
+			func() {
+				inFlightPacket.PacketTimeoutHeight = "height"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			inFlightPacket = types.InFlightPacket{
+				OriginalSenderAddress: "cosmos1sender",
+				RefundChannelId:       ibctesting.FirstChannelID,
+				RefundPortId:          ibctesting.TransferPort,
+				PacketSrcChannelId:    ibctesting.FirstChannelID,
+				PacketSrcPortId:       ibctesting.TransferPort,
+				PacketTimeoutHeight:   "1-100",
+				PacketData:            []byte("data"),
+				RefundSequence:        1,
+				RetriesRemaining:      1,
+			}
+
+			tc.malleate()
+
+			genesis := types.NewGenesisState(map[string]types.InFlightPacket{
+				string(types.RefundPacketKey(ibctesting.FirstChannelID, ibctesting.TransferPort, 1)): inFlightPacket,
+			})
+
+			err := genesis.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidInFlightPacket)
+			}
+		})
+	}
+}
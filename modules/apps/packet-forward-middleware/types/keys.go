@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the packet forward middleware module name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// ForwardMetadataKey is the key of the forward metadata within the packet memo
+	ForwardMetadataKey = "forward"
+)
+
+// RefundPacketKey returns the key under which the in flight packet is stored for a forwarded
+// packet sent over the given channel and port with the given sequence.
+func RefundPacketKey(channelID, portID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
+}
+
+// GetHoldAddress returns the address which temporarily holds the funds of a received packet
+// until they are forwarded. The address is derived from the destination channel of the received
+// packet and the original sender.
+func GetHoldAddress(channelID, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(fmt.Sprintf("%s/%s", channelID, sender))))
+}
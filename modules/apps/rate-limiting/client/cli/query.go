@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+)
+
+// GetCmdQueryRateLimits defines the command to query all rate limits.
+func GetCmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all rate limits",
+		Long:    "Query all rate limits including the flow tracked over their current window",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limits", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a denomination over a channel.
+func GetCmdQueryRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [channel-id] [denom]",
+		Short:   "Query the rate limit of a denomination over a channel",
+		Long:    "Query the rate limit of a denomination over a channel including the flow tracked over its current window",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limit channel-0 uatom", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimitsByChannel defines the command to query all rate limits of a channel.
+func GetCmdQueryRateLimitsByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits-by-channel [channel-id]",
+		Short:   "Query all rate limits of a channel",
+		Long:    "Query all rate limits of a channel including the flow tracked over their current window",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limits-by-channel channel-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsByChannelRequest{
+				ChannelId: args[0],
+			}
+
+			res, err := queryClient.RateLimitsByChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
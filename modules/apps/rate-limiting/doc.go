@@ -0,0 +1,12 @@
+/*
+Package ratelimiting implements a middleware which limits the net flow of ICS-20 transfers of a
+denomination over a channel. Rate limits are added, updated and removed through governance and
+define the maximum net outflow and net inflow over a window of a number of hours as a percentage
+of the channel value, which is the total supply of the denomination at the start of the window.
+
+Sends which would exceed the send quota are rejected and received transfers which would exceed
+the receive quota are acknowledged with an error. The outflow of sent transfers is reverted if
+the transfer times out or is acknowledged with an error within the same window. The flow of a
+rate limit is reset once its window ends.
+*/
+package ratelimiting
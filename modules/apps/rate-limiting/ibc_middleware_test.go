@@ -0,0 +1,248 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+type RateLimitingTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitingTestSuite))
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// addRateLimit adds a rate limit for the bond denom over the channel on chainA with the given
+// quota percentages and returns the amount corresponding to one percent of the channel value.
+func (suite *RateLimitingTestSuite) addRateLimit(maxPercentSend, maxPercentRecv int64) sdkmath.Int {
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	msg := types.NewMsgAddRateLimit(
+		keeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID,
+		sdkmath.NewInt(maxPercentSend), sdkmath.NewInt(maxPercentRecv), 24,
+	)
+
+	_, err := keeper.AddRateLimit(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	return suite.getRateLimit().Flow.ChannelValue.QuoRaw(100)
+}
+
+func (suite *RateLimitingTestSuite) getRateLimit() types.RateLimit {
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	return rateLimit
+}
+
+// transferFromA sends the amount of the bond denom from chainA to the receiver on chainB and returns the sent packet.
+func (suite *RateLimitingTestSuite) transferFromA(amount sdkmath.Int, receiver string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacket() {
+	onePercent := suite.addRateLimit(1, 1)
+
+	// sending exactly the quota succeeds
+	packet := suite.transferFromA(onePercent, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight())
+	suite.Require().Equal(onePercent, suite.getRateLimit().Flow.Outflow)
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	// sending any more exceeds the quota
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// the successful acknowledgement keeps the outflow and removes the pending send packet
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Equal(onePercent, suite.getRateLimit().Flow.Outflow)
+
+	_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacketWithoutRateLimit() {
+	amount := sdkmath.NewInt(100)
+	packet := suite.transferFromA(amount, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight())
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitingTestSuite) TestRevertSentPacket() {
+	var (
+		receiver      string
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		relay    func(packet channeltypes.Packet)
+	}{
+		{
+			"timeout",
+			func() {
+				timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			},
+			func(packet channeltypes.Packet) {
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+			},
+		},
+		{
+			"error acknowledgement",
+			func() {
+				receiver = "invalid"
+			},
+			func(packet channeltypes.Packet) {
+				// the transfer to an invalid receiver is acknowledged with an error
+				suite.Require().NoError(suite.path.RelayPacket(packet))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			onePercent := suite.addRateLimit(1, 1)
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			timeoutHeight = suite.chainB.GetTimeoutHeight()
+
+			tc.malleate()
+
+			packet := suite.transferFromA(onePercent, receiver, timeoutHeight)
+			suite.Require().Equal(onePercent, suite.getRateLimit().Flow.Outflow)
+
+			tc.relay(packet)
+
+			suite.Require().True(suite.getRateLimit().Flow.Outflow.IsZero())
+			suite.Require().Empty(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestRevertSentPacketAfterWindowReset() {
+	onePercent := suite.addRateLimit(1, 1)
+
+	packet := suite.transferFromA(onePercent, "invalid", suite.chainB.GetTimeoutHeight())
+
+	// start a new window on chainA
+	rateLimit := suite.getRateLimit()
+	suite.coordinator.IncrementTimeBy(rateLimit.Quota.Duration())
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+	suite.Require().True(suite.getRateLimit().Flow.Outflow.IsZero())
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the outflow of the new window is not affected
+	suite.Require().True(suite.getRateLimit().Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitingTestSuite) TestOnRecvPacket() {
+	// send two percent of the channel value to chainB before adding the rate limit
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount
+	twoPercent := supply.QuoRaw(50)
+	packet := suite.transferFromA(twoPercent, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	onePercent := suite.addRateLimit(100, 1)
+	voucher := transfertypes.GetTransferCoin(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom, twoPercent)
+
+	testCases := []struct {
+		name     string
+		amount   sdkmath.Int
+		expError bool
+	}{
+		{"receiving more than the quota is rejected", onePercent.AddRaw(1), true},
+		{"receiving the quota succeeds", onePercent, false},
+	}
+
+	for _, tc := range testCases {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.NewCoin(voucher.Denom, tc.amount),
+			suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "",
+		)
+		res, err := suite.chainB.SendMsgs(msg)
+		suite.Require().NoError(err, tc.name)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err, tc.name)
+
+		suite.Require().NoError(suite.path.EndpointA.UpdateClient(), tc.name)
+		res, err = suite.path.EndpointA.RecvPacketWithResult(packet)
+		suite.Require().NoError(err, tc.name)
+
+		ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+		suite.Require().NoError(err, tc.name)
+
+		var ack channeltypes.Acknowledgement
+		suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack), tc.name)
+
+		if tc.expError {
+			suite.Require().False(ack.Success(), tc.name)
+			suite.Require().True(suite.getRateLimit().Flow.Inflow.IsZero(), tc.name)
+		} else {
+			suite.Require().True(ack.Success(), tc.name)
+			suite.Require().Equal(tc.amount, suite.getRateLimit().Flow.Inflow, tc.name)
+		}
+
+		suite.Require().NoError(suite.path.EndpointB.AcknowledgePacket(packet, ackBz), tc.name)
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: k.GetAllRateLimits(ctx),
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewPath(req.Denom, req.ChannelId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", req.Denom, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: &rateLimit,
+	}, nil
+}
+
+// RateLimitsByChannel implements the Query/RateLimitsByChannel gRPC method
+func (k Keeper) RateLimitsByChannel(c context.Context, req *types.QueryRateLimitsByChannelRequest) (*types.QueryRateLimitsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRateLimitsByChannelResponse{
+		RateLimits: k.GetRateLimitsByChannel(ctx, req.ChannelId),
+	}, nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// Keeper defines the rate limiting middleware keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of adding, updating and removing rate limits. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate limiting middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper, authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the rate limiting middleware's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetRateLimit returns the rate limit of the given denomination over the given channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit under its path.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitKey(rateLimit.Path.ChannelId, rateLimit.Path.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes the rate limit of the given denomination over the given channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitKey(channelID, denom))
+}
+
+// GetAllRateLimits returns all stored rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimits(ctx, []byte(types.KeyRateLimitPrefix))
+}
+
+// GetRateLimitsByChannel returns all rate limits of the given channel.
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimits(ctx, types.RateLimitChannelPrefix(channelID))
+}
+
+func (k Keeper) getRateLimits(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// GetPendingSendPacket returns the pending send packet of the given denomination for the packet
+// sent over the given channel with the given sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence, denom))
+	if len(bz) == 0 {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingSendPacket)
+
+	return pendingSendPacket, true
+}
+
+// SetPendingSendPacket stores the pending send packet.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PendingSendPacketKey(pendingSendPacket.ChannelId, pendingSendPacket.Sequence, pendingSendPacket.Denom)
+	store.Set(key, k.cdc.MustMarshal(&pendingSendPacket))
+}
+
+// DeletePendingSendPacket removes the pending send packet of the given denomination for the packet
+// sent over the given channel with the given sequence.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence, denom))
+}
+
+// GetAllPendingSendPackets returns all stored pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyPendingSendPacketPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	pendingSendPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+	}
+
+	return pendingSendPackets
+}
+
+// InitGenesis initializes the rate limiting middleware state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingSendPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+}
+
+// ExportGenesis returns the rate limiting middleware exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx), k.GetAllPendingSendPackets(ctx))
+}
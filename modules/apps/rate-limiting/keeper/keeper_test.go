@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"unauthorized", func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"channel not found", func() {
+				msg.ChannelId = "channel-100"
+			}, channeltypes.ErrChannelNotFound,
+		},
+		{
+			"rate limit already exists", func() {
+				_, err := suite.chainA.GetSimApp().RateLimitingKeeper.AddRateLimit(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			}, types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"denom has no supply", func() {
+				msg.Denom = "unknown"
+			}, types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			msg = types.NewMsgAddRateLimit(keeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(10), sdkmath.NewInt(20), 24)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := keeper.AddRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := keeper.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().Equal(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, msg.Denom).Amount, rateLimit.Flow.ChannelValue)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+				suite.Require().Equal(ctx.BlockTime(), rateLimit.Flow.WindowStart)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"unauthorized", func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"rate limit not found", func() {
+				msg.ChannelId = "channel-100"
+			}, types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			suite.addRateLimit()
+
+			// record an outflow which is reset by the update
+			rateLimit, found := keeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(100)
+			keeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			msg = types.NewMsgUpdateRateLimit(keeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(50), sdkmath.NewInt(60), 12)
+
+			tc.malleate()
+
+			_, err := keeper.UpdateRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := keeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"unauthorized", func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"rate limit not found", func() {
+				msg.Denom = "unknown"
+			}, types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			suite.addRateLimit()
+
+			msg = types.NewMsgRemoveRateLimit(keeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			_, err := keeper.RemoveRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := keeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResetExpiredRateLimits() {
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	suite.addRateLimit()
+
+	ctx := suite.chainA.GetContext()
+	rateLimit, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	rateLimit.Flow.Inflow = sdkmath.NewInt(100)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(200)
+	keeper.SetRateLimit(ctx, rateLimit)
+
+	// the flow is kept while the window has not ended
+	ctx = ctx.WithBlockTime(rateLimit.Flow.WindowStart.Add(rateLimit.Quota.Duration() - time.Second))
+	keeper.ResetExpiredRateLimits(ctx)
+
+	updated, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, updated)
+
+	// the flow is reset once the window has ended
+	ctx = ctx.WithBlockTime(rateLimit.Flow.WindowStart.Add(rateLimit.Quota.Duration()))
+	keeper.ResetExpiredRateLimits(ctx)
+
+	updated, found = keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(updated.Flow.Inflow.IsZero())
+	suite.Require().True(updated.Flow.Outflow.IsZero())
+	suite.Require().Equal(ctx.BlockTime(), updated.Flow.WindowStart)
+	suite.Require().Equal(rateLimit.Quota, updated.Quota)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	suite.addRateLimit()
+
+	ctx := suite.chainA.GetContext()
+	rateLimit, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	res, err := keeper.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit}, res.RateLimits)
+
+	rateLimitRes, err := keeper.RateLimit(ctx, &types.QueryRateLimitRequest{Denom: sdk.DefaultBondDenom, ChannelId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimit, *rateLimitRes.RateLimit)
+
+	_, err = keeper.RateLimit(ctx, &types.QueryRateLimitRequest{Denom: "unknown", ChannelId: suite.path.EndpointA.ChannelID})
+	suite.Require().Error(err)
+
+	_, err = keeper.RateLimit(ctx, &types.QueryRateLimitRequest{})
+	suite.Require().Error(err)
+
+	channelRes, err := keeper.RateLimitsByChannel(ctx, &types.QueryRateLimitsByChannelRequest{ChannelId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit}, channelRes.RateLimits)
+
+	channelRes, err = keeper.RateLimitsByChannel(ctx, &types.QueryRateLimitsByChannelRequest{ChannelId: "channel-100"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(channelRes.RateLimits)
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	ctx := suite.chainA.GetContext()
+	windowStart := ctx.BlockTime().UTC()
+
+	genesisState := types.NewGenesisState(
+		[]types.RateLimit{
+			types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID), types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24), sdkmath.NewInt(1000), windowStart),
+			types.NewRateLimit(types.NewPath("uatom", "channel-1"), types.NewQuota(sdkmath.NewInt(5), sdkmath.NewInt(0), 1), sdkmath.NewInt(500), windowStart),
+		},
+		[]types.PendingSendPacket{
+			types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, windowStart, sdkmath.NewInt(10)),
+		},
+	)
+
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	keeper.InitGenesis(ctx, *genesisState)
+
+	suite.Require().Equal(genesisState, keeper.ExportGenesis(ctx))
+}
+
+// addRateLimit adds a rate limit for the bond denom over the channel on chainA.
+func (suite *KeeperTestSuite) addRateLimit() {
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	msg := types.NewMsgAddRateLimit(keeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+	_, err := keeper.AddRateLimit(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+}
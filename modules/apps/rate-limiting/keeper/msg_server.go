@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// AddRateLimit defines a rpc handler method for MsgAddRateLimit. Adds a rate limit for a denomination over a channel.
+func (k Keeper) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	path := types.NewPath(msg.Denom, msg.ChannelId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.addRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit added", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit defines a rpc handler method for MsgUpdateRateLimit. Updates the quota of a rate limit and resets its flow.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	path := types.NewPath(msg.Denom, msg.ChannelId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.updateRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit updated", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a rpc handler method for MsgRemoveRateLimit. Removes the rate limit of a denomination over a channel.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.removeRateLimit(ctx, types.NewPath(msg.Denom, msg.ChannelId)); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit removed", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
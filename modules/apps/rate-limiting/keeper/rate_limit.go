@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// addRateLimit adds a rate limit for the denomination over the transfer channel of the given path.
+// The flow of the rate limit starts at the current block time with the current channel value.
+func (k Keeper) addRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, path.ChannelId); !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", transfertypes.PortID, path.ChannelId)
+	}
+
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s on channel %s", path.Denom, path.ChannelId)
+	}
+
+	channelValue, err := k.getChannelValue(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, channelValue, ctx.BlockTime()))
+	return nil
+}
+
+// updateRateLimit replaces the quota of an existing rate limit and resets its flow.
+func (k Keeper) updateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", path.Denom, path.ChannelId)
+	}
+
+	channelValue, err := k.getChannelValue(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, channelValue, ctx.BlockTime()))
+	return nil
+}
+
+// removeRateLimit removes an existing rate limit. Pending send packets accounted for by the
+// rate limit are removed once the packets are acknowledged or time out.
+func (k Keeper) removeRateLimit(ctx sdk.Context, path types.Path) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", path.Denom, path.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, path.ChannelId, path.Denom)
+	return nil
+}
+
+// ResetExpiredRateLimits starts a new window for every rate limit whose window has ended.
+// The inflow and outflow are cleared and the channel value is recomputed.
+func (k Keeper) ResetExpiredRateLimits(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if !rateLimit.Flow.IsExpired(ctx.BlockTime(), rateLimit.Quota) {
+			continue
+		}
+
+		// a rate limit whose denomination no longer has any supply keeps a zero channel value
+		// and therefore blocks all transfers until it is updated or removed
+		channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+		rateLimit.Flow = types.NewFlow(channelValue, ctx.BlockTime())
+		k.SetRateLimit(ctx, rateLimit)
+
+		k.Logger(ctx).Debug("rate limit window reset", "denom", rateLimit.Path.Denom, "channel", rateLimit.Path.ChannelId, "channel-value", channelValue)
+	}
+}
+
+// getChannelValue returns the total supply of the denomination, which is the value against
+// which the quota percentages are applied.
+func (k Keeper) getChannelValue(ctx sdk.Context, denom string) (sdkmath.Int, error) {
+	channelValue := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if channelValue.IsZero() {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrZeroChannelValue, "denom %s has no supply", denom)
+	}
+
+	return channelValue, nil
+}
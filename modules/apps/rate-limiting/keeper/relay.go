@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// SendPacket adds the amount of every rate limited token of the transfer packet to the outflow of
+// its rate limit before passing the packet to the underlying ICS4Wrapper. The send is rejected if
+// the net outflow would exceed the send quota. The accounted tokens are recorded as pending send
+// packets so that their outflow can be reverted if the packet fails.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	packetData, err := k.unmarshalPacketData(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
+
+	var pendingSendPackets []types.PendingSendPacket
+	for _, token := range packetData.Tokens {
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+
+		rateLimit, found := k.GetRateLimit(ctx, sourceChannel, denom)
+		if !found {
+			continue
+		}
+
+		amount, err := parseAmount(token)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := rateLimit.Flow.AddOutflow(amount, rateLimit.Quota); err != nil {
+			return 0, errorsmod.Wrapf(err, "cannot send %s over channel %s", denom, sourceChannel)
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+		pendingSendPackets = append(pendingSendPackets, types.NewPendingSendPacket(sourceChannel, 0, denom, rateLimit.Flow.WindowStart, amount))
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, pendingSendPacket := range pendingSendPackets {
+		pendingSendPacket.Sequence = sequence
+		k.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement wraps the underlying ICS4Wrapper's WriteAcknowledgement function.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ReceiveRateLimitedPacket adds the amount of every rate limited token of the received transfer
+// packet to the inflow of its rate limit. An error is returned if the net inflow would exceed the
+// receive quota, in which case the packet must be acknowledged with an error.
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetData, err := k.unmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range packetData.Tokens {
+		denom := getReceiveDenom(packet, token.Denom)
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetDestChannel(), denom)
+		if !found {
+			continue
+		}
+
+		amount, err := parseAmount(token)
+		if err != nil {
+			return err
+		}
+
+		if err := rateLimit.Flow.AddInflow(amount, rateLimit.Quota); err != nil {
+			return errorsmod.Wrapf(err, "cannot receive %s over channel %s", denom, packet.GetDestChannel())
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// RevertSentPacket removes the amounts of the pending send packets of the failed packet from the
+// outflow of their rate limits. Amounts sent in a previous window are not reverted as the flow
+// they were added to has already been reset.
+func (k Keeper) RevertSentPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	for _, pendingSendPacket := range k.removePendingSendPackets(ctx, packet.GetSourceChannel(), packet.GetSequence()) {
+		rateLimit, found := k.GetRateLimit(ctx, pendingSendPacket.ChannelId, pendingSendPacket.Denom)
+		if !found || !rateLimit.Flow.WindowStart.Equal(pendingSendPacket.WindowStart) {
+			continue
+		}
+
+		rateLimit.Flow.RevertOutflow(pendingSendPacket.Amount)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// RemovePendingSendPackets removes the pending send packets of the successfully acknowledged packet.
+func (k Keeper) RemovePendingSendPackets(ctx sdk.Context, packet channeltypes.Packet) {
+	k.removePendingSendPackets(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// removePendingSendPackets deletes and returns the pending send packets of all denominations of
+// the packet sent over the given channel with the given sequence.
+func (k Keeper) removePendingSendPackets(ctx sdk.Context, channelID string, sequence uint64) []types.PendingSendPacket {
+	pendingSendPackets := k.getPendingSendPackets(ctx, channelID, sequence)
+	for _, pendingSendPacket := range pendingSendPackets {
+		k.DeletePendingSendPacket(ctx, channelID, sequence, pendingSendPacket.Denom)
+	}
+
+	return pendingSendPackets
+}
+
+// getPendingSendPackets returns the pending send packets of all denominations of the packet sent
+// over the given channel with the given sequence.
+func (k Keeper) getPendingSendPackets(ctx sdk.Context, channelID string, sequence uint64) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketSequencePrefix(channelID, sequence))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingSendPackets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+	}
+
+	return pendingSendPackets
+}
+
+// unmarshalPacketData unmarshals the transfer packet data according to the application version
+// of the given channel.
+func (k Keeper) unmarshalPacketData(ctx sdk.Context, portID, channelID string, data []byte) (transfertypes.FungibleTokenPacketDataV2, error) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketData(data, appVersion)
+}
+
+// getReceiveDenom returns the denomination on this chain of the token received with the packet.
+// Tokens returning to this chain are unprefixed while tokens originating from the counterparty
+// chain are prefixed with the destination port and channel.
+func getReceiveDenom(packet channeltypes.Packet, denom string) string {
+	var fullDenomPath string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		fullDenomPath = strings.TrimPrefix(denom, transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel()))
+	} else {
+		fullDenomPath = transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	}
+
+	return transfertypes.ParseDenomTrace(fullDenomPath).IBCDenom()
+}
+
+// parseAmount parses the token amount.
+func parseAmount(token transfertypes.Token) (sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return sdkmath.Int{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+
+	return amount, nil
+}
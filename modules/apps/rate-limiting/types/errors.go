@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// rate limiting middleware sentinel errors
+var (
+	ErrRateLimitNotFound        = errorsmod.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists   = errorsmod.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidRateLimit         = errorsmod.Register(ModuleName, 4, "invalid rate limit")
+	ErrQuotaExceeded            = errorsmod.Register(ModuleName, 5, "quota exceeded")
+	ErrZeroChannelValue         = errorsmod.Register(ModuleName, 6, "channel value is zero")
+	ErrInvalidPendingSendPacket = errorsmod.Register(ModuleName, 7, "invalid pending send packet")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[string]struct{})
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid rate limit at index %d", i)
+		}
+
+		key := string(RateLimitKey(rateLimit.Path.ChannelId, rateLimit.Path.Denom))
+		if _, found := paths[key]; found {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate rate limit for denom %s on channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		paths[key] = struct{}{}
+	}
+
+	for i, pendingSendPacket := range gs.PendingSendPackets {
+		if err := pendingSendPacket.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid pending send packet at index %d", i)
+		}
+	}
+
+	return nil
+}
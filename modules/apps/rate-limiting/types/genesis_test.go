@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	windowStart := time.Now()
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+	rateLimit := types.NewRateLimit(types.NewPath("uatom", "channel-0"), quota, sdkmath.NewInt(1000), windowStart)
+	pendingSendPacket := types.NewPendingSendPacket("channel-0", 1, "uatom", windowStart, sdkmath.NewInt(10))
+
+	testCases := []struct {
+		name         string
+		genesisState *types.GenesisState
+		expErr       error
+	}{
+		{
+			"default genesis state",
+			types.DefaultGenesisState(),
+			nil,
+		},
+		{
+			"valid genesis state",
+			types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{pendingSendPacket}),
+			nil,
+		},
+		{
+			"invalid rate limit path",
+			types.NewGenesisState([]types.RateLimit{types.NewRateLimit(types.NewPath("uatom", "channel"), quota, sdkmath.NewInt(1000), windowStart)}, nil),
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"invalid rate limit quota",
+			types.NewGenesisState([]types.RateLimit{types.NewRateLimit(types.NewPath("uatom", "channel-0"), types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 0), sdkmath.NewInt(1000), windowStart)}, nil),
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"duplicate rate limit",
+			types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}, nil),
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"invalid pending send packet",
+			types.NewGenesisState(nil, []types.PendingSendPacket{types.NewPendingSendPacket("channel-0", 0, "uatom", windowStart, sdkmath.NewInt(10))}),
+			types.ErrInvalidPendingSendPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.genesisState.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
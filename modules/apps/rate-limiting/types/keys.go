@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware module name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// KeyRateLimitPrefix is the key prefix under which rate limits are stored
+	KeyRateLimitPrefix = "rateLimit"
+
+	// KeyPendingSendPacketPrefix is the key prefix under which pending send packets are stored
+	KeyPendingSendPacketPrefix = "pendingSendPacket"
+)
+
+// RateLimitKey returns the key under which the rate limit of the given denomination over
+// the given channel is stored.
+func RateLimitKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s", RateLimitChannelPrefix(channelID), denom))
+}
+
+// RateLimitChannelPrefix returns the key prefix under which all rate limits of the given
+// channel are stored.
+func RateLimitChannelPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyRateLimitPrefix, channelID))
+}
+
+// PendingSendPacketKey returns the key under which the pending send packet of the given
+// denomination for the packet sent over the given channel with the given sequence is stored.
+func PendingSendPacketKey(channelID string, sequence uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s", PendingSendPacketSequencePrefix(channelID, sequence), denom))
+}
+
+// PendingSendPacketSequencePrefix returns the key prefix under which the pending send packets
+// of all denominations of the packet sent over the given channel with the given sequence are stored.
+func PendingSendPacketSequencePrefix(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/", KeyPendingSendPacketPrefix, channelID, sequence))
+}
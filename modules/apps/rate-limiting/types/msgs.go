@@ -0,0 +1,114 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgAddRateLimit)(nil)
+	_ sdk.Msg = (*MsgUpdateRateLimit)(nil)
+	_ sdk.Msg = (*MsgRemoveRateLimit)(nil)
+)
+
+// NewMsgAddRateLimit creates a new MsgAddRateLimit instance
+func NewMsgAddRateLimit(authority, denom, channelID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Authority:      authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	return mustGetSigners(msg.Authority)
+}
+
+// NewMsgUpdateRateLimit creates a new MsgUpdateRateLimit instance
+func NewMsgUpdateRateLimit(authority, denom, channelID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Authority:      authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	return mustGetSigners(msg.Authority)
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(authority, denom, channelID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelId).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	return mustGetSigners(msg.Authority)
+}
+
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+func mustGetSigners(authority string) []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func TestMsgAddRateLimitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAddRateLimit
+		expErr error
+	}{
+		{"success", types.NewMsgAddRateLimit(authority, "uatom", "channel-0", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), nil},
+		{"invalid authority", types.NewMsgAddRateLimit("invalid", "uatom", "channel-0", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), ibcerrors.ErrInvalidAddress},
+		{"invalid denom", types.NewMsgAddRateLimit(authority, "", "channel-0", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), types.ErrInvalidRateLimit},
+		{"invalid channel identifier", types.NewMsgAddRateLimit(authority, "uatom", "channel", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), types.ErrInvalidRateLimit},
+		{"invalid quota", types.NewMsgAddRateLimit(authority, "uatom", "channel-0", sdkmath.NewInt(101), sdkmath.NewInt(20), 24), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, authority, tc.msg.GetSigners()[0].String())
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateRateLimitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateRateLimit
+		expErr error
+	}{
+		{"success", types.NewMsgUpdateRateLimit(authority, "uatom", "channel-0", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), nil},
+		{"invalid authority", types.NewMsgUpdateRateLimit("", "uatom", "channel-0", sdkmath.NewInt(10), sdkmath.NewInt(20), 24), ibcerrors.ErrInvalidAddress},
+		{"invalid quota", types.NewMsgUpdateRateLimit(authority, "uatom", "channel-0", sdkmath.ZeroInt(), sdkmath.ZeroInt(), 24), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveRateLimitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRemoveRateLimit
+		expErr error
+	}{
+		{"success", types.NewMsgRemoveRateLimit(authority, "uatom", "channel-0"), nil},
+		{"invalid authority", types.NewMsgRemoveRateLimit("invalid", "uatom", "channel-0"), ibcerrors.ErrInvalidAddress},
+		{"invalid channel identifier", types.NewMsgRemoveRateLimit(authority, "uatom", ""), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// maxPercent is the upper bound of the quota percentages
+var maxPercent = sdkmath.NewInt(100)
+
+// NewRateLimit creates a new RateLimit instance whose flow starts at the given time
+// with the given channel value.
+func NewRateLimit(path Path, quota Quota, channelValue sdkmath.Int, windowStart time.Time) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(channelValue, windowStart),
+	}
+}
+
+// Validate performs a basic validation of the rate limit path and quota.
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Quota.Validate()
+}
+
+// NewPath creates a new Path instance
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the path denomination and channel identifier.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid denom: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid channel ID: %s", err)
+	}
+
+	return nil
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate checks that the quota percentages are between 0 and 100 with at least one of them
+// being non-zero and that the window duration is positive.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent send must be between 0 and %s", maxPercent)
+	}
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent recv must be between 0 and %s", maxPercent)
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "max percent send and max percent recv cannot both be 0")
+	}
+	if q.DurationHours == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "duration hours must be greater than 0")
+	}
+
+	return nil
+}
+
+// Duration returns the length of the rate limit window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// NewFlow creates a new Flow instance without any inflow or outflow.
+func NewFlow(channelValue sdkmath.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowStart:  windowStart,
+	}
+}
+
+// IsExpired returns true if the window of the flow has ended at the given block time.
+func (f Flow) IsExpired(blockTime time.Time, quota Quota) bool {
+	return !blockTime.Before(f.WindowStart.Add(quota.Duration()))
+}
+
+// AddInflow adds the amount to the inflow if the resulting net inflow does not exceed
+// the receive quota.
+func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
+	netInflow := f.Inflow.Add(amount).Sub(f.Outflow)
+	if exceedsQuota(netInflow, f.ChannelValue, quota.MaxPercentRecv) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "net inflow %s exceeds %s%% of channel value %s", netInflow, quota.MaxPercentRecv, f.ChannelValue)
+	}
+
+	f.Inflow = f.Inflow.Add(amount)
+	return nil
+}
+
+// AddOutflow adds the amount to the outflow if the resulting net outflow does not exceed
+// the send quota.
+func (f *Flow) AddOutflow(amount sdkmath.Int, quota Quota) error {
+	netOutflow := f.Outflow.Add(amount).Sub(f.Inflow)
+	if exceedsQuota(netOutflow, f.ChannelValue, quota.MaxPercentSend) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "net outflow %s exceeds %s%% of channel value %s", netOutflow, quota.MaxPercentSend, f.ChannelValue)
+	}
+
+	f.Outflow = f.Outflow.Add(amount)
+	return nil
+}
+
+// RevertOutflow removes the amount from the outflow, it is used when a sent packet fails.
+func (f *Flow) RevertOutflow(amount sdkmath.Int) {
+	if amount.GT(f.Outflow) {
+		f.Outflow = sdkmath.ZeroInt()
+		return
+	}
+
+	f.Outflow = f.Outflow.Sub(amount)
+}
+
+// exceedsQuota returns true if the net flow is greater than the given percentage of the channel value.
+func exceedsQuota(netFlow, channelValue, percent sdkmath.Int) bool {
+	threshold := channelValue.Mul(percent).Quo(maxPercent)
+	return netFlow.GT(threshold)
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(channelID string, sequence uint64, denom string, windowStart time.Time, amount sdkmath.Int) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		Denom:       denom,
+		WindowStart: windowStart,
+		Amount:      amount,
+	}
+}
+
+// Validate performs a basic validation of the pending send packet fields.
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPendingSendPacket, "invalid channel ID: %s", err)
+	}
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "sequence cannot be 0")
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPendingSendPacket, "invalid denom: %s", err)
+	}
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "amount must be positive")
+	}
+
+	return nil
+}
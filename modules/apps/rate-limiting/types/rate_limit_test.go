@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/rate-limiting/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		quota  types.Quota
+		expErr error
+	}{
+		{"valid quota", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 24), nil},
+		{"valid quota with send only", types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 1), nil},
+		{"send percent greater than 100", types.NewQuota(sdkmath.NewInt(101), sdkmath.NewInt(20), 24), types.ErrInvalidRateLimit},
+		{"negative recv percent", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(-1), 24), types.ErrInvalidRateLimit},
+		{"nil send percent", types.NewQuota(sdkmath.Int{}, sdkmath.NewInt(20), 24), types.ErrInvalidRateLimit},
+		{"both percents are zero", types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 24), types.ErrInvalidRateLimit},
+		{"zero duration", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 0), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.quota.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestFlowAddInflowAndOutflow(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 24)
+	flow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+
+	// send up to 10% of the channel value
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(100), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdkmath.NewInt(1), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(100), flow.Outflow)
+
+	// the inflow is offset by the outflow
+	require.NoError(t, flow.AddInflow(sdkmath.NewInt(300), quota))
+	require.ErrorIs(t, flow.AddInflow(sdkmath.NewInt(1), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(300), flow.Inflow)
+
+	// the outflow is offset by the inflow
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(300), quota))
+	require.Equal(t, sdkmath.NewInt(400), flow.Outflow)
+}
+
+func TestFlowRevertOutflow(t *testing.T) {
+	flow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+	flow.Outflow = sdkmath.NewInt(100)
+
+	flow.RevertOutflow(sdkmath.NewInt(40))
+	require.Equal(t, sdkmath.NewInt(60), flow.Outflow)
+
+	flow.RevertOutflow(sdkmath.NewInt(100))
+	require.True(t, flow.Outflow.IsZero())
+}
+
+func TestFlowIsExpired(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 1)
+	windowStart := time.Now()
+	flow := types.NewFlow(sdkmath.NewInt(1000), windowStart)
+
+	require.False(t, flow.IsExpired(windowStart, quota))
+	require.False(t, flow.IsExpired(windowStart.Add(time.Hour-time.Nanosecond), quota))
+	require.True(t, flow.IsExpired(windowStart.Add(time.Hour), quota))
+}
+
+func TestPendingSendPacketValidate(t *testing.T) {
+	windowStart := time.Now()
+
+	testCases := []struct {
+		name   string
+		packet types.PendingSendPacket
+		expErr error
+	}{
+		{"valid pending send packet", types.NewPendingSendPacket("channel-0", 1, "uatom", windowStart, sdkmath.NewInt(10)), nil},
+		{"invalid channel identifier", types.NewPendingSendPacket("channel", 1, "uatom", windowStart, sdkmath.NewInt(10)), types.ErrInvalidPendingSendPacket},
+		{"zero sequence", types.NewPendingSendPacket("channel-0", 0, "uatom", windowStart, sdkmath.NewInt(10)), types.ErrInvalidPendingSendPacket},
+		{"invalid denom", types.NewPendingSendPacket("channel-0", 1, "", windowStart, sdkmath.NewInt(10)), types.ErrInvalidPendingSendPacket},
+		{"zero amount", types.NewPendingSendPacket("channel-0", 1, "uatom", windowStart, sdkmath.ZeroInt()), types.ErrInvalidPendingSendPacket},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.packet.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -26,14 +26,16 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagForwarding             = "forwarding"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
-		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified
+		Short: "Transfer fungible tokens through IBC",
+		Long: strings.TrimSpace(`Transfer fungible tokens through IBC. Multiple comma-separated amounts may be
+provided when the channel has negotiated the ics20-2 version. Timeouts can be specified
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
@@ -51,14 +53,16 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
@@ -85,6 +89,16 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			forwarding, err := cmd.Flags().GetStringSlice(flagForwarding)
+			if err != nil {
+				return err
+			}
+
+			forwardingHops, err := parseHops(forwarding)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel.
 			// localhost clients must rely solely on local clock time in order to use relative timestamps.
@@ -147,9 +161,19 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
-			)
+			var msg *types.MsgTransfer
+			if len(coins) == 1 {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			} else {
+				// multiple tokens can only be transferred over ics20-2 channels
+				msg = types.NewMsgTransferWithTokens(
+					srcPort, srcChannel, coins.Sort(), sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			}
+			msg.ForwardingHops = forwardingHops
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -158,7 +182,23 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().StringSlice(flagForwarding, nil, "Comma-separated list of {port}/{channel} hops over which the tokens are forwarded by the intermediate chains. Tokens may only be forwarded over ics20-2 channels.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseHops parses forwarding hops of the form {port}/{channel}.
+func parseHops(hops []string) ([]types.Hop, error) {
+	var forwardingHops []types.Hop
+	for _, hop := range hops {
+		portID, channelID, found := strings.Cut(hop, "/")
+		if !found {
+			return nil, fmt.Errorf("expected forwarding hop of the form {port}/{channel}, got: %s", hop)
+		}
+
+		forwardingHops = append(forwardingHops, types.NewHop(portID, channelID))
+	}
+
+	return forwardingHops, nil
+}
@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -32,8 +33,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED and use the correct port (by default 'transfer'). Only 2^32 channels
+// are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -82,8 +83,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, types.SupportedVersions)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -109,8 +110,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -118,7 +119,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	// the version proposed by the counterparty is accepted
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -129,8 +131,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 	return nil
 }
@@ -174,10 +176,10 @@ func (im IBCModule) OnRecvPacket(
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+	data, err := im.unmarshalPacketData(ctx, packet.GetData(), packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		ackErr = err
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
 	}
@@ -185,7 +187,7 @@ func (im IBCModule) OnRecvPacket(
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		err := im.keeper.OnRecvPacketV2(ctx, packet, data)
 		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err)
 			ackErr = err
@@ -199,11 +201,12 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens)...)
+	eventAttributes = append(eventAttributes,
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
-	}
+	)
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -216,6 +219,12 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	// the acknowledgement of forwarded tokens is written once the forwarded packet
+	// is acknowledged or times out
+	if ack.Success() && data.ShouldBeForwarded() {
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -231,25 +240,30 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.unmarshalPacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
-	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+	if err := im.keeper.OnAcknowledgementPacketV2(ctx, packet, data, ack); err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -279,25 +293,120 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.unmarshalPacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
-	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+	if err := im.keeper.OnTimeoutPacketV2(ctx, packet, data); err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount, types.AttributeKeyRefundTokens)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			eventAttributes...,
 		),
 	)
 
 	return nil
 }
+
+// OnChanUpgradeInit implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, proposedOrder, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
+	}
+
+	return proposedVersion, nil
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, proposedOrder, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the IBCModule interface
+func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeOpen implements the IBCModule interface
+func (IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into a FungibleTokenPacketData or a FungibleTokenPacketDataV2. This function
+// implements the optional PacketDataUnmarshaler interface used by middlewares
+// such as callbacks.
+func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	var packetData types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err == nil {
+		return packetData, nil
+	}
+
+	var packetDataV2 types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetDataV2); err != nil {
+		return nil, err
+	}
+
+	return packetDataV2, nil
+}
+
+// unmarshalPacketData unmarshals the packet data according to the transfer version of the
+// given channel end.
+func (im IBCModule) unmarshalPacketData(ctx sdk.Context, bz []byte, portID, channelID string) (types.FungibleTokenPacketDataV2, error) {
+	version, found := im.keeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return types.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return types.UnmarshalPacketData(bz, version)
+}
+
+// tokenAttributes returns the event attributes of the transferred tokens. The denomination
+// and amount of a single token are emitted as separate attributes, while multiple tokens
+// are emitted as a single JSON encoded attribute.
+func tokenAttributes(tokens []types.Token, denomKey, amountKey, tokensKey string) []sdk.Attribute {
+	if len(tokens) <= 1 {
+		var token types.Token
+		if len(tokens) == 1 {
+			token = tokens[0]
+		}
+
+		return []sdk.Attribute{
+			sdk.NewAttribute(denomKey, token.Denom),
+			sdk.NewAttribute(amountKey, token.Amount),
+		}
+	}
+
+	bz, err := json.Marshal(tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.Attribute{sdk.NewAttribute(tokensKey, string(bz))}
+}
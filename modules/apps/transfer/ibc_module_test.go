@@ -32,6 +32,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: ics20-2 version", func() {
+				channel.Version = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -90,8 +95,13 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			)
 
 			if tc.expPass {
+				expVersion := channel.Version
+				if expVersion == "" {
+					expVersion = types.Version
+				}
+
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -138,6 +148,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
 			}, false,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -182,7 +197,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -202,6 +217,11 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
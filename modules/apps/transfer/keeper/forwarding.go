@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// forwardPacket forwards the tokens received in the given packet, which are held by the forward
+// address of the destination channel of the packet, over the first hop of the forwarding
+// information. The remaining hops and the destination memo are carried by the forwarded packet.
+// The received packet is stored until the forwarded packet is acknowledged or times out.
+// Forwarded packets time out DefaultRelativePacketTimeoutTimestamp after they are sent and are
+// not retried, the failure being propagated back to the sender instead. Transfers requiring
+// custom timeouts or retries on intermediate chains are forwarded by the packet forward middleware.
+func (k Keeper) forwardPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, coins sdk.Coins) error {
+	nextHop := data.Forwarding.Hops[0]
+
+	var (
+		memo       string
+		forwarding *types.ForwardingPacketData
+	)
+	if len(data.Forwarding.Hops) == 1 {
+		// the tokens are sent to the final receiver along with the destination memo
+		memo = data.Forwarding.DestinationMemo
+	} else {
+		forwarding = types.NewForwardingPacketData(data.Forwarding.DestinationMemo, data.Forwarding.Hops[1:]...)
+	}
+
+	forwardAddress := types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + types.DefaultRelativePacketTimeoutTimestamp
+
+	sequence, err := k.sendTransfer(
+		ctx, nextHop.PortId, nextHop.ChannelId, coins, forwardAddress, data.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo, forwarding,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to forward tokens over %s/%s", nextHop.PortId, nextHop.ChannelId)
+	}
+
+	k.SetForwardedPacket(ctx, nextHop.PortId, nextHop.ChannelId, sequence, packet)
+
+	k.Logger(ctx).Info("forwarded IBC fungible tokens", "tokens", coins.String(), "port-id", nextHop.PortId, "channel-id", nextHop.ChannelId, "sequence", sequence)
+
+	return nil
+}
+
+// onForwardedPacketResult writes the acknowledgement of the received packet on whose behalf the
+// given packet was sent, once the sent packet is acknowledged or times out. If the forwarded
+// packet failed, the tokens refunded to the forward address are returned to the state they had
+// before the received packet was processed, so that the sending chain may refund the sender.
+// Nothing is done if the given packet was not sent to forward tokens.
+func (k Keeper) onForwardedPacketResult(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	receivedPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.deleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if !ack.Success() {
+		if err := k.revertForwardedPacket(ctx, receivedPacket); err != nil {
+			return err
+		}
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(receivedPacket.GetDestPort(), receivedPacket.GetDestChannel()))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, receivedPacket, ack)
+}
+
+// revertForwardedPacket reverts the effects of receiving the tokens of the given packet, which are
+// held by the forward address of its destination channel. Vouchers minted upon receive are burned
+// and native tokens unescrowed upon receive are escrowed again.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return err
+	}
+
+	forwardAddress := types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	for _, token := range data.Tokens {
+		coin, err := receivedCoin(packet, token)
+		if err != nil {
+			return err
+		}
+
+		if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+			if err := k.escrowToken(ctx, forwardAddress, escrowAddress, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			// NOTE: should not happen as the module account was
+			// retrieved on the step above and it has enough balace
+			// to burn.
+			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+		}
+	}
+
+	return nil
+}
+
+// GetForwardedPacket returns the received packet whose tokens were forwarded by the packet sent
+// over the given port and channel with the given sequence.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ForwardedPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// SetForwardedPacket stores the received packet whose tokens were forwarded by the packet sent
+// over the given port and channel with the given sequence.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ForwardedPacketKey(portID, channelID, sequence), k.cdc.MustMarshal(&packet))
+}
+
+// deleteForwardedPacket deletes the received packet whose tokens were forwarded by the packet sent
+// over the given port and channel with the given sequence.
+func (k Keeper) deleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ForwardedPacketKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedPackets returns all received packets whose forwarded packets are not yet
+// acknowledged or timed out.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyForwardedPacketPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var forwardedPackets []types.ForwardedPacket
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is of the form {portID}/{channelID}/{sequence}
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		if len(keySplit) != 3 {
+			continue
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[2], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		forwardedPackets = append(forwardedPackets, types.ForwardedPacket{
+			PortId:    keySplit[0],
+			ChannelId: keySplit[1],
+			Sequence:  sequence,
+			Packet:    packet,
+		})
+	}
+
+	return forwardedPackets
+}
+
+// forwardingHopsString returns the forwarding hops as a comma separated list of {portID}/{channelID}.
+func forwardingHopsString(hops []types.Hop) string {
+	hopStrings := make([]string, len(hops))
+	for i, hop := range hops {
+		hopStrings[i] = fmt.Sprintf("%s/%s", hop.PortId, hop.ChannelId)
+	}
+
+	return strings.Join(hopStrings, ",")
+}
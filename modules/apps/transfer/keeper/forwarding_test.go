@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// TestForwardedPacketFailure asserts that the effects of receiving a forwarded packet are reverted
+// and an error acknowledgement is written for it once the packet sent to forward its tokens is
+// acknowledged with an error or times out.
+func (suite *KeeperTestSuite) TestForwardedPacketFailure() {
+	var (
+		pathAtoB *ibctesting.Path
+		denom    string
+	)
+
+	amount := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		timeout  bool
+		expAck   channeltypes.Acknowledgement
+	}{
+		{
+			"error acknowledgement for vouchers minted on receive",
+			func() {},
+			false,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketFailed),
+		},
+		{
+			"timeout for vouchers minted on receive",
+			func() {},
+			true,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimedOut),
+		},
+		{
+			"error acknowledgement for native tokens unescrowed on receive",
+			func() {
+				denom = types.GetPrefixedDenom(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+				escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainB.GetSimApp().BankKeeper, suite.chainB.GetContext(), escrowAddress, sdk.NewCoins(coin)))
+				suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coin)
+			},
+			false,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketFailed),
+		},
+		{
+			"timeout for native tokens unescrowed on receive",
+			func() {
+				denom = types.GetPrefixedDenom(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+				escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainB.GetSimApp().BankKeeper, suite.chainB.GetContext(), escrowAddress, sdk.NewCoins(coin)))
+				suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coin)
+			},
+			true,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimedOut),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			pathAtoB = NewTransferPath(suite.chainA, suite.chainB)
+			pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+			pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(pathAtoB)
+
+			pathBtoC := NewTransferPath(suite.chainB, suite.chainC)
+			pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+			pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(pathBtoC)
+
+			denom = sdk.DefaultBondDenom
+
+			tc.malleate()
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			bankKeeper := suite.chainB.GetSimApp().BankKeeper
+			escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+			forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+			voucherDenom := types.GetTransferCoin(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount).Denom
+
+			ctx := suite.chainB.GetContext()
+			escrowBalance := bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{types.NewToken(denom, amount.String())},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), "",
+			)
+			data.Forwarding = types.NewForwardingPacketData("", types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+			packet := channeltypes.NewPacket(
+				data.GetBytes(), 1,
+				pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID,
+				pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100), 0,
+			)
+
+			suite.Require().NoError(transferKeeper.OnRecvPacketV2(ctx, packet, data))
+
+			forwardedPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+			suite.Require().NoError(err)
+
+			receivedPacket, found := transferKeeper.GetForwardedPacket(ctx, forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(packet, receivedPacket)
+
+			var forwardedData types.FungibleTokenPacketDataV2
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(forwardedPacket.GetData(), &forwardedData))
+
+			if tc.timeout {
+				err = transferKeeper.OnTimeoutPacketV2(ctx, forwardedPacket, forwardedData)
+			} else {
+				err = transferKeeper.OnAcknowledgementPacketV2(ctx, forwardedPacket, forwardedData, channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed packet transfer")))
+			}
+			suite.Require().NoError(err)
+
+			_, found = transferKeeper.GetForwardedPacket(ctx, forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+			suite.Require().False(found)
+
+			// the tokens received by the forward address are returned to the state they had before the packet was received
+			suite.Require().True(bankKeeper.GetAllBalances(ctx, forwardAddress).IsZero())
+			suite.Require().True(bankKeeper.GetSupply(ctx, voucherDenom).IsZero())
+			suite.Require().Equal(escrowBalance, bankKeeper.GetAllBalances(ctx, escrowAddress))
+
+			ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(tc.expAck.Acknowledgement()), ackCommitment)
+		})
+	}
+}
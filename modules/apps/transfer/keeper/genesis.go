@@ -34,14 +34,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, forwardedPacket := range state.ForwardedPackets {
+		k.SetForwardedPacket(ctx, forwardedPacket.PortId, forwardedPacket.ChannelId, forwardedPacket.Sequence, forwardedPacket.Packet)
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info and forwarded packets into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:           k.GetPort(ctx),
+		DenomTraces:      k.GetAllDenomTraces(ctx),
+		Params:           k.GetParams(ctx),
+		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets: k.GetAllForwardedPackets(ctx),
 	}
 }
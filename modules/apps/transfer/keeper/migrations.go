@@ -86,6 +86,18 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateMaxLengthParams sets the maximum memo and receiver lengths of the transfer module's parameters
+// to their default values.
+func (m Migrator) MigrateMaxLengthParams(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxMemoLength = types.DefaultMaxMemoLength
+	params.MaxReceiverLength = types.DefaultMaxReceiverLength
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated transfer app max memo and receiver length params")
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
@@ -26,7 +26,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 				subspace := suite.chainA.GetSimApp().GetSubspace(transfertypes.ModuleName)
 				subspace.SetParamSet(suite.chainA.GetContext(), &params) // set params
 			},
-			transfertypes.DefaultParams(),
+			transfertypes.NewParams(transfertypes.DefaultSendEnabled, transfertypes.DefaultReceiveEnabled, 0, 0),
 		},
 	}
 
@@ -46,6 +46,18 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratorMigrateMaxLengthParams() {
+	params := transfertypes.NewParams(false, true, 0, 0)
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateMaxLengthParams(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	expParams := transfertypes.NewParams(false, true, transfertypes.DefaultMaxMemoLength, transfertypes.DefaultMaxReceiverLength)
+	suite.Require().Equal(expParams, suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext()))
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 	testCases := []struct {
 		msg            string
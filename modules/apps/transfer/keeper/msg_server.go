@@ -17,41 +17,69 @@ var _ types.MsgServer = (*Keeper)(nil)
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.GetParams(ctx).SendEnabled {
+	params := k.GetParams(ctx)
+	if !params.SendEnabled {
 		return nil, types.ErrSendDisabled
 	}
 
+	if params.MaxMemoLength != 0 && uint64(len(msg.Memo)) > params.MaxMemoLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMemo, "memo length (%d bytes) exceeds maximum of %d bytes", len(msg.Memo), params.MaxMemoLength)
+	}
+
+	if params.MaxReceiverLength != 0 && uint64(len(msg.Receiver)) > params.MaxReceiverLength {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "receiver length (%d bytes) exceeds maximum of %d bytes", len(msg.Receiver), params.MaxReceiverLength)
+	}
+
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, msg.Token) {
-		return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", msg.Token.Denom)
+	coins := msg.GetCoins()
+	for _, coin := range coins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
 	}
 
 	if k.bankKeeper.BlockedAddr(sender) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	// the memo of forwarded tokens is delivered to the receiver on the final chain
+	memo, forwarding := msg.Memo, msg.GetForwarding()
+	if forwarding != nil {
+		memo = ""
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		memo, forwarding)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins.String(), "sender", msg.Sender, "receiver", msg.Receiver)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+	}
+	if len(msg.Tokens) == 0 {
+		attributes = append(attributes,
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDenom, msg.Token.Denom),
-			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
-		),
+		)
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyTokens, coins.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo))
+	if forwarding != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyForwardingHops, forwardingHopsString(forwarding.Hops)))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeTransfer, attributes...),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
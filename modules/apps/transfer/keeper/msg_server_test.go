@@ -7,7 +7,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
 )
 
 // TestMsgTransfer tests Transfer rpc handler
@@ -47,6 +49,34 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"memo length exceeds max memo length",
+			func() {
+				params := types.DefaultParams()
+				params.MaxMemoLength = uint64(len(msg.Memo)) - 1
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"receiver length exceeds max receiver length",
+			func() {
+				params := types.DefaultParams()
+				params.MaxReceiverLength = uint64(len(msg.Receiver)) - 1
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"success: memo and receiver length limits disabled",
+			func() {
+				params := types.DefaultParams()
+				params.MaxMemoLength = 0
+				params.MaxReceiverLength = 0
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
 		{
 			"invalid sender",
 			func() {
@@ -129,6 +159,47 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 	}
 }
 
+// TestMsgTransferPacketDataTooLarge tests that a transfer whose packet data exceeds the maximum packet data
+// size of the channel params fails when delivered in a transaction, leaving the sender's balance and the
+// next send sequence of the channel unchanged.
+func (suite *KeeperTestSuite) TestMsgTransferPacketDataTooLarge() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelParams := channeltypes.DefaultParams()
+	channelParams.MaxPacketDataBytes = 1
+	suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetParams(suite.chainA.GetContext(), channelParams)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom)
+	sequenceBefore, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	_, res, err := simapp.SignAndDeliver(
+		suite.T(), suite.chainA.TxConfig, suite.chainA.App.GetBaseApp(), []sdk.Msg{msg}, suite.chainA.ChainID,
+		[]uint64{suite.chainA.SenderAccount.GetAccountNumber()}, []uint64{suite.chainA.SenderAccount.GetSequence()},
+		false, suite.chainA.SenderPrivKey,
+	)
+	suite.Require().ErrorIs(err, channeltypes.ErrPacketDataTooLarge)
+	suite.Require().Nil(res)
+
+	suite.chainA.NextBlock()
+
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+
+	sequenceAfter, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sequenceBefore, sequenceAfter)
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	validAuthority := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -56,12 +56,13 @@ func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	coins sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
+	forwarding *types.ForwardingPacketData,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -78,6 +79,84 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	if !types.IsSupportedVersion(appVersion) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "unsupported version: %s", appVersion)
+	}
+
+	if appVersion == types.V1 && len(coins) != 1 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "multiple tokens cannot be transferred over %s channels", types.V1)
+	}
+
+	if appVersion == types.V1 && forwarding != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "tokens cannot be forwarded over %s channels", types.V1)
+	}
+
+	// escrow or burn all tokens, the transfer fails if any token cannot be sent
+	tokens := make([]types.Token, 0, len(coins))
+	sources := make([]bool, 0, len(coins))
+	for _, coin := range coins {
+		fullDenomPath, source, err := k.sendToken(ctx, sourcePort, sourceChannel, coin, sender)
+		if err != nil {
+			return 0, err
+		}
+
+		tokens = append(tokens, types.NewToken(fullDenomPath, coin.Amount.String()))
+		sources = append(sources, source)
+	}
+
+	packetData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), receiver, memo)
+	packetData.Forwarding = forwarding
+
+	packetDataBytes, err := types.MarshalPacketData(packetData, appVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		for i, coin := range coins {
+			if coin.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(coin.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, tokens[i].Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				[]metrics.Label{
+					telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+					telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+					telemetry.NewLabel(coretypes.LabelSource, fmt.Sprintf("%t", sources[i])),
+				},
+			)
+		}
+	}()
+
+	return sequence, nil
+}
+
+// sendToken escrows or burns the token sent by the sender over the given channel and returns
+// the full denomination path of the token, as it is sent within the packet data, and whether
+// this chain is the source of the token.
+func (k Keeper) sendToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+) (string, bool, error) {
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
@@ -88,74 +167,41 @@ func (k Keeper) sendTransfer(
 	if strings.HasPrefix(token.Denom, "ibc/") {
 		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
 		if err != nil {
-			return 0, err
+			return "", false, err
 		}
 	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
-	}
-
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
 
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
-
 		// obtain the escrow address for the source channel end
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
-			return 0, err
+			return "", false, err
 		}
 
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
-
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return 0, err
-		}
-
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
-		}
+		return fullDenomPath, true, nil
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
-	)
-
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
-	if err != nil {
-		return 0, err
+	// transfer the coins to the module account and burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		return "", false, err
 	}
 
-	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
-			)
-		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
-	}()
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
 
-	return sequence, nil
+	return fullDenomPath, false, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
@@ -164,6 +210,17 @@ func (k Keeper) sendTransfer(
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	return k.OnRecvPacketV2(ctx, packet, data.ToV2())
+}
+
+// OnRecvPacketV2 processes a cross chain fungible token transfer of one or more
+// tokens. Each token is received as described in OnRecvPacket. If any token
+// cannot be received an error is returned, in which case core IBC discards the
+// state changes of all tokens of the packet. If the tokens must be forwarded, they
+// are received by the forward address of the destination channel and sent over
+// the next hop, in which case the acknowledgement is written asynchronously once
+// the forwarded packet is acknowledged or times out.
+func (k Keeper) OnRecvPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
@@ -173,17 +230,44 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+	var receiver sdk.AccAddress
+	if data.ShouldBeForwarded() {
+		// the tokens are held by the forward address until they are forwarded
+		receiver = types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	} else {
+		// decode the receiver address
+		var err error
+		receiver, err = sdk.AccAddressFromBech32(data.Receiver)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		}
 	}
 
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+	receivedCoins := sdk.NewCoins()
+	for _, token := range data.Tokens {
+		coin, err := k.receiveToken(ctx, packet, token, receiver)
+		if err != nil {
+			return err
+		}
+
+		receivedCoins = receivedCoins.Add(coin)
+	}
+
+	if data.ShouldBeForwarded() {
+		return k.forwardPacket(ctx, packet, data, receivedCoins)
+	}
+
+	return nil
+}
+
+// receiveToken unescrows or mints the token received in the given packet to the receiver
+// and returns the received coin.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, receiver sdk.AccAddress) (sdk.Coin, error) {
+	coin, err := receivedCoin(packet, token)
+	if err != nil {
+		return sdk.Coin{}, err
 	}
+	transferAmount := coin.Amount
 
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
@@ -197,31 +281,20 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
 	// chain would have prefixed with DestPort and DestChannel when originally
 	// receiving this coin as seen in the "sender chain is the source" condition.
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// sender chain is not the source, unescrow tokens
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
-
-		// coin denomination used in sending from the escrow address
-		denom := unprefixedDenom
-
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := types.ParseDenomTrace(unprefixedDenom)
-		if !denomTrace.IsNativeDenom() {
-			denom = denomTrace.IBCDenom()
-		}
-		token := sdk.NewCoin(denom, transferAmount)
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
 
 		if k.bankKeeper.BlockedAddr(receiver) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+			return sdk.Coin{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
-			return err
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, coin); err != nil {
+			return sdk.Coin{}, err
 		}
 
 		defer func() {
@@ -242,7 +315,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return coin, nil
 	}
 
 	// sender chain is the source, mint vouchers
@@ -250,7 +323,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
 	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
 	// NOTE: sourcePrefix contains the trailing "/"
-	prefixedDenom := sourcePrefix + data.Denom
+	prefixedDenom := sourcePrefix + token.Denom
 
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
@@ -260,28 +333,26 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		k.SetDenomTrace(ctx, denomTrace)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
 			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
+			sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
 		),
 	)
-	voucher := sdk.NewCoin(voucherDenom, transferAmount)
 
 	// mint new tokens if the source of the transfer is the same chain
 	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(voucher),
+		ctx, types.ModuleName, sdk.NewCoins(coin),
 	); err != nil {
-		return errorsmod.Wrap(err, "failed to mint IBC tokens")
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint IBC tokens")
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
+		ctx, types.ModuleName, receiver, sdk.NewCoins(coin),
 	); err != nil {
-		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 	}
 
 	defer func() {
@@ -289,7 +360,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			telemetry.SetGaugeWithLabels(
 				[]string{"ibc", types.ModuleName, "packet", "receive"},
 				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
 			)
 		}
 
@@ -302,67 +373,136 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return coin, nil
+}
+
+// receivedCoin returns the coin on this chain of the token received in the given packet. The
+// denomination is unprefixed if this chain is the source of the token, otherwise it is the
+// voucher denomination of the token prefixed with the destination port and channel.
+func receivedCoin(packet channeltypes.Packet, token types.Token) (sdk.Coin, error) {
+	// parse the transfer amount
+	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+	}
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
+
+		// The denomination used to send the coins is either the native denom or the hash of the path
+		// if the denomination is not native.
+		denom := unprefixedDenom
+		denomTrace := types.ParseDenomTrace(unprefixedDenom)
+		if !denomTrace.IsNativeDenom() {
+			denom = denomTrace.IBCDenom()
+		}
+
+		return sdk.NewCoin(denom, transferAmount), nil
+	}
+
+	// NOTE: the source prefix contains the trailing "/"
+	prefixedDenom := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + token.Denom
+	return sdk.NewCoin(types.ParseDenomTrace(prefixedDenom).IBCDenom(), transferAmount), nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// the sender is refunded their tokens using the refundPacketTokens function.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	return k.OnAcknowledgementPacketV2(ctx, packet, data.ToV2(), ack)
+}
+
+// OnAcknowledgementPacketV2 responds to the acknowledgement of a packet transferring
+// one or more tokens as described in OnAcknowledgementPacket. If the packet was sent
+// to forward the tokens of a received packet, the acknowledgement of the received
+// packet is written.
+func (k Keeper) OnAcknowledgementPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+			return err
+		}
+
+		forwardedAck := channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrForwardedPacketFailed, "packet sequence %d", packet.GetSequence()))
+		return k.onForwardedPacketResult(ctx, packet, forwardedAck)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
-		return nil
+		// needs to be executed and no error needs to be returned, unless the
+		// tokens were forwarded
+		return k.onForwardedPacketResult(ctx, packet, ack)
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	return k.OnTimeoutPacketV2(ctx, packet, data.ToV2())
 }
 
-// refundPacketToken will unescrow and send back the tokens back to sender
+// OnTimeoutPacketV2 refunds the sender all tokens of the original packet since
+// it was never received and has been timed out. If the packet was sent to forward
+// the tokens of a received packet, an error acknowledgement is written for the
+// received packet.
+func (k Keeper) OnTimeoutPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		return err
+	}
+
+	forwardedAck := channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrForwardedPacketTimedOut, "packet sequence %d", packet.GetSequence()))
+	return k.onForwardedPacketResult(ctx, packet, forwardedAck)
+}
+
+// refundPacketTokens will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// the sending address. All tokens of the packet are refunded.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
-	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
-
 	// decode the sender address
 	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
 	}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	for _, token := range data.Tokens {
+		if err := k.refundToken(ctx, packet, token, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundToken refunds a single token of the packet to the sender.
+func (k Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, sender sdk.AccAddress) error {
+	// parse the denomination from the full denom path
+	trace := types.ParseDenomTrace(token.Denom)
+
+	// parse the transfer amount
+	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+	coin := sdk.NewCoin(trace.IBCDenom(), transferAmount)
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
+		return k.unescrowToken(ctx, escrowAddress, sender, coin)
 	}
 
 	// mint vouchers back to sender
 	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(token),
+		ctx, types.ModuleName, sdk.NewCoins(coin),
 	); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(token)); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin)); err != nil {
 		panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 	}
 
@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgChannelOpenInit = "op_weight_msg_transfer_channel_open_init" //nolint:gosec
+	OpWeightMsgTransfer        = "op_weight_msg_transfer"                   //nolint:gosec
+
+	DefaultWeightMsgChannelOpenInit = 10
+	DefaultWeightMsgTransfer        = 100
+)
+
+// packetTimeout is the timeout of the packets sent by simulated transfers, relative to the block time
+const packetTimeout = 24 * time.Hour
+
+// WeightedOperations returns all the transfer module operations with their respective weights. Transfer channels
+// are opened and tokens are transferred over the 09-localhost connection of the simulated chain.
+func WeightedOperations(
+	appParams simtypes.AppParams, registry codectypes.InterfaceRegistry, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper,
+	ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simulation.WeightedOperations {
+	cdc := codec.NewProtoCodec(registry)
+
+	var weightMsgChannelOpenInit, weightMsgTransfer int
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenInit, &weightMsgChannelOpenInit, nil,
+		func(_ *rand.Rand) { weightMsgChannelOpenInit = DefaultWeightMsgChannelOpenInit },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) { weightMsgTransfer = DefaultWeightMsgTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgChannelOpenInit, SimulateMsgChannelOpenInit(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgTransfer, SimulateMsgTransfer(cdc, k, ibcKeeper, ak, bk)),
+	}
+}
+
+// SimulateMsgChannelOpenInit generates a MsgChannelOpenInit for a transfer channel on the localhost connection,
+// using a random supported transfer version.
+func SimulateMsgChannelOpenInit(cdc *codec.ProtoCodec, k keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		portID := k.GetPort(ctx)
+		version := types.SupportedVersions[r.Intn(len(types.SupportedVersions))]
+		msg := channeltypes.NewMsgChannelOpenInit(
+			portID, version, channeltypes.UNORDERED, []string{exported.LocalhostConnectionID}, portID, simAccount.Address.String(),
+		)
+
+		opMsg, _, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk, nil))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgTransfer generates a MsgTransfer of random spendable tokens of a random account over an open
+// transfer channel on the localhost connection. The packet sent is relayed in a future operation.
+func SimulateMsgTransfer(
+	cdc *codec.ProtoCodec, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+
+		portID := k.GetPort(ctx)
+
+		var channels []channeltypes.IdentifiedChannel
+		for _, channel := range ibcsims.GetLocalhostChannels(ctx, ibcKeeper) {
+			if channel.PortId == portID && channel.State == channeltypes.OPEN {
+				channels = append(channels, channel)
+			}
+		}
+
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open localhost transfer channel found"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, sender.Address))
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender has no spendable coins"), nil, nil
+		}
+
+		timeoutTimestamp := uint64(ctx.BlockTime().Add(packetTimeout).UnixNano())
+
+		var msg *types.MsgTransfer
+		// multiple tokens may only be transferred over channels which negotiated ics20-2
+		if channel.Version == types.V2 && len(coins) > 1 {
+			msg = types.NewMsgTransferWithTokens(
+				channel.PortId, channel.ChannelId, coins, sender.Address.String(), receiver.Address.String(),
+				clienttypes.ZeroHeight(), timeoutTimestamp, "",
+			)
+		} else {
+			coins = coins[:1]
+			msg = types.NewMsgTransfer(
+				channel.PortId, channel.ChannelId, coins[0], sender.Address.String(), receiver.Address.String(),
+				clienttypes.ZeroHeight(), timeoutTimestamp, "",
+			)
+		}
+
+		opMsg, res, err := ibcsims.DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, sender, ak, bk, coins))
+		if err != nil || res == nil {
+			return opMsg, nil, err
+		}
+
+		packet, err := ibcsims.ParsePacketFromEvents(res.Events)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to parse packet"), nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+				Op:          ibcsims.SimulateMsgRecvPacket(cdc, ibcKeeper, ak, bk, packet),
+			},
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// newOperationInput returns the operation input delivering the provided message signed by the simulation account.
+func newOperationInput(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec, msg sdk.Msg,
+	simAccount simtypes.Account, ak simulation.AccountKeeper, bk simulation.BankKeeper, coinsSpentInMsg sdk.Coins,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		CoinsSpentInMsg: coinsSpentInMsg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+}
@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/simulation"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcsims "github.com/cosmos/ibc-go/v7/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestSimulateTransferOperations(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	app := chain.GetSimApp()
+	cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+
+	// no transfer is generated until a transfer channel is open
+	opMsg, _, err := simulation.SimulateMsgTransfer(cdc, app.TransferKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper)(
+		rand.New(rand.NewSource(1)), app.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID,
+	)
+	require.NoError(t, err)
+	require.False(t, opMsg.OK)
+
+	runOperation(t, chain, simulation.SimulateMsgChannelOpenInit(cdc, app.TransferKeeper, app.AccountKeeper, app.BankKeeper))
+
+	channels := ibcsims.GetLocalhostChannels(chain.GetContext(), app.IBCKeeper)
+	require.Len(t, channels, 1)
+	require.Equal(t, app.TransferKeeper.GetPort(chain.GetContext()), channels[0].PortId)
+	require.Equal(t, channeltypes.INIT, channels[0].State)
+
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenTry(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenAck(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	runOperation(t, chain, ibcsims.SimulateMsgChannelOpenConfirm(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+
+	// the packet sent by the transfer is received in a future operation
+	futureOps := runOperation(t, chain, simulation.SimulateMsgTransfer(cdc, app.TransferKeeper, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Len(t, futureOps, 1)
+
+	runOperation(t, chain, futureOps[0].Op)
+}
+
+// runOperation runs the simulation operation against the chain and requires the generated message to be delivered.
+func runOperation(t *testing.T, chain *ibctesting.TestChain, op simtypes.Operation) []simtypes.FutureOperation {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(1))
+	opMsg, futureOps, err := op(r, chain.App.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID)
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+
+	return futureOps
+}
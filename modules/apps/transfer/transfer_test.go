@@ -2,15 +2,18 @@ package transfer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 )
 
@@ -143,6 +146,270 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// TestHandleMsgTransferWithMultipleTokens transfers multiple tokens from chainA to chainB
+// and back over an ics20-2 channel and asserts that an ics20-1 channel rejects the transfer.
+func (suite *TransferTestSuite) TestHandleMsgTransferWithMultipleTokens() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(path)
+
+	pathV1 := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathV1)
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin("foo", amount))
+	suite.fundAccount(suite.chainA, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin("foo", amount)))
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	originalBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+	timeoutHeight := suite.chainB.GetTimeoutHeight()
+
+	// multiple tokens cannot be transferred over an ics20-1 channel
+	msg := types.NewMsgTransferWithTokens(pathV1.EndpointA.ChannelConfig.PortID, pathV1.EndpointA.ChannelID, coins, sender.String(), receiver.String(), timeoutHeight, 0, "")
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+
+	// send from chainA to chainB
+	msg = types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, sender.String(), receiver.String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var packetData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Len(packetData.Tokens, 2)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// check that the escrow account holds both tokens and the vouchers exist on chainB
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(coins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress))
+
+	var vouchers sdk.Coins
+	for _, coin := range coins {
+		voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin.Denom, coin.Amount)
+		suite.Require().Equal(voucher, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom))
+		vouchers = vouchers.Add(voucher)
+	}
+
+	// send the vouchers back from chainB to chainA
+	msg = types.NewMsgTransferWithTokens(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, vouchers, receiver.String(), sender.String(), suite.chainA.GetTimeoutHeight(), 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// check that the vouchers are burned and the tokens are unescrowed
+	for _, voucher := range vouchers {
+		suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom).IsZero())
+	}
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress).IsZero())
+	suite.Require().Equal(originalBalances, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender))
+}
+
+// TestTimeoutMsgTransferWithMultipleTokens asserts that all tokens of a timed out
+// ics20-2 packet are refunded to the sender.
+func (suite *TransferTestSuite) TestTimeoutMsgTransferWithMultipleTokens() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(path)
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin("foo", amount))
+	suite.fundAccount(suite.chainA, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin("foo", amount)))
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+	msg := types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// commit a block on chainB so the packet times out
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress).IsZero())
+	suite.Require().Equal(originalBalances, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender))
+
+	for _, coin := range coins {
+		suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Denom).IsZero())
+	}
+}
+
+// TestHandleMsgTransferWithForwarding transfers multiple tokens from chainA to chainC through
+// chainB, which forwards the tokens over the forwarding hop and acknowledges the packet sent
+// from chainA once the forwarded packet is acknowledged.
+func (suite *TransferTestSuite) TestHandleMsgTransferWithForwarding() {
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin("foo", amount))
+	suite.fundAccount(suite.chainA, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin("foo", amount)))
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	msg := types.NewMsgTransferWithTokens(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coins, sender.String(), receiver.String(), suite.chainB.GetTimeoutHeight(), 0, "destination memo")
+	msg.ForwardingHops = []types.Hop{types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)}
+
+	packet, forwardedPacket := suite.sendForwardedTransfer(msg, pathAtoB, pathBtoC)
+
+	var forwardedData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(forwardedPacket.GetData(), &forwardedData))
+	suite.Require().Equal(receiver.String(), forwardedData.Receiver)
+	suite.Require().Equal("destination memo", forwardedData.Memo)
+	suite.Require().Nil(forwardedData.Forwarding)
+
+	// relay the forwarded packet to chainC and its acknowledgement back to chainB
+	res, err := pathBtoC.EndpointB.RecvPacketWithResult(forwardedPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathBtoC.EndpointA.AcknowledgePacket(forwardedPacket, ack))
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().False(found)
+
+	// the acknowledgement of the packet sent from chainA is written on chainB
+	suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, ack))
+
+	// the vouchers are escrowed on chainB and the receiver holds the vouchers of the vouchers on chainC
+	forwardAddress := types.GetForwardAddress(packet.DestinationPort, packet.DestinationChannel)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+
+	for _, coin := range coins {
+		voucherOnB := types.GetTransferCoin(packet.DestinationPort, packet.DestinationChannel, coin.Denom, coin.Amount)
+		escrowAddress := types.GetEscrowAddress(forwardedPacket.SourcePort, forwardedPacket.SourceChannel)
+		suite.Require().Equal(voucherOnB, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, voucherOnB.Denom))
+
+		fullDenomPath := types.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, coin.Denom)
+		voucherOnC := types.GetTransferCoin(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, fullDenomPath, coin.Amount)
+		suite.Require().Equal(voucherOnC, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, voucherOnC.Denom))
+	}
+}
+
+// TestForwardedPacketTimeout asserts that the tokens of a forwarded packet which times out are
+// returned to chainA, where they are refunded to the sender upon the error acknowledgement.
+func (suite *TransferTestSuite) TestForwardedPacketTimeout() {
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+
+	msg := types.NewMsgTransferWithTokens(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coins, sender.String(), suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	msg.ForwardingHops = []types.Hop{types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)}
+
+	packet, forwardedPacket := suite.sendForwardedTransfer(msg, pathAtoB, pathBtoC)
+
+	// time out the forwarded packet on chainB
+	suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultRelativePacketTimeoutTimestamp))
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(pathBtoC.EndpointA.UpdateClient())
+
+	suite.Require().NoError(pathBtoC.EndpointA.TimeoutPacket(forwardedPacket))
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().False(found)
+
+	// the vouchers received on chainB are burned
+	forwardAddress := types.GetForwardAddress(packet.DestinationPort, packet.DestinationChannel)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+	voucherOnB := types.GetTransferCoin(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom, amount)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherOnB.Denom).IsZero())
+
+	// the error acknowledgement written on chainB refunds the sender on chainA
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimedOut)
+	suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+	suite.Require().Equal(originalBalances, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender))
+}
+
+// setupForwardingPaths sets up ics20-2 paths from chainA to chainB and from chainB to chainC.
+func (suite *TransferTestSuite) setupForwardingPaths() (*ibctesting.Path, *ibctesting.Path) {
+	pathAtoB := NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	pathBtoC := NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathBtoC)
+
+	return pathAtoB, pathBtoC
+}
+
+// sendForwardedTransfer sends the transfer from chainA and receives it on chainB, which forwards
+// the tokens to chainC. The sent and forwarded packets are returned.
+func (suite *TransferTestSuite) sendForwardedTransfer(msg *types.MsgTransfer, pathAtoB, pathBtoC *ibctesting.Path) (channeltypes.Packet, channeltypes.Packet) {
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var packetData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().True(packetData.ShouldBeForwarded())
+	suite.Require().Empty(packetData.Memo)
+
+	suite.Require().NoError(pathAtoB.EndpointB.UpdateClient())
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is not written until the forwarded packet is acknowledged
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the forwarded tokens are sent by the forward address of the channel on chainB
+	var forwardedData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(forwardedPacket.GetData(), &forwardedData))
+	suite.Require().Equal(types.GetForwardAddress(packet.DestinationPort, packet.DestinationChannel).String(), forwardedData.Sender)
+
+	receivedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(packet, receivedPacket)
+
+	suite.Require().NoError(pathBtoC.EndpointB.UpdateClient())
+
+	return packet, forwardedPacket
+}
+
+// fundAccount mints the provided coins and sends them to the given address on the chain.
+func (suite *TransferTestSuite) fundAccount(chain *ibctesting.TestChain, addr sdk.AccAddress, coins sdk.Coins) {
+	ctx := chain.GetContext()
+	suite.Require().NoError(chain.GetSimApp().BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(chain.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	suite.coordinator.CommitBlock(chain)
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	suite.Run(t, new(TransferTestSuite))
 }
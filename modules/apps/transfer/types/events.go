@@ -11,12 +11,15 @@ const (
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyTokens         = "tokens"
 	AttributeKeyRefundReceiver = "refund_receiver"
 	AttributeKeyRefundDenom    = "refund_denom"
 	AttributeKeyRefundAmount   = "refund_amount"
+	AttributeKeyRefundTokens   = "refund_tokens"
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyForwardingHops = "forwarding_hops"
 )
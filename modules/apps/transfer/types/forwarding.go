@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// MaximumForwardingHopsLength is the maximum number of hops over which tokens may be forwarded
+const MaximumForwardingHopsLength = 8
+
+// NewHop creates a new Hop instance
+func NewHop(portID, channelID string) Hop {
+	return Hop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the hop identifiers.
+func (h Hop) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source port ID %s", h.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source channel ID %s", h.ChannelId)
+	}
+
+	return nil
+}
+
+// NewForwardingPacketData creates a new ForwardingPacketData instance
+func NewForwardingPacketData(destinationMemo string, hops ...Hop) *ForwardingPacketData {
+	return &ForwardingPacketData{
+		DestinationMemo: destinationMemo,
+		Hops:            hops,
+	}
+}
+
+// Validate performs a basic validation of the forwarding hops. At least one hop must be
+// provided and the number of hops must not exceed MaximumForwardingHopsLength.
+func (fpd ForwardingPacketData) Validate() error {
+	return validateHops(fpd.Hops)
+}
+
+// validateHops validates the given forwarding hops.
+func validateHops(hops []Hop) error {
+	if len(hops) == 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "hops cannot be empty")
+	}
+	if len(hops) > MaximumForwardingHopsLength {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hops must not exceed %d", MaximumForwardingHopsLength)
+	}
+
+	for _, hop := range hops {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidForwarding, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +34,9 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// forwarded_packets contains the received packets whose tokens are being forwarded
+	// and which are not acknowledged yet
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,8 +100,86 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+// ForwardedPacket defines a received packet whose tokens were forwarded, keyed by the
+// port ID, channel ID and sequence of the packet sent to forward the tokens.
+type ForwardedPacket struct {
+	PortId    string        `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string        `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64        `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Packet    types1.Packet `protobuf:"bytes,4,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *ForwardedPacket) Reset()         { *m = ForwardedPacket{} }
+func (m *ForwardedPacket) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacket) ProtoMessage()    {}
+func (*ForwardedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{1}
+}
+func (m *ForwardedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacket.Merge(m, src)
+}
+func (m *ForwardedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedPacket proto.InternalMessageInfo
+
+func (m *ForwardedPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ForwardedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ForwardedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardedPacket) GetPacket() types1.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types1.Packet{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
+	proto.RegisterType((*ForwardedPacket)(nil), "ibc.applications.transfer.v1.ForwardedPacket")
 }
 
 func init() {
@@ -105,31 +187,38 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x08, 0x27, 0xa4, 0xb0, 0x90, 0x30, 0x11, 0x72, 0x22, 0x44, 0x61, 0x81,
-	0xb2, 0x8b, 0x43, 0x91, 0xde, 0x80, 0x10, 0x1d, 0x18, 0x2a, 0x28, 0xa2, 0xf5, 0x7a, 0x31, 0x2b,
-	0x62, 0x8f, 0xb5, 0xb3, 0x31, 0xe2, 0x2f, 0xf8, 0x8e, 0xfb, 0x86, 0xfb, 0x80, 0x94, 0x29, 0xaf,
-	0xba, 0x3b, 0x25, 0x3f, 0x72, 0xf2, 0xda, 0x17, 0x45, 0x3a, 0x29, 0x95, 0x67, 0x3c, 0xef, 0xbd,
-	0x99, 0x7d, 0xcf, 0x7d, 0x2d, 0x53, 0x4e, 0x59, 0x55, 0xad, 0x25, 0x67, 0x5a, 0x42, 0x89, 0x54,
-	0x2b, 0x56, 0xe2, 0x2f, 0xa1, 0x68, 0x1d, 0xd1, 0x5c, 0x94, 0x02, 0x25, 0x92, 0x4a, 0x81, 0x06,
-	0xef, 0x85, 0x4c, 0x39, 0x39, 0xc5, 0x92, 0x7b, 0x2c, 0xa9, 0xa3, 0xc9, 0x9b, 0xb3, 0x4a, 0x47,
-	0xa4, 0x91, 0x9a, 0x04, 0x1c, 0xb0, 0x00, 0xa4, 0x29, 0x43, 0x41, 0xeb, 0x28, 0x15, 0x9a, 0x45,
-	0x94, 0x83, 0x2c, 0xbb, 0xf9, 0xd3, 0x1c, 0x72, 0x30, 0x25, 0x6d, 0xaa, 0xf6, 0xef, 0xcb, 0xcb,
-	0x9e, 0x3b, 0xfa, 0xd4, 0x9e, 0xf4, 0x4d, 0x33, 0x2d, 0xbc, 0x67, 0xee, 0xa3, 0x0a, 0x94, 0x5e,
-	0xc9, 0xcc, 0xb7, 0x67, 0x76, 0xf8, 0x38, 0x71, 0x9a, 0xf6, 0x73, 0xe6, 0xfd, 0x74, 0x47, 0x99,
-	0x28, 0xa1, 0x58, 0x69, 0xc5, 0xb8, 0x40, 0xbf, 0x37, 0xeb, 0x87, 0xc3, 0x45, 0x48, 0xce, 0xbd,
-	0x80, 0x7c, 0x68, 0x18, 0xdf, 0x1b, 0x42, 0x3c, 0xde, 0x5e, 0x4f, 0xad, 0x8b, 0x9b, 0xa9, 0x63,
-	0x5a, 0x4c, 0x86, 0xd9, 0x71, 0x86, 0x5e, 0xec, 0x3a, 0x15, 0x53, 0xac, 0x40, 0xbf, 0x3f, 0xb3,
-	0xc3, 0xe1, 0xe2, 0xd5, 0x79, 0xd9, 0x2f, 0x06, 0x1b, 0x0f, 0x1a, 0xc9, 0xa4, 0x63, 0x7a, 0xca,
-	0x1d, 0x6b, 0xd0, 0x6c, 0xbd, 0x12, 0xc8, 0x15, 0xfc, 0x15, 0x99, 0x3f, 0x30, 0x27, 0x3e, 0x27,
-	0xad, 0x33, 0xa4, 0x71, 0x86, 0x74, 0xce, 0x90, 0xf7, 0x20, 0xcb, 0xf8, 0x6d, 0x77, 0x53, 0x98,
-	0x4b, 0xfd, 0x7b, 0x93, 0x12, 0x0e, 0x05, 0xed, 0x6c, 0x6c, 0x3f, 0x73, 0xcc, 0xfe, 0x50, 0xfd,
-	0xaf, 0x12, 0x68, 0x08, 0x98, 0x3c, 0x31, 0x2b, 0x3e, 0x76, 0x1b, 0xe2, 0xaf, 0xdb, 0x7d, 0x60,
-	0xef, 0xf6, 0x81, 0x7d, 0xbb, 0x0f, 0xec, 0xff, 0x87, 0xc0, 0xda, 0x1d, 0x02, 0xeb, 0xea, 0x10,
-	0x58, 0x3f, 0x96, 0x0f, 0x25, 0x65, 0xca, 0xe7, 0x39, 0xd0, 0x7a, 0x49, 0x0b, 0xc8, 0x36, 0x6b,
-	0x81, 0x4d, 0xb6, 0x27, 0x99, 0x9a, 0x3d, 0xa9, 0x63, 0x82, 0x79, 0x77, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x5f, 0x34, 0x4a, 0xbc, 0x47, 0x02, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xce, 0x36, 0x21, 0x50, 0xa7, 0x14, 0x58, 0x21, 0xb1, 0x04, 0xd8, 0x86, 0x8a, 0xc3, 0x0a,
+	0x14, 0x9b, 0x94, 0x43, 0xc5, 0x75, 0xf9, 0x53, 0x6f, 0x65, 0xe1, 0x04, 0x87, 0xe0, 0xb5, 0x9d,
+	0xad, 0xd5, 0xec, 0x7a, 0xf1, 0x38, 0xa9, 0x78, 0x0b, 0x9e, 0x00, 0x71, 0xe6, 0x49, 0x7a, 0xec,
+	0x91, 0x13, 0xa0, 0xe4, 0x45, 0x90, 0x1d, 0x27, 0x0a, 0x20, 0x72, 0x5a, 0xdb, 0xf3, 0x7d, 0xdf,
+	0xcc, 0x7c, 0x33, 0x8b, 0x1e, 0xca, 0x9c, 0x11, 0x5a, 0xd7, 0x63, 0xc9, 0xa8, 0x91, 0xaa, 0x02,
+	0x62, 0x34, 0xad, 0x60, 0x24, 0x34, 0x99, 0x0e, 0x48, 0x21, 0x2a, 0x01, 0x12, 0x70, 0xad, 0x95,
+	0x51, 0xe1, 0x5d, 0x99, 0x33, 0xbc, 0x8e, 0xc5, 0x4b, 0x2c, 0x9e, 0x0e, 0xba, 0x8f, 0x36, 0x2a,
+	0xad, 0x90, 0x4e, 0xaa, 0x1b, 0x33, 0x05, 0xa5, 0x02, 0x92, 0x53, 0x10, 0x64, 0x3a, 0xc8, 0x85,
+	0xa1, 0x03, 0xc2, 0x94, 0xac, 0x7c, 0xfc, 0x66, 0xa1, 0x0a, 0xe5, 0x8e, 0xc4, 0x9e, 0xfc, 0xeb,
+	0x7d, 0x9b, 0x82, 0x29, 0x2d, 0x08, 0x3b, 0xa1, 0x55, 0x25, 0xc6, 0x56, 0xd9, 0x1f, 0x17, 0x90,
+	0xfd, 0x2f, 0x4d, 0xb4, 0xf3, 0x6a, 0x51, 0xf5, 0x1b, 0x43, 0x8d, 0x08, 0x6f, 0xa1, 0xcb, 0xb5,
+	0xd2, 0x66, 0x28, 0x79, 0x14, 0xf4, 0x82, 0x64, 0x3b, 0x6b, 0xdb, 0xeb, 0x11, 0x0f, 0xdf, 0xa3,
+	0x1d, 0x2e, 0x2a, 0x55, 0x0e, 0x8d, 0xa6, 0x4c, 0x40, 0xb4, 0xd5, 0x6b, 0x26, 0x9d, 0x83, 0x04,
+	0x6f, 0x6a, 0x12, 0x3f, 0xb7, 0x8c, 0xb7, 0x96, 0x90, 0xee, 0x9e, 0xff, 0xd8, 0x6b, 0x7c, 0xfb,
+	0xb9, 0xd7, 0x76, 0x57, 0xc8, 0x3a, 0x7c, 0x15, 0x83, 0x30, 0x45, 0xed, 0x9a, 0x6a, 0x5a, 0x42,
+	0xd4, 0xec, 0x05, 0x49, 0xe7, 0xe0, 0xc1, 0x66, 0xd9, 0x63, 0x87, 0x4d, 0x5b, 0x56, 0x32, 0xf3,
+	0xcc, 0x50, 0xa3, 0x5d, 0xa3, 0x0c, 0x1d, 0x0f, 0x05, 0x30, 0xad, 0xce, 0x04, 0x8f, 0x5a, 0xae,
+	0xc4, 0xdb, 0x78, 0x61, 0x1e, 0xb6, 0xe6, 0x61, 0x6f, 0x1e, 0x7e, 0xa6, 0x64, 0x95, 0x3e, 0xf6,
+	0x35, 0x25, 0x85, 0x34, 0x27, 0x93, 0x1c, 0x33, 0x55, 0x12, 0xef, 0xf4, 0xe2, 0xd3, 0x07, 0x7e,
+	0x4a, 0xcc, 0xa7, 0x5a, 0x80, 0x23, 0x40, 0x76, 0xd5, 0xa5, 0x78, 0xe1, 0x33, 0x84, 0x1f, 0xd0,
+	0x8d, 0x91, 0xd2, 0x67, 0x54, 0x73, 0xc1, 0x87, 0x35, 0x65, 0xa7, 0xc2, 0x40, 0x74, 0xc9, 0xa5,
+	0xed, 0x6f, 0x6e, 0xe1, 0xe5, 0x92, 0x76, 0xec, 0x58, 0xbe, 0x97, 0xeb, 0xa3, 0x3f, 0x9f, 0x61,
+	0xff, 0x6b, 0x80, 0xae, 0xfd, 0x85, 0xfd, 0xff, 0x8c, 0xee, 0x21, 0xe4, 0xc7, 0x6b, 0x63, 0x5b,
+	0x2e, 0xb6, 0xed, 0x5f, 0x8e, 0x78, 0xd8, 0x45, 0x57, 0x40, 0x7c, 0x9c, 0x88, 0x8a, 0x09, 0xe7,
+	0x73, 0x2b, 0x5b, 0xdd, 0xc3, 0xa7, 0x76, 0x02, 0x56, 0x3d, 0x6a, 0xb9, 0x09, 0xdc, 0x71, 0xe5,
+	0xdb, 0xe5, 0xc1, 0xcb, 0x8d, 0x71, 0xc6, 0xaf, 0x15, 0xeb, 0x09, 0xe9, 0xeb, 0xf3, 0x59, 0x1c,
+	0x5c, 0xcc, 0xe2, 0xe0, 0xd7, 0x2c, 0x0e, 0x3e, 0xcf, 0xe3, 0xc6, 0xc5, 0x3c, 0x6e, 0x7c, 0x9f,
+	0xc7, 0x8d, 0x77, 0x87, 0xff, 0xfa, 0x2a, 0x73, 0xd6, 0x2f, 0x14, 0x99, 0x1e, 0x92, 0x52, 0xf1,
+	0xc9, 0x58, 0x80, 0xfd, 0x07, 0xd6, 0x76, 0xdf, 0x99, 0x9d, 0xb7, 0xdd, 0x76, 0x3e, 0xf9, 0x3d,
+	0x00, 0x8f, 0x8e, 0xb4, 0x79, 0x6f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +241,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -200,6 +303,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -235,6 +390,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -406,6 +589,206 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
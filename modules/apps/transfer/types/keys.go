@@ -5,15 +5,25 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
 	// ModuleName defines the IBC transfer name
 	ModuleName = "transfer"
 
-	// Version defines the current version the IBC tranfer
-	// module supports
-	Version = "ics20-1"
+	// V1 defines the first version of the IBC transfer module, which transfers a
+	// single token per packet
+	V1 = "ics20-1"
+
+	// V2 defines the version of the IBC transfer module which allows multiple
+	// tokens to be transferred in a single packet
+	V2 = "ics20-2"
+
+	// Version defines the default version the IBC tranfer module proposes
+	// when no version is specified. It remains V1 for compatibility with
+	// counterparties which do not support V2.
+	Version = V1
 
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
@@ -32,10 +42,17 @@ const (
 
 	KeyTotalEscrowPrefix = "totalEscrowForDenom"
 
+	// KeyForwardedPacketPrefix is the key prefix used to store the received packets whose
+	// tokens are forwarded
+	KeyForwardedPacketPrefix = "forwardedPacket"
+
 	ParamsKey = "params"
 )
 
 var (
+	// SupportedVersions defines the versions the IBC transfer module supports
+	SupportedVersions = []string{V2, V1}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
@@ -51,15 +68,41 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
 	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	preImage := []byte(V1)
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
 
+// GetForwardAddress returns the address which holds the tokens received over the specified
+// channel until they are forwarded. Tokens are only held by the forward address while a packet
+// is being received or while a forwarded packet is refunded.
+func GetForwardAddress(portID, channelID string) sdk.AccAddress {
+	// a distinct module name is used so that the forward address does not collide with
+	// the escrow address of the same channel
+	return address.Module(ModuleName, []byte(fmt.Sprintf("forward/%s/%s", portID, channelID)))
+}
+
 // TotalEscrowForDenomKey returns the store key of under which the total amout of
 // source chain tokens in escrow is stored.
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// ForwardedPacketKey returns the store key under which the received packet whose tokens
+// were forwarded is stored, keyed by the packet sent to forward the tokens.
+func ForwardedPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyForwardedPacketPrefix, portID, channelID, sequence))
+}
+
+// IsSupportedVersion returns true if the given version is supported by the IBC transfer module.
+func IsSupportedVersion(version string) bool {
+	for _, supportedVersion := range SupportedVersions {
+		if version == supportedVersion {
+			return true
+		}
+	}
+
+	return false
+}
@@ -61,6 +61,26 @@ func NewMsgTransfer(
 	}
 }
 
+// NewMsgTransferWithTokens creates a new MsgTransfer instance which transfers
+// multiple tokens in a single packet
+func NewMsgTransferWithTokens(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
 // Route implements sdk.Msg
 func (MsgTransfer) Route() string {
 	return RouterKey
@@ -77,12 +97,24 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return errorsmod.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+	if len(msg.Tokens) > 0 && !isEmptyCoin(msg.Token) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "only one of token and tokens may be set")
+	}
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
 	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
+
+	denoms := make(map[string]struct{})
+	for _, coin := range msg.GetCoins() {
+		if err := validateCoin(coin); err != nil {
+			return err
+		}
+		if _, found := denoms[coin.Denom]; found {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate denomination %s", coin.Denom)
+		}
+		denoms[coin.Denom] = struct{}{}
 	}
+
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -91,7 +123,32 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	if len(msg.ForwardingHops) > 0 {
+		if err := validateHops(msg.ForwardingHops); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetForwarding returns the forwarding information of the packet sent for the message, which
+// carries the memo of the message to the final receiver, or nil if the tokens are not forwarded.
+func (msg MsgTransfer) GetForwarding() *ForwardingPacketData {
+	if len(msg.ForwardingHops) == 0 {
+		return nil
+	}
+
+	return NewForwardingPacketData(msg.Memo, msg.ForwardingHops...)
+}
+
+// GetCoins returns the coins to be transferred, which are either the tokens
+// or the single token of the message.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) > 0 {
+		return msg.Tokens
+	}
+
+	return sdk.Coins{msg.Token}
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -107,3 +164,20 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// validateCoin validates that the coin is a positive amount of a valid IBC denomination
+func validateCoin(coin sdk.Coin) error {
+	if !coin.IsValid() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coin.String())
+	}
+	if !coin.IsPositive() {
+		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, coin.String())
+	}
+
+	return ValidateIBCDenom(coin.Denom)
+}
+
+// isEmptyCoin returns true if the coin has not been set
+func isEmptyCoin(coin sdk.Coin) bool {
+	return coin.Denom == "" && (coin.Amount.IsNil() || coin.Amount.IsZero())
+}
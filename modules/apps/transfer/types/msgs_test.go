@@ -83,6 +83,15 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", types.NewMsgTransfer(validPort, validChannel, coin, emptyAddr, receiver, timeoutHeight, 0, ""), false},
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid msg with multiple tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), sender, receiver, timeoutHeight, 0, ""), true},
+		{"both token and tokens set", msgWithTokenAndTokens(), false},
+		{"invalid ibc denom in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, invalidIBCCoin}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"zero coin in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, zeroCoin}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"duplicate denoms in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, coin}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"too many tokens", types.NewMsgTransferWithTokens(validPort, validChannel, tooManyCoins(), sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid msg with forwarding hops", msgWithForwardingHops(types.NewHop(validPort, validChannel)), true},
+		{"invalid forwarding hop", msgWithForwardingHops(types.NewHop(invalidPort, validChannel)), false},
+		{"too many forwarding hops", msgWithForwardingHops(make([]types.Hop, types.MaximumForwardingHopsLength+1)...), false},
 	}
 
 	for i, tc := range testCases {
@@ -95,6 +104,35 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// TestMsgTransferGetCoins tests GetCoins for MsgTransfer
+func TestMsgTransferGetCoins(t *testing.T) {
+	msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	require.Equal(t, sdk.NewCoins(coin), msg.GetCoins())
+
+	msg = types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), sender, receiver, timeoutHeight, 0, "")
+	require.Equal(t, sdk.NewCoins(coin, ibcCoin), msg.GetCoins())
+}
+
+func msgWithForwardingHops(hops ...types.Hop) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	msg.ForwardingHops = hops
+	return msg
+}
+
+func msgWithTokenAndTokens() *types.MsgTransfer {
+	msg := types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(ibcCoin), sender, receiver, timeoutHeight, 0, "")
+	msg.Token = coin
+	return msg
+}
+
+func tooManyCoins() sdk.Coins {
+	coins := make(sdk.Coins, types.MaximumTokensLength+1)
+	for i := range coins {
+		coins[i] = sdk.NewCoin(fmt.Sprintf("denom%03d", i), sdkmath.NewInt(100))
+	}
+	return coins
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -10,8 +11,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
+var (
+	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketData         = (*FungibleTokenPacketDataV2)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketDataV2)(nil)
+)
+
+// MaximumTokensLength is the maximum number of tokens which may be transferred in a single packet
+const MaximumTokensLength = 100
+
 var (
 	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
 	// to the current block height of the counterparty chain provided by the client state. The
@@ -64,3 +76,202 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
 }
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
+	return getCustomMemoData(ftpd.Memo, key)
+}
+
+// ToV2 converts the packet data to the ics20-2 packet data holding the single token
+// of the packet data.
+func (ftpd FungibleTokenPacketData) ToV2() FungibleTokenPacketDataV2 {
+	return NewFungibleTokenPacketDataV2(
+		[]Token{NewToken(ftpd.Denom, ftpd.Amount)},
+		ftpd.Sender, ftpd.Receiver, ftpd.Memo,
+	)
+}
+
+// NewFungibleTokenPacketDataV2 contructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer. At least one token
+// must be transferred and a denomination may not be transferred more than once.
+// If the tokens are forwarded the memo must be empty, as the memo for the final
+// receiver is carried by the forwarding information.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return errorsmod.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+	if len(ftpd.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ErrInvalidAmount, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	denoms := make(map[string]struct{})
+	for _, token := range ftpd.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if _, found := denoms[token.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination %s", token.Denom)
+		}
+		denoms[token.Denom] = struct{}{}
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+
+	if ftpd.Forwarding != nil {
+		if err := ftpd.Forwarding.Validate(); err != nil {
+			return err
+		}
+		if ftpd.Memo != "" {
+			return errorsmod.Wrap(ErrInvalidForwarding, "memo must be empty if tokens are forwarded")
+		}
+	}
+
+	return nil
+}
+
+// ShouldBeForwarded returns true if the tokens of the packet must be forwarded by the
+// receiving chain.
+func (ftpd FungibleTokenPacketDataV2) ShouldBeForwarded() bool {
+	return ftpd.Forwarding != nil && len(ftpd.Forwarding.Hops) > 0
+}
+
+// GetBytes is a helper for serialising
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketDataV2) GetCustomPacketData(key string) interface{} {
+	return getCustomMemoData(ftpd.Memo, key)
+}
+
+// NewToken constructs a new Token instance
+func NewToken(denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of the token amount and full denomination path.
+func (t Token) Validate() error {
+	amount, ok := sdkmath.NewIntFromString(t.Amount)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// UnmarshalPacketData unmarshals the packet data bytes of a packet sent over a channel
+// of the given version and returns it as ics20-2 packet data.
+func UnmarshalPacketData(bz []byte, version string) (FungibleTokenPacketDataV2, error) {
+	switch version {
+	case V1:
+		var data FungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return data.ToV2(), nil
+	case V2:
+		var data FungibleTokenPacketDataV2
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return data, nil
+	default:
+		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ErrInvalidVersion, "unsupported version: %s", version)
+	}
+}
+
+// MarshalPacketData marshals the ics20-2 packet data into the packet data bytes of a packet
+// sent over a channel of the given version. Packet data may only be marshalled for ics20-1
+// channels if it holds a single token which is not forwarded.
+func MarshalPacketData(data FungibleTokenPacketDataV2, version string) ([]byte, error) {
+	switch version {
+	case V1:
+		if len(data.Tokens) != 1 {
+			return nil, errorsmod.Wrapf(ErrInvalidVersion, "multiple tokens cannot be transferred over %s channels", V1)
+		}
+		if data.ShouldBeForwarded() {
+			return nil, errorsmod.Wrapf(ErrInvalidVersion, "tokens cannot be forwarded over %s channels", V1)
+		}
+
+		return NewFungibleTokenPacketData(data.Tokens[0].Denom, data.Tokens[0].Amount, data.Sender, data.Receiver, data.Memo).GetBytes(), nil
+	case V2:
+		return data.GetBytes(), nil
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidVersion, "unsupported version: %s", version)
+	}
+}
+
+// getCustomMemoData interprets the memo as a JSON object and returns the value
+// associated with the given key. If the key is missing or the memo is not properly
+// formatted, then nil is returned.
+func getCustomMemoData(memo, key string) interface{} {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
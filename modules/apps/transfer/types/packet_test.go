@@ -1,11 +1,13 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 )
 
 const (
@@ -43,3 +45,139 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCustomPacketData(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memo          string
+		expCustomData interface{}
+	}{
+		{"success: memo has custom data", `{"src_callback": {"address": "addr"}}`, map[string]interface{}{"address": "addr"}},
+		{"success: memo does not have key", `{"dest_callback": {"address": "addr"}}`, nil},
+		{"success: empty memo", "", nil},
+		{"success: memo is not a json object", "memo", nil},
+	}
+
+	for _, tc := range testCases {
+		packetData := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, tc.memo)
+
+		require.Equal(t, tc.expCustomData, packetData.GetCustomPacketData("src_callback"), tc.name)
+		require.Equal(t, sender, packetData.GetPacketSender(types.PortID), tc.name)
+	}
+}
+
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	token := types.NewToken(denom, amount)
+	tooManyTokens := make([]types.Token, types.MaximumTokensLength+1)
+	for i := range tooManyTokens {
+		tooManyTokens[i] = types.NewToken(fmt.Sprintf("%s%d", denom, i), amount)
+	}
+	hop := types.NewHop("transfer", "channel-1")
+	tooManyHops := make([]types.Hop, types.MaximumForwardingHopsLength+1)
+	for i := range tooManyHops {
+		tooManyHops[i] = hop
+	}
+
+	withForwarding := func(memo string, forwarding *types.ForwardingPacketData) types.FungibleTokenPacketDataV2 {
+		packetData := types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender, receiver, memo)
+		packetData.Forwarding = forwarding
+		return packetData
+	}
+
+	testCases := []struct {
+		name       string
+		packetData types.FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender, receiver, ""), true},
+		{"valid packet with multiple tokens", types.NewFungibleTokenPacketDataV2([]types.Token{token, types.NewToken("uatom", largeAmount)}, sender, receiver, "memo"), true},
+		{"empty tokens", types.NewFungibleTokenPacketDataV2(nil, sender, receiver, ""), false},
+		{"too many tokens", types.NewFungibleTokenPacketDataV2(tooManyTokens, sender, receiver, ""), false},
+		{"duplicate denoms", types.NewFungibleTokenPacketDataV2([]types.Token{token, token}, sender, receiver, ""), false},
+		{"invalid denom", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken("", amount)}, sender, receiver, ""), false},
+		{"invalid zero amount", types.NewFungibleTokenPacketDataV2([]types.Token{token, types.NewToken("uatom", "0")}, sender, receiver, ""), false},
+		{"invalid large amount", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, invalidLargeAmount)}, sender, receiver, ""), false},
+		{"missing sender address", types.NewFungibleTokenPacketDataV2([]types.Token{token}, emptyAddr, receiver, ""), false},
+		{"missing recipient address", types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender, emptyAddr, ""), false},
+		{"valid packet with forwarding", withForwarding("", types.NewForwardingPacketData("memo", hop)), true},
+		{"memo set with forwarding", withForwarding("memo", types.NewForwardingPacketData("", hop)), false},
+		{"empty forwarding hops", withForwarding("", types.NewForwardingPacketData("memo")), false},
+		{"too many forwarding hops", withForwarding("", types.NewForwardingPacketData("", tooManyHops...)), false},
+		{"invalid forwarding hop", withForwarding("", types.NewForwardingPacketData("", types.NewHop("transfer", "invalid/channel"))), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestUnmarshalPacketData(t *testing.T) {
+	packetDataV1 := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, "memo")
+	packetDataV2 := types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount), types.NewToken("uatom", amount)}, sender, receiver, "memo")
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		version string
+		expData types.FungibleTokenPacketDataV2
+		expErr  error
+	}{
+		{"success: ics20-1 packet data", packetDataV1.GetBytes(), types.V1, packetDataV1.ToV2(), nil},
+		{"success: ics20-2 packet data", packetDataV2.GetBytes(), types.V2, packetDataV2, nil},
+		{"failure: ics20-2 packet data on ics20-1 channel", packetDataV2.GetBytes(), types.V1, types.FungibleTokenPacketDataV2{}, ibcerrors.ErrInvalidType},
+		{"failure: invalid packet data", []byte("invalid"), types.V2, types.FungibleTokenPacketDataV2{}, ibcerrors.ErrInvalidType},
+		{"failure: unsupported version", packetDataV1.GetBytes(), "ics20-3", types.FungibleTokenPacketDataV2{}, types.ErrInvalidVersion},
+	}
+
+	for _, tc := range testCases {
+		data, err := types.UnmarshalPacketData(tc.bz, tc.version)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expData, data, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMarshalPacketData(t *testing.T) {
+	packetDataV1 := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, "memo")
+	packetDataV2 := types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount), types.NewToken("uatom", amount)}, sender, receiver, "memo")
+	forwardedPacketData := types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "")
+	forwardedPacketData.Forwarding = types.NewForwardingPacketData("memo", types.NewHop("transfer", "channel-1"))
+
+	testCases := []struct {
+		name    string
+		data    types.FungibleTokenPacketDataV2
+		version string
+		expBz   []byte
+		expErr  error
+	}{
+		{"success: ics20-1 packet data", packetDataV1.ToV2(), types.V1, packetDataV1.GetBytes(), nil},
+		{"success: ics20-2 packet data", packetDataV2, types.V2, packetDataV2.GetBytes(), nil},
+		{"success: forwarded ics20-2 packet data", forwardedPacketData, types.V2, forwardedPacketData.GetBytes(), nil},
+		{"failure: multiple tokens on ics20-1 channel", packetDataV2, types.V1, nil, types.ErrInvalidVersion},
+		{"failure: forwarded tokens on ics20-1 channel", forwardedPacketData, types.V1, nil, types.ErrInvalidVersion},
+		{"failure: unsupported version", packetDataV2, "ics20-3", nil, types.ErrInvalidVersion},
+	}
+
+	for _, tc := range testCases {
+		bz, err := types.MarshalPacketData(tc.data, tc.version)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expBz, bz, tc.name)
+
+			data, err := types.UnmarshalPacketData(bz, tc.version)
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.data, data, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
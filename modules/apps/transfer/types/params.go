@@ -5,17 +5,23 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultMaxMemoLength is the default maximum length in bytes of the memo of outgoing transfers
+	DefaultMaxMemoLength = uint64(32768)
+	// DefaultMaxReceiverLength is the default maximum length in bytes of the receiver of outgoing transfers
+	DefaultMaxReceiverLength = uint64(2048)
 )
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, maxMemoLength, maxReceiverLength uint64) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:       enableSend,
+		ReceiveEnabled:    enableReceive,
+		MaxMemoLength:     maxMemoLength,
+		MaxReceiverLength: maxReceiverLength,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultMaxMemoLength, DefaultMaxReceiverLength)
 }
@@ -79,6 +79,60 @@ func (m *DenomTrace) GetBaseDenom() string {
 	return ""
 }
 
+// Hop defines a port ID, channel ID pair specifying the channel over which tokens are
+// forwarded on an intermediate chain.
+type Hop struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{1}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(m, src)
+}
+func (m *Hop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *Hop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 // Params defines the set of IBC transfer parameters.
 // NOTE: To prevent a single token from being transferred, set the
 // TransfersEnabled parameter to true and then set the bank module's SendEnabled
@@ -90,13 +144,19 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// max_memo_length is the maximum length in bytes of the memo of token transfers sent
+	// from this chain. The limit is disabled if set to zero.
+	MaxMemoLength uint64 `protobuf:"varint,3,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
+	// max_receiver_length is the maximum length in bytes of the receiver of token transfers
+	// sent from this chain. The limit is disabled if set to zero.
+	MaxReceiverLength uint64 `protobuf:"varint,4,opt,name=max_receiver_length,json=maxReceiverLength,proto3" json:"max_receiver_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5041673e96e97901, []int{1}
+	return fileDescriptor_5041673e96e97901, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,8 +199,23 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReceiverLength() uint64 {
+	if m != nil {
+		return m.MaxReceiverLength
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
+	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
 }
 
@@ -149,23 +224,29 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0x87, 0x9b, 0x22, 0xc5, 0x46, 0x51, 0xc8, 0xd4, 0x41, 0x83, 0x76, 0x51, 0x10, 0x2f, 0x14,
-	0x87, 0x8e, 0x82, 0xe8, 0xae, 0xa5, 0x93, 0x4b, 0x49, 0x72, 0xaf, 0x6d, 0xe0, 0xf2, 0x87, 0xbc,
-	0xe9, 0x81, 0xdf, 0xc2, 0x8f, 0xe5, 0xd8, 0xd1, 0x51, 0xee, 0xbe, 0x88, 0x5c, 0x2c, 0x47, 0xb7,
-	0x1f, 0x4f, 0x9e, 0xbc, 0xc3, 0x43, 0xef, 0x8c, 0xd2, 0x42, 0x86, 0x50, 0x19, 0x2d, 0x93, 0xf1,
-	0x0e, 0x45, 0x8a, 0xd2, 0xe1, 0x07, 0x44, 0x51, 0xcf, 0xfa, 0x5d, 0x84, 0xe8, 0x93, 0x67, 0x17,
-	0x46, 0xe9, 0xe2, 0x50, 0x2e, 0x7a, 0xa1, 0x9e, 0x4d, 0x1f, 0x29, 0x7d, 0x06, 0xe7, 0xed, 0x32,
-	0x4a, 0x0d, 0x8c, 0xd1, 0xa3, 0x20, 0xd3, 0x66, 0x42, 0xae, 0xc8, 0xed, 0x78, 0x91, 0x37, 0xbb,
-	0xa4, 0x54, 0x49, 0x84, 0x55, 0xd9, 0x69, 0x93, 0x61, 0x7e, 0x19, 0x77, 0x24, 0xff, 0x9b, 0x2e,
-	0xe9, 0xe8, 0x55, 0x46, 0x69, 0x91, 0x5d, 0xd3, 0x53, 0x04, 0x57, 0xae, 0xc0, 0x49, 0x55, 0x41,
-	0x99, 0x8f, 0x1c, 0x2f, 0x4e, 0x3a, 0xf6, 0xf2, 0x8f, 0xd8, 0x0d, 0x3d, 0x8f, 0xa0, 0xc1, 0xd4,
-	0xd0, 0x5b, 0xc3, 0x6c, 0x9d, 0xed, 0xf1, 0x5e, 0x7c, 0x7a, 0xfb, 0x6e, 0x38, 0xd9, 0x35, 0x9c,
-	0xfc, 0x36, 0x9c, 0x7c, 0xb5, 0x7c, 0xb0, 0x6b, 0xf9, 0xe0, 0xa7, 0xe5, 0x83, 0xf7, 0xf9, 0xda,
-	0xa4, 0xcd, 0x56, 0x15, 0xda, 0x5b, 0xa1, 0x3d, 0x5a, 0x8f, 0xc2, 0x28, 0x7d, 0xbf, 0xf6, 0xa2,
-	0x9e, 0x0b, 0xeb, 0xcb, 0x6d, 0x05, 0xd8, 0xb5, 0x39, 0x68, 0x92, 0x3e, 0x03, 0xa0, 0x1a, 0xe5,
-	0x1c, 0x0f, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x85, 0xfd, 0x75, 0xb7, 0x3d, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x4a, 0x33, 0x31,
+	0x18, 0x85, 0x3b, 0x6d, 0xe9, 0xf7, 0x35, 0xfe, 0x14, 0xe3, 0xc2, 0x2e, 0x74, 0xa8, 0x5d, 0x68,
+	0x41, 0x9c, 0xa1, 0xb8, 0xe8, 0x4a, 0x04, 0x51, 0xb0, 0xa0, 0xa0, 0x83, 0x2b, 0x37, 0x43, 0x26,
+	0xf3, 0xda, 0x09, 0x4c, 0x92, 0x21, 0x49, 0x87, 0x7a, 0x17, 0x5e, 0x88, 0x17, 0xe2, 0xb2, 0x4b,
+	0x97, 0xd2, 0xde, 0x88, 0x24, 0x9d, 0x96, 0xee, 0xde, 0x3c, 0xe7, 0x39, 0xd9, 0x1c, 0x74, 0xc1,
+	0x12, 0x1a, 0x92, 0xa2, 0xc8, 0x19, 0x25, 0x86, 0x49, 0xa1, 0x43, 0xa3, 0x88, 0xd0, 0xef, 0xa0,
+	0xc2, 0x72, 0xb8, 0xb9, 0x83, 0x42, 0x49, 0x23, 0xf1, 0x31, 0x4b, 0x68, 0xb0, 0x2d, 0x07, 0x1b,
+	0xa1, 0x1c, 0xf6, 0x6f, 0x10, 0xba, 0x03, 0x21, 0xf9, 0xab, 0x22, 0x14, 0x30, 0x46, 0xcd, 0x82,
+	0x98, 0xac, 0xeb, 0xf5, 0xbc, 0x41, 0x3b, 0x72, 0x37, 0x3e, 0x41, 0x28, 0x21, 0x1a, 0xe2, 0xd4,
+	0x6a, 0xdd, 0xba, 0x4b, 0xda, 0x96, 0xb8, 0x5e, 0xff, 0x1a, 0x35, 0x1e, 0x64, 0x81, 0x8f, 0xd0,
+	0xbf, 0x42, 0x2a, 0x13, 0xb3, 0xb4, 0x2a, 0xb7, 0xec, 0x73, 0x9c, 0xda, 0x3a, 0xcd, 0x88, 0x10,
+	0x90, 0xdb, 0xac, 0xaa, 0x57, 0x64, 0x9c, 0xf6, 0xbf, 0x3c, 0xd4, 0x7a, 0x26, 0x8a, 0x70, 0x8d,
+	0x4f, 0xd1, 0xae, 0x06, 0x91, 0xc6, 0x20, 0x48, 0x92, 0xc3, 0xea, 0x9f, 0xff, 0xd1, 0x8e, 0x65,
+	0xf7, 0x2b, 0x84, 0xcf, 0x51, 0x47, 0x01, 0x05, 0x56, 0xc2, 0xc6, 0xaa, 0x3b, 0x6b, 0xbf, 0xc2,
+	0x6b, 0xf1, 0x0c, 0x75, 0x38, 0x99, 0xc5, 0x1c, 0xb8, 0x8c, 0x73, 0x10, 0x13, 0x93, 0x75, 0x1b,
+	0x3d, 0x6f, 0xd0, 0x8c, 0xf6, 0x38, 0x99, 0x3d, 0x01, 0x97, 0x8f, 0x0e, 0xe2, 0x00, 0x1d, 0x5a,
+	0xaf, 0x6a, 0xab, 0xb5, 0xdb, 0x74, 0xee, 0x01, 0x27, 0xb3, 0xa8, 0x4a, 0x56, 0xfe, 0xed, 0xcb,
+	0xf7, 0xc2, 0xf7, 0xe6, 0x0b, 0xdf, 0xfb, 0x5d, 0xf8, 0xde, 0xe7, 0xd2, 0xaf, 0xcd, 0x97, 0x7e,
+	0xed, 0x67, 0xe9, 0xd7, 0xde, 0x46, 0x13, 0x66, 0xb2, 0x69, 0x12, 0x50, 0xc9, 0x43, 0x2a, 0x35,
+	0x97, 0x3a, 0x64, 0x09, 0xbd, 0x9c, 0xc8, 0xb0, 0x1c, 0x85, 0x5c, 0xa6, 0xd3, 0x1c, 0xb4, 0xdd,
+	0x6c, 0x6b, 0x2b, 0xf3, 0x51, 0x80, 0x4e, 0x5a, 0x6e, 0xa6, 0xab, 0xbf, 0x01, 0x00, 0xe2, 0x98,
+	0x3b, 0xd0, 0xd5, 0x01, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -205,6 +286,43 @@ func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Hop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -225,6 +343,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxReceiverLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxReceiverLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -276,6 +404,23 @@ func (m *DenomTrace) Size() (n int) {
 	return n
 }
 
+func (m *Hop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -288,6 +433,12 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxMemoLength))
+	}
+	if m.MaxReceiverLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxReceiverLength))
+	}
 	return n
 }
 
@@ -411,6 +562,120 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Hop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -480,6 +745,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReceiverLength", wireType)
+			}
+			m.MaxReceiverLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReceiverLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -47,14 +47,25 @@ func (a TransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.Accep
 			return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
 		}
 
-		// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
-		if allocation.SpendLimit.AmountOf(msgTransfer.Token.Denom).Equal(UnboundedSpendLimit()) {
-			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
+		limitLeft := allocation.SpendLimit
+		spendLimitUpdated := false
+		for _, coin := range msgTransfer.GetCoins() {
+			// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
+			if allocation.SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+				continue
+			}
+
+			var isNegative bool
+			limitLeft, isNegative = limitLeft.SafeSub(coin)
+			if isNegative {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+			}
+
+			spendLimitUpdated = true
 		}
 
-		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.Token)
-		if isNegative {
-			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		if !spendLimitUpdated {
+			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
 		}
 
 		if limitLeft.IsZero() {
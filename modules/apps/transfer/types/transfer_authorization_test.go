@@ -128,6 +128,68 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().True(sdkmath.NewInt(100).Equal(remainder))
 			},
 		},
+		{
+			"success: with multiple tokens",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = transferAuthz.Allocations[0].SpendLimit.Add(sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedTransferAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				remainder := updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf(sdk.DefaultBondDenom)
+				suite.Require().True(sdkmath.NewInt(50).Equal(remainder))
+
+				remainder = updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf("test-denom")
+				suite.Require().True(remainder.IsZero())
+			},
+		},
+		{
+			"success: with multiple tokens and unlimited spend limit for one denom",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = transferAuthz.Allocations[0].SpendLimit.Add(sdk.NewCoin("test-denom", types.UnboundedSpendLimit()))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				updatedTransferAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				remainder := updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf(sdk.DefaultBondDenom)
+				suite.Require().True(sdkmath.NewInt(50).Equal(remainder))
+
+				remainder = updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf("test-denom")
+				suite.Require().True(types.UnboundedSpendLimit().Equal(remainder))
+			},
+		},
+		{
+			"requested amount of one of multiple tokens is more than the spend limit",
+			func() {
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
 		{
 			"no spend limit set for MsgTransfer port/channel",
 			func() {
@@ -53,6 +53,13 @@ type MsgTransfer struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the tokens to be transferred in a single packet. Only one of token and tokens may be set
+	// and multiple tokens may only be transferred over ics20-2 channels.
+	Tokens []types.Coin `protobuf:"bytes,9,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// optional channels over which the tokens are forwarded by the intermediate chains before they reach
+	// the receiver. The memo is delivered to the receiver on the final chain. Tokens may only be forwarded
+	// over ics20-2 channels.
+	ForwardingHops []Hop `protobuf:"bytes,10,rep,name=forwarding_hops,json=forwardingHops,proto3" json:"forwarding_hops,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -241,44 +248,49 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0xc2, 0xb2, 0xc2, 0xac, 0xa0, 0x0e, 0x46, 0x4a, 0x43, 0xba, 0x64, 0x23, 0x09, 0x42,
-	0x98, 0x49, 0x31, 0x84, 0x84, 0x23, 0x1c, 0xf4, 0x42, 0x82, 0x1b, 0xbc, 0x78, 0x21, 0xed, 0xf0,
-	0x6c, 0x27, 0x6e, 0x3b, 0x75, 0x66, 0xda, 0xc8, 0xcd, 0xe8, 0xc5, 0xa3, 0x3f, 0x81, 0x9f, 0xc0,
-	0xcf, 0xe0, 0xc8, 0xd1, 0x93, 0x1a, 0x38, 0xe0, 0xc1, 0x1f, 0x61, 0x3a, 0x9d, 0x2e, 0xab, 0x07,
-	0xd4, 0x53, 0x67, 0xbe, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0x4d, 0x1f, 0x5a, 0xe1, 0x11, 0xa3, 0x61,
-	0x9e, 0x0f, 0x39, 0x0b, 0x35, 0x17, 0x99, 0xa2, 0x5a, 0x86, 0x99, 0x7a, 0x0d, 0x92, 0x96, 0x01,
-	0xd5, 0xef, 0x48, 0x2e, 0x85, 0x16, 0x78, 0x89, 0x47, 0x8c, 0x8c, 0xd3, 0x48, 0x43, 0x23, 0x65,
-	0xe0, 0x3d, 0x8c, 0x45, 0x2c, 0x0c, 0x91, 0x56, 0xa7, 0x3a, 0xc7, 0x5b, 0x60, 0x42, 0xa5, 0x42,
-	0xd1, 0x54, 0xc5, 0x55, 0xad, 0x54, 0xc5, 0x36, 0xe0, 0xdb, 0x40, 0x14, 0x2a, 0xa0, 0x65, 0x10,
-	0x81, 0x0e, 0x03, 0xca, 0x04, 0xcf, 0x6c, 0xbc, 0x57, 0x69, 0x62, 0x42, 0x02, 0x65, 0x43, 0x0e,
-	0x99, 0xae, 0xb2, 0xeb, 0x93, 0x25, 0xac, 0xdf, 0x2e, 0xba, 0x51, 0x66, 0xc8, 0xfd, 0x6f, 0x13,
-	0xa8, 0xbb, 0xaf, 0xe2, 0x43, 0x8b, 0xe2, 0x1e, 0xea, 0x2a, 0x51, 0x48, 0x06, 0x47, 0xb9, 0x90,
-	0xda, 0x75, 0x96, 0x9d, 0xd5, 0x99, 0x01, 0xaa, 0xa1, 0x03, 0x21, 0x35, 0x5e, 0x41, 0x73, 0x96,
-	0xc0, 0x92, 0x30, 0xcb, 0x60, 0xe8, 0x4e, 0x18, 0xce, 0x6c, 0x8d, 0xee, 0xd5, 0x20, 0xde, 0x42,
-	0x53, 0x5a, 0xbc, 0x81, 0xcc, 0x9d, 0x5c, 0x76, 0x56, 0xbb, 0x9b, 0x8b, 0xa4, 0x9e, 0x8a, 0x54,
-	0x53, 0x11, 0x3b, 0x15, 0xd9, 0x13, 0x3c, 0xdb, 0x6d, 0x9f, 0x7f, 0xed, 0xb5, 0x06, 0x35, 0x1b,
-	0x3f, 0x42, 0x1d, 0x05, 0xd9, 0x31, 0x48, 0xb7, 0x6d, 0xaa, 0xda, 0x1b, 0xf6, 0xd0, 0xb4, 0x04,
-	0x06, 0xbc, 0x04, 0xe9, 0x4e, 0x99, 0xc8, 0xe8, 0x8e, 0x9f, 0xa1, 0x39, 0xcd, 0x53, 0x10, 0x85,
-	0x3e, 0x4a, 0x80, 0xc7, 0x89, 0x76, 0x3b, 0xa6, 0xa7, 0x47, 0xaa, 0x67, 0xa9, 0x9c, 0x22, 0xd6,
-	0x9f, 0x32, 0x20, 0xcf, 0x0d, 0xc3, 0x36, 0x9d, 0xb5, 0x79, 0x35, 0x88, 0xd7, 0xd1, 0x83, 0xa6,
-	0x50, 0xf5, 0x55, 0x3a, 0x4c, 0x73, 0xf7, 0xce, 0xb2, 0xb3, 0xda, 0x1e, 0xdc, 0xb7, 0x81, 0xc3,
-	0x06, 0xc7, 0x18, 0xb5, 0x53, 0x48, 0x85, 0x3b, 0x6d, 0xd4, 0x98, 0xf3, 0xce, 0xfc, 0xa7, 0xd3,
-	0x5e, 0xeb, 0xc7, 0x69, 0xaf, 0xf5, 0xe1, 0xfa, 0x6c, 0xcd, 0x4a, 0xef, 0x07, 0x68, 0x7e, 0xcc,
-	0xe0, 0x01, 0xa8, 0x5c, 0x64, 0x0a, 0xaa, 0x89, 0x14, 0xbc, 0x2d, 0x20, 0x63, 0x60, 0x5c, 0x6e,
-	0x0f, 0x46, 0xf7, 0xfe, 0x47, 0x07, 0xdd, 0xdb, 0x57, 0xf1, 0xcb, 0xfc, 0x38, 0xd4, 0x70, 0x10,
-	0xca, 0x30, 0x55, 0x78, 0x09, 0xcd, 0x84, 0x85, 0x4e, 0x84, 0xe4, 0xfa, 0xc4, 0x3e, 0xcb, 0x0d,
-	0x80, 0x77, 0x51, 0x27, 0x37, 0x3c, 0xf3, 0x1a, 0xdd, 0xcd, 0xc7, 0xe4, 0xb6, 0x5f, 0x92, 0xd4,
-	0x35, 0xad, 0x0b, 0x36, 0x73, 0x67, 0xae, 0x52, 0x7d, 0x53, 0xb3, 0xbf, 0x88, 0x16, 0xfe, 0x10,
-	0xd1, 0x88, 0xdf, 0xfc, 0xe9, 0xa0, 0xc9, 0x7d, 0x15, 0xe3, 0x04, 0x4d, 0x8f, 0xfe, 0x9c, 0x27,
-	0xb7, 0xb7, 0x1c, 0xf3, 0xc0, 0x0b, 0xfe, 0x99, 0x3a, 0xb2, 0x4b, 0xa3, 0xbb, 0xbf, 0xd9, 0xb1,
-	0xf1, 0xd7, 0x12, 0xe3, 0x74, 0x6f, 0xeb, 0xbf, 0xe8, 0x4d, 0x57, 0x6f, 0xea, 0xfd, 0xf5, 0xd9,
-	0x9a, 0xb3, 0xfb, 0xe2, 0xfc, 0xd2, 0x77, 0x2e, 0x2e, 0x7d, 0xe7, 0xfb, 0xa5, 0xef, 0x7c, 0xbe,
-	0xf2, 0x5b, 0x17, 0x57, 0x7e, 0xeb, 0xcb, 0x95, 0xdf, 0x7a, 0xb5, 0x1d, 0x73, 0x9d, 0x14, 0x11,
-	0x61, 0x22, 0xa5, 0x76, 0x6f, 0x79, 0xc4, 0x36, 0x62, 0x41, 0xcb, 0x6d, 0x9a, 0x8a, 0xe3, 0x62,
-	0x08, 0xaa, 0xda, 0xc5, 0xb1, 0x1d, 0xd4, 0x27, 0x39, 0xa8, 0xa8, 0x63, 0xd6, 0xef, 0xe9, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x13, 0x85, 0x07, 0x62, 0x04, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xfe, 0x29, 0xfd, 0xc3, 0x54, 0x0a, 0x0e, 0x46, 0x96, 0x0d, 0xd9, 0x96, 0x46, 0x12,
+	0x04, 0x99, 0xcd, 0x62, 0x08, 0x09, 0xc7, 0x72, 0x90, 0xc4, 0x90, 0x60, 0x83, 0x17, 0x2f, 0x64,
+	0x77, 0x3b, 0xec, 0x4e, 0xec, 0xee, 0x1b, 0x67, 0xa6, 0x55, 0x6e, 0x46, 0x2f, 0x1e, 0xbd, 0x7b,
+	0xe1, 0x23, 0xf0, 0x31, 0x38, 0x72, 0xf4, 0x44, 0x0c, 0x1c, 0x30, 0xc6, 0x0f, 0x61, 0x76, 0x76,
+	0x5a, 0x2a, 0x87, 0xaa, 0xa7, 0x99, 0x79, 0xef, 0xf7, 0x7e, 0xef, 0xf7, 0xde, 0xbc, 0x3c, 0xb4,
+	0xc2, 0xc2, 0xc8, 0x0b, 0x38, 0xef, 0xb2, 0x28, 0x50, 0x0c, 0x32, 0xe9, 0x29, 0x11, 0x64, 0xf2,
+	0x98, 0x0a, 0xaf, 0xef, 0x7b, 0xea, 0x1d, 0xe1, 0x02, 0x14, 0xe0, 0x25, 0x16, 0x46, 0x64, 0x14,
+	0x46, 0x06, 0x30, 0xd2, 0xf7, 0x9d, 0x07, 0x31, 0xc4, 0xa0, 0x81, 0x5e, 0x7e, 0x2b, 0x62, 0x9c,
+	0x85, 0x08, 0x64, 0x0a, 0xd2, 0x4b, 0x65, 0x9c, 0x73, 0xa5, 0x32, 0x36, 0x0e, 0xd7, 0x38, 0xc2,
+	0x40, 0x52, 0xaf, 0xef, 0x87, 0x54, 0x05, 0xbe, 0x17, 0x01, 0xcb, 0x8c, 0xbf, 0x9e, 0x6b, 0x8a,
+	0x40, 0x50, 0x2f, 0xea, 0x32, 0x9a, 0xa9, 0x3c, 0xba, 0xb8, 0x19, 0xc0, 0xfa, 0x78, 0xd1, 0x03,
+	0x65, 0x1a, 0xdc, 0xfc, 0x52, 0x46, 0xd5, 0x7d, 0x19, 0x1f, 0x1a, 0x2b, 0xae, 0xa3, 0xaa, 0x84,
+	0x9e, 0x88, 0xe8, 0x11, 0x07, 0xa1, 0x6c, 0xab, 0x61, 0xad, 0x4e, 0xb7, 0x51, 0x61, 0x3a, 0x00,
+	0xa1, 0xf0, 0x0a, 0xaa, 0x19, 0x40, 0x94, 0x04, 0x59, 0x46, 0xbb, 0xf6, 0x7f, 0x1a, 0x33, 0x53,
+	0x58, 0x77, 0x0b, 0x23, 0xde, 0x42, 0x93, 0x0a, 0x5e, 0xd3, 0xcc, 0x9e, 0x68, 0x58, 0xab, 0xd5,
+	0xcd, 0x45, 0x52, 0x54, 0x45, 0xf2, 0xaa, 0x88, 0xa9, 0x8a, 0xec, 0x02, 0xcb, 0x5a, 0xe5, 0xf3,
+	0xcb, 0x7a, 0xa9, 0x5d, 0xa0, 0xf1, 0x43, 0x54, 0x91, 0x34, 0xeb, 0x50, 0x61, 0x97, 0x35, 0xab,
+	0x79, 0x61, 0x07, 0x4d, 0x09, 0x1a, 0x51, 0xd6, 0xa7, 0xc2, 0x9e, 0xd4, 0x9e, 0xe1, 0x1b, 0x3f,
+	0x43, 0x35, 0xc5, 0x52, 0x0a, 0x3d, 0x75, 0x94, 0x50, 0x16, 0x27, 0xca, 0xae, 0xe8, 0x9c, 0x0e,
+	0xc9, 0xbf, 0x25, 0xef, 0x14, 0x31, 0xfd, 0xe9, 0xfb, 0x64, 0x4f, 0x23, 0x4c, 0xd2, 0x19, 0x13,
+	0x57, 0x18, 0xf1, 0x3a, 0xba, 0x3f, 0x20, 0xca, 0x4f, 0xa9, 0x82, 0x94, 0xdb, 0xff, 0x37, 0xac,
+	0xd5, 0x72, 0x7b, 0xce, 0x38, 0x0e, 0x07, 0x76, 0x8c, 0x51, 0x39, 0xa5, 0x29, 0xd8, 0x53, 0x5a,
+	0x8d, 0xbe, 0xe3, 0xe7, 0xa8, 0xa2, 0xcb, 0x90, 0xf6, 0x74, 0x63, 0x62, 0x7c, 0xd5, 0x76, 0x2e,
+	0xe0, 0xc7, 0x65, 0x7d, 0xae, 0x08, 0x78, 0x02, 0x29, 0x53, 0x34, 0xe5, 0xea, 0xa4, 0x6d, 0x28,
+	0x70, 0x8a, 0x66, 0x8f, 0x41, 0xbc, 0x0d, 0x44, 0x87, 0x65, 0xf1, 0x51, 0x02, 0x5c, 0xda, 0x48,
+	0xb3, 0x2e, 0x93, 0x71, 0xe3, 0x46, 0xf6, 0x80, 0xb7, 0x96, 0x0d, 0xfb, 0xe2, 0x1d, 0x86, 0x91,
+	0x34, 0xb5, 0x5b, 0xd7, 0x1e, 0x70, 0xb9, 0x33, 0xff, 0xe9, 0xb4, 0x5e, 0xfa, 0x7e, 0x5a, 0x2f,
+	0x7d, 0xb8, 0x39, 0x5b, 0x33, 0x6d, 0x6f, 0xfa, 0x68, 0x7e, 0x64, 0x38, 0xda, 0x54, 0x72, 0xc8,
+	0x24, 0xcd, 0x7f, 0x43, 0xd2, 0x37, 0x3d, 0x9a, 0x45, 0x54, 0x4f, 0x48, 0xb9, 0x3d, 0x7c, 0x37,
+	0x3f, 0x5a, 0x68, 0x76, 0x5f, 0xc6, 0x2f, 0x79, 0x27, 0x50, 0xf4, 0x20, 0x10, 0x41, 0x2a, 0xf1,
+	0x12, 0x9a, 0x0e, 0x7a, 0x2a, 0x01, 0xc1, 0xd4, 0x89, 0x19, 0xa9, 0x5b, 0x03, 0x6e, 0xa1, 0x0a,
+	0xd7, 0x38, 0x3d, 0x49, 0xd5, 0xcd, 0x47, 0xe3, 0xeb, 0x2b, 0x38, 0xcd, 0x0f, 0x9a, 0xc8, 0x9d,
+	0x5a, 0xae, 0xfa, 0x96, 0xb3, 0xb9, 0x88, 0x16, 0xee, 0x88, 0x18, 0x88, 0xdf, 0xfc, 0x69, 0xa1,
+	0x89, 0x7d, 0x19, 0xe3, 0x04, 0x4d, 0x0d, 0xa7, 0xfe, 0xf1, 0xf8, 0x94, 0x23, 0x3d, 0x70, 0xfc,
+	0xbf, 0x86, 0x0e, 0xdb, 0xa5, 0xd0, 0xbd, 0xdf, 0xda, 0xb1, 0xf1, 0x47, 0x8a, 0x51, 0xb8, 0xb3,
+	0xf5, 0x4f, 0xf0, 0x41, 0x56, 0x67, 0xf2, 0xfd, 0xcd, 0xd9, 0x9a, 0xd5, 0x7a, 0x71, 0x7e, 0xe5,
+	0x5a, 0x17, 0x57, 0xae, 0xf5, 0xed, 0xca, 0xb5, 0x3e, 0x5f, 0xbb, 0xa5, 0x8b, 0x6b, 0xb7, 0xf4,
+	0xf5, 0xda, 0x2d, 0xbd, 0xda, 0x8e, 0x99, 0x4a, 0x7a, 0x21, 0x89, 0x20, 0xf5, 0xcc, 0xce, 0x61,
+	0x61, 0xb4, 0x11, 0x83, 0xd7, 0xdf, 0xf6, 0x52, 0xe8, 0xf4, 0xba, 0x54, 0xe6, 0x7b, 0x64, 0x64,
+	0x7f, 0xa8, 0x13, 0x4e, 0x65, 0x58, 0xd1, 0xab, 0xe3, 0xe9, 0xaf, 0x01, 0x00, 0x66, 0x33, 0x5f,
+	0xab, 0x1e, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -421,6 +433,34 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardingHops) > 0 {
+		for iNdEx := len(m.ForwardingHops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardingHops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -619,6 +659,18 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ForwardingHops) > 0 {
+		for _, e := range m.ForwardingHops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -938,6 +990,74 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardingHops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardingHops = append(m.ForwardingHops, Hop{})
+			if err := m.ForwardingHops[len(m.ForwardingHops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8,7 +8,8 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades, to update the localhost client, to store the
+// consensus state of the host chain and to prune expired consensus states within the consensus state prune limit.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
@@ -39,4 +40,26 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.UpdateLocalhostClient(ctx, clientState)
 		}
 	}
+
+	// store the consensus state of the host chain, so that it can be served to counterparties without relying
+	// on the historical info of the staking module.
+	k.TrackSelfConsensusState(ctx)
+
+	// prune expired consensus states of idle clients, which are otherwise only pruned on client updates.
+	if limit := k.GetParams(ctx).ConsensusStatePruneLimit; limit > 0 {
+		k.PruneExpiredConsensusStatesWithLimit(ctx, limit)
+	}
+}
+
+// EndBlocker checks the status of clients, within the client status check limit, in order to call the client
+// status hooks for the clients which expired or were frozen. Clients expire without any transaction being
+// executed, so expiry is only detected by this check. A client status check limit of 0 disables the check.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	limit := k.GetParams(ctx).ClientStatusCheckLimit
+	if limit == 0 {
+		k.Logger(ctx).Debug("client status check is disabled", "client-status-check-limit", limit)
+		return
+	}
+
+	k.CheckClientStatuses(ctx, limit)
 }
@@ -3,6 +3,7 @@ package client_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -114,6 +115,41 @@ func (suite *ClientTestSuite) TestBeginBlockerUpgradeEventsAbsence() {
 	suite.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeUpgradeChain, false)
 }
 
+func (suite *ClientTestSuite) TestBeginBlockerPruneConsensusStates() {
+	testCases := []struct {
+		name      string
+		limit     uint64
+		expPruned bool
+	}{
+		{"pruning disabled", 0, false},
+		{"expired consensus states pruned", 10, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			params := clientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStatePruneLimit = tc.limit
+			clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			consensusHeight := path.EndpointA.GetClientState().GetLatestHeight()
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+			client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+			_, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, consensusHeight)
+			suite.Require().Equal(!tc.expPruned, found)
+		})
+	}
+}
+
 // requireContainsEvent verifies if an event of a specific type was emitted.
 func (suite *ClientTestSuite) requireContainsEvent(events sdk.Events, eventType string, shouldContain bool) {
 	found := false
@@ -22,11 +22,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientStatuses(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
+		GetCmdQuerySelfConsensusStates(),
 		GetCmdClientParams(),
 	)
 
@@ -48,6 +50,9 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateClientCmd(),
 		NewSubmitMisbehaviourCmd(), // Deprecated
 		NewUpgradeClientCmd(),
+		NewPruneConsensusStatesCmd(),
+		NewCmdSubmitRecoverClientProposal(),
+		NewCmdScheduleIBCUpgradeProposal(),
 	)
 
 	return txCmd
@@ -125,6 +125,44 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientStatuses defines the command to query the status of all clients.
+func GetCmdQueryClientStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "statuses",
+		Short:   "Query the status of all light clients",
+		Long:    "Query the status of all light clients along with the time left until they expire and the timestamp of their latest consensus state",
+		Example: fmt.Sprintf("%s query %s %s statuses", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientStatusesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientStatuses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "client statuses")
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -312,6 +350,45 @@ func GetCmdSelfConsensusState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySelfConsensusStates defines the command to query the consensus states of this chain stored by the
+// client submodule.
+func GetCmdQuerySelfConsensusStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "self-consensus-states",
+		Short:   "Query the stored self consensus states of this chain",
+		Long:    "Query the consensus states of this chain stored by the client submodule within the self consensus state retention",
+		Example: fmt.Sprintf("%s query %s %s self-consensus-states", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySelfConsensusStatesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SelfConsensusStates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "self consensus states")
+
+	return cmd
+}
+
 // GetCmdClientParams returns the command handler for ibc client parameter querying.
 func GetCmdClientParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -13,8 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -241,6 +244,30 @@ func NewUpgradeClientCmd() *cobra.Command {
 	return cmd
 }
 
+// NewPruneConsensusStatesCmd defines the command to prune the expired consensus states of an IBC light client.
+func NewPruneConsensusStatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prune-consensus-states [client-identifier]",
+		Short:   "prune expired consensus states of an IBC client",
+		Long:    "prune all expired consensus states and their metadata of the IBC client associated with the provided client identifier",
+		Example: fmt.Sprintf("%s tx ibc %s prune-consensus-states [client-identifier] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneConsensusStates(args[0], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitUpdateClientProposal implements a command handler for submitting an update IBC client proposal transaction.
 func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -282,7 +309,7 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 				return err
 			}
 
-			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
 				return err
 			}
@@ -381,7 +408,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 				return err
 			}
 
-			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
 				return err
 			}
@@ -400,3 +427,146 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 
 	return cmd
 }
+
+// NewCmdSubmitRecoverClientProposal implements a command handler for submitting a recover client proposal transaction.
+func NewCmdSubmitRecoverClientProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-client [subject-client-id] [substitute-client-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a recover IBC client proposal",
+		Long: "Submit a recover IBC client governance proposal along with an initial deposit.\n" +
+			"Please specify a subject client identifier you want to recover.\n" +
+			"Please specify the substitute client the subject client will be recovered to.",
+		Example: fmt.Sprintf("%s tx %s %s recover-client 07-tendermint-0 07-tendermint-1 --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName, exported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgRecoverClient(authority, args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := newSubmitProposal(cmd, clientCtx, msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	addProposalFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdScheduleIBCUpgradeProposal implements a command handler for submitting a proposal to schedule an IBC software upgrade.
+func NewCmdScheduleIBCUpgradeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-ibc-upgrade [name] [height] [path/to/upgraded_client_state.json]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to schedule an IBC software upgrade",
+		Long: "Submit a governance proposal to schedule an IBC client breaking upgrade along with an initial deposit.\n" +
+			"The client state specified is the upgraded client state representing the upgraded chain.",
+		Example: fmt.Sprintf("%s tx %s %s schedule-ibc-upgrade <name> <height> <path/to/upgraded_client_state.json> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName, exported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			plan := upgradetypes.Plan{
+				Name:   args[0],
+				Height: height,
+			}
+
+			// attempt to unmarshal client state argument
+			var clientState exported.ClientState
+			clientContentOrFileName := args[2]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := os.ReadFile(clientContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for client state were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				}
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg, err := types.NewMsgIBCSoftwareUpgrade(authority, plan, clientState)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := newSubmitProposal(cmd, clientCtx, msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	addProposalFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newSubmitProposal creates a gov v1 MsgSubmitProposal for the provided message, using the proposal flags
+// of the command and the sender of the client context as the proposer.
+func newSubmitProposal(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) (*govv1.MsgSubmitProposal, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+}
+
+// addProposalFlags adds the gov v1 proposal flags to the command.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "summary of proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -7,14 +7,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 // CreateClient generates a new client identifier and isolated prefix store for the provided client state.
-// The client state is responsible for setting any client-specific data in the store via the Initialize method.
-// This includes the client state, initial consensus state and any associated metadata.
+// The light client module registered for the client type is responsible for setting any client-specific data
+// in the store via the Initialize method. This includes the client state, initial consensus state and any
+// associated metadata.
 func (k Keeper) CreateClient(
 	ctx sdk.Context, clientState exported.ClientState, consensusState exported.ConsensusState,
 ) (string, error) {
@@ -30,10 +32,14 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	clientModule, found := k.router.GetRoute(clientState.ClientType())
+	if !found {
+		return "", errorsmod.Wrap(types.ErrRouteNotFound, clientState.ClientType())
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
-	clientStore := k.ClientStore(ctx, clientID)
 
-	if err := clientState.Initialize(ctx, k.cdc, clientStore, consensusState); err != nil {
+	if err := clientModule.Initialize(ctx, clientID, clientState, consensusState); err != nil {
 		return "", err
 	}
 
@@ -61,19 +67,22 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 		return errorsmod.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
 	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyClientMessage(ctx, k.cdc, clientStore, clientMsg); err != nil {
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	if err := clientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
 		return err
 	}
 
-	foundMisbehaviour := clientState.CheckForMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+	foundMisbehaviour := clientModule.CheckForMisbehaviour(ctx, clientID, clientMsg)
 	if foundMisbehaviour {
-		clientState.UpdateStateOnMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+		clientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
 
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
@@ -89,10 +98,12 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 
 		emitSubmitMisbehaviourEvent(ctx, clientID, clientState)
 
+		k.checkClientStatus(ctx, clientID)
+
 		return nil
 	}
 
-	consensusHeights := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+	consensusHeights := clientModule.UpdateState(ctx, clientID, clientMsg)
 
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
@@ -109,6 +120,8 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 	// emitting events in the keeper emits for both begin block and handler client updates
 	emitUpdateClientEvent(ctx, clientID, clientState.ClientType(), consensusHeights, k.cdc, clientMsg)
 
+	k.checkClientStatus(ctx, clientID)
+
 	return nil
 }
 
@@ -122,13 +135,19 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		return errorsmod.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
 	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	k.ConsumeProofGas(ctx, proofUpgradeClient)
+	k.ConsumeProofGas(ctx, proofUpgradeConsState)
+
+	if err := clientModule.VerifyUpgradeAndUpdateState(ctx, clientID,
 		upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState,
 	); err != nil {
 		return errorsmod.Wrapf(err, "cannot upgrade client with ID %s", clientID)
@@ -147,5 +166,93 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 
 	emitUpgradeClientEvent(ctx, clientID, upgradedClient)
 
+	k.checkClientStatus(ctx, clientID)
+
+	return nil
+}
+
+// RecoverClient will retrieve the subject and substitute client.
+// A callback will occur to the light client module of the subject client
+// with the identifiers of both the subject and the substitute client.
+// The light client modules are responsible for validating the parameters of the
+// substitute (ensuring they match the subject's parameters) as well as copying
+// the necessary consensus states from the substitute to the subject client
+// store. The substitute must be Active and the subject must not be Active.
+func (k Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	subjectClientState, found := k.GetClientState(ctx, subjectClientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "subject client with ID %s", subjectClientID)
+	}
+
+	if status := k.GetClientStatus(ctx, subjectClientState, subjectClientID); status == exported.Active {
+		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
+	}
+
+	substituteClientState, found := k.GetClientState(ctx, substituteClientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", substituteClientID)
+	}
+
+	if subjectClientState.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
+		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
+	}
+
+	if status := k.GetClientStatus(ctx, substituteClientState, substituteClientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "substitute client is not %s, status is %s", exported.Active, status)
+	}
+
+	clientModule, found := k.Route(subjectClientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
+	}
+
+	if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
+		return errorsmod.Wrap(err, "failed to validate substitute client")
+	}
+
+	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "update"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, substituteClientState.ClientType()),
+			telemetry.NewLabel(types.LabelClientID, subjectClientID),
+			telemetry.NewLabel(types.LabelUpdateType, "recovery"),
+		},
+	)
+
+	emitRecoverClientEvent(ctx, subjectClientID, substituteClientState.ClientType())
+
+	// the recovered client is active again and its status hooks are called once it expires or is frozen again
+	k.checkClientStatus(ctx, subjectClientID)
+
+	return nil
+}
+
+// ScheduleIBCSoftwareUpgrade schedules an upgrade for the IBC client. It sets the
+// upgraded client state in the upgrade store, clearing any previously set client
+// state and consensus state if a previous plan was set.
+func (k Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.Plan, upgradedClientState exported.ClientState) error {
+	// zero out any custom fields before setting
+	cs := upgradedClientState.ZeroCustomFields()
+	bz, err := types.MarshalClientState(k.cdc, cs)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not marshal UpgradedClientState")
+	}
+
+	if err := k.upgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+		return err
+	}
+
+	// sets the new upgraded client in last height committed on this chain is at plan.Height,
+	// since the chain will panic at plan.Height and new chain will resume at plan.Height
+	if err = k.upgradeKeeper.SetUpgradedClient(ctx, plan.Height, bz); err != nil {
+		return err
+	}
+
+	// emitting an event for scheduling an upgrade plan
+	emitScheduleIBCSoftwareUpgradeEvent(ctx, plan.Name, plan.Height)
+
 	return nil
 }
@@ -81,11 +81,11 @@ func emitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState export
 	})
 }
 
-// emitUpdateClientProposalEvent emits an update client proposal event
-func emitUpdateClientProposalEvent(ctx sdk.Context, clientID, clientType string) {
+// emitRecoverClientEvent emits a recover client event
+func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeUpdateClientProposal,
+			types.EventTypeRecoverClient,
 			sdk.NewAttribute(types.AttributeKeySubjectClientID, clientID),
 			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
 		),
@@ -96,12 +96,12 @@ func emitUpdateClientProposalEvent(ctx sdk.Context, clientID, clientType string)
 	})
 }
 
-// emitUpgradeClientProposalEvent emits an upgrade client proposal event
-func emitUpgradeClientProposalEvent(ctx sdk.Context, title string, height int64) {
+// emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
+func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, name string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeUpgradeClientProposal,
-			sdk.NewAttribute(types.AttributeKeyUpgradePlanTitle, title),
+			types.EventTypeScheduleIBCSoftwareUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradePlanTitle, name),
 			sdk.NewAttribute(types.AttributeKeyUpgradePlanHeight, fmt.Sprintf("%d", height)),
 		),
 		sdk.NewEvent(
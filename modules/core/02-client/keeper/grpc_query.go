@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
@@ -254,6 +256,181 @@ func (k Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 	}, nil
 }
 
+// ClientStatuses implements the Query/ClientStatuses gRPC method
+func (k Keeper) ClientStatuses(c context.Context, req *types.QueryClientStatusesRequest) (*types.QueryClientStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var clientStatuses []types.IdentifiedClientStatus
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.KeyClientStorePrefix)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// filter any metadata stored under client state key
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != host.KeyClientState {
+			return false, nil
+		}
+
+		clientState, err := k.UnmarshalClientState(value)
+		if err != nil {
+			return false, err
+		}
+
+		clientID := keySplit[1]
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return false, err
+		}
+
+		if accumulate {
+			clientStatuses = append(clientStatuses, k.identifiedClientStatus(ctx, clientID, clientState))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClientStatusesResponse{
+		ClientStatuses: clientStatuses,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// SelfConsensusStates implements the Query/SelfConsensusStates gRPC method
+func (k Keeper) SelfConsensusStates(c context.Context, req *types.QuerySelfConsensusStatesRequest) (*types.QuerySelfConsensusStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var consensusStates []types.ConsensusStateWithHeight
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SelfConsensusStatePrefixKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		height, err := types.ParseSelfConsensusStateHeight(key)
+		if err != nil {
+			return err
+		}
+
+		consensusState, err := k.UnmarshalConsensusState(value)
+		if err != nil {
+			return err
+		}
+
+		consensusStates = append(consensusStates, types.NewConsensusStateWithHeight(height, consensusState))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySelfConsensusStatesResponse{
+		ConsensusStates: consensusStates,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// VerifyMembership implements the Query/VerifyMembership gRPC method
+func (k Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembershipRequest) (*types.QueryVerifyMembershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateVerificationRequest(req.ClientId, req.Proof, req.ProofHeight, req.MerklePath); err != nil {
+		return nil, err
+	}
+
+	if len(req.Value) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty value")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// cache the context to ensure the proof verification does not change state, gas for the proof is
+	// consumed on the shared gas meter as the query may be executed on chain
+	cachedCtx, _ := ctx.CacheContext()
+
+	if err := k.VerifyClientMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath, req.Value); err != nil {
+		if grpcErr := verificationQueryError(err, req.ClientId); grpcErr != nil {
+			return nil, grpcErr
+		}
+
+		k.Logger(ctx).Debug("proof verification failed", "client-id", req.ClientId, "key", req.MerklePath, "error", err)
+		return &types.QueryVerifyMembershipResponse{Success: false}, nil
+	}
+
+	return &types.QueryVerifyMembershipResponse{Success: true}, nil
+}
+
+// VerifyNonMembership implements the Query/VerifyNonMembership gRPC method
+func (k Keeper) VerifyNonMembership(c context.Context, req *types.QueryVerifyNonMembershipRequest) (*types.QueryVerifyNonMembershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateVerificationRequest(req.ClientId, req.Proof, req.ProofHeight, req.MerklePath); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// cache the context to ensure the proof verification does not change state, gas for the proof is
+	// consumed on the shared gas meter as the query may be executed on chain
+	cachedCtx, _ := ctx.CacheContext()
+
+	if err := k.VerifyClientNonMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath); err != nil {
+		if grpcErr := verificationQueryError(err, req.ClientId); grpcErr != nil {
+			return nil, grpcErr
+		}
+
+		k.Logger(ctx).Debug("proof verification failed", "client-id", req.ClientId, "key", req.MerklePath, "error", err)
+		return &types.QueryVerifyNonMembershipResponse{Success: false}, nil
+	}
+
+	return &types.QueryVerifyNonMembershipResponse{Success: true}, nil
+}
+
+// validateVerificationRequest performs the stateless validation of a proof verification query.
+func validateVerificationRequest(clientID string, proof []byte, proofHeight types.Height, merklePath commitmenttypes.MerklePath) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(proof) == 0 {
+		return status.Error(codes.InvalidArgument, "empty proof")
+	}
+
+	if proofHeight.IsZero() {
+		return status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if len(merklePath.KeyPath) == 0 {
+		return status.Error(codes.InvalidArgument, "empty merkle path")
+	}
+
+	return nil
+}
+
+// verificationQueryError returns the gRPC error of a proof verification query for errors which must not be reported
+// as failed proof verifications: the client is not found, is not active or the delay periods are below the delay
+// periods of the connections of the client. Nil is returned for all other errors.
+func verificationQueryError(err error, clientID string) error {
+	switch {
+	case errors.Is(err, types.ErrClientNotFound), errors.Is(err, types.ErrRouteNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, types.ErrInvalidDelayPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, types.ErrClientNotActive):
+		return status.Error(codes.FailedPrecondition, errorsmod.Wrapf(err, "cannot verify proof using client (%s)", clientID).Error())
+	default:
+		return nil
+	}
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (k Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
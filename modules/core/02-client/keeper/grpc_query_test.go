@@ -2,12 +2,15 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -646,3 +649,244 @@ func (suite *KeeperTestSuite) TestQueryClientParams() {
 	res, _ := suite.chainA.QueryServer.ClientParams(ctx, &types.QueryClientParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryVerifyMembership() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryVerifyMembershipRequest
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expSuccess bool
+		expErr     bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"proof verification fails with invalid value",
+			func() {
+				req.Value = []byte("invalid value")
+			},
+			false,
+			false,
+		},
+		{
+			"proof verification fails with delay period not passed",
+			func() {
+				req.TimeDelay = uint64(time.Hour.Nanoseconds())
+			},
+			false,
+			false,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+			true,
+		},
+		{
+			"empty proof",
+			func() {
+				req.Proof = nil
+			},
+			false,
+			true,
+		},
+		{
+			"invalid proof height",
+			func() {
+				req.ProofHeight = types.ZeroHeight()
+			},
+			false,
+			true,
+		},
+		{
+			"empty merkle path",
+			func() {
+				req.MerklePath.KeyPath = nil
+			},
+			false,
+			true,
+		},
+		{
+			"empty value",
+			func() {
+				req.Value = nil
+			},
+			false,
+			true,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = types.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			false,
+			true,
+		},
+		{
+			"client not active",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// prove the connection end stored on chainB using the client of chainB on chainA
+			connectionKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+			proof, proofHeight := suite.chainB.QueryProof(connectionKey)
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			req = &types.QueryVerifyMembershipRequest{
+				ClientId:    path.EndpointA.ClientID,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				MerklePath:  merklePath,
+				Value:       value,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			res, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembership(sdk.WrapSDKContext(ctx), req)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSuccess, res.Success)
+
+				// the proof gas is charged before the proof is verified
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(ctx)
+				proofGas := commitmenttypes.ProofVerificationGas(req.Proof, params.ProofGasCostPerByte, params.ProofGasCostPerHashOp)
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, proofGas)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyNonMembership() {
+	var req *types.QueryVerifyNonMembershipRequest
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expSuccess bool
+		expErr     bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"proof verification fails for existing key",
+			func() {
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.FirstConnectionID)))
+				suite.Require().NoError(err)
+
+				req.MerklePath = merklePath
+			},
+			false,
+			false,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+			true,
+		},
+		{
+			"empty proof",
+			func() {
+				req.Proof = nil
+			},
+			false,
+			true,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = types.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// prove the absence of a connection end on chainB using the client of chainB on chainA
+			connectionKey := host.ConnectionKey(ibctesting.InvalidID)
+			proof, proofHeight := suite.chainB.QueryProof(connectionKey)
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID)))
+			suite.Require().NoError(err)
+
+			req = &types.QueryVerifyNonMembershipRequest{
+				ClientId:    path.EndpointA.ClientID,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				MerklePath:  merklePath,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyNonMembership(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSuccess, res.Success)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,7 +14,6 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cometbft/cometbft/libs/log"
-	"github.com/cometbft/cometbft/light"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
@@ -29,27 +27,57 @@ import (
 // Keeper represents a type that grants read and write permissions to any client
 // state information
 type Keeper struct {
-	storeKey       storetypes.StoreKey
-	cdc            codec.BinaryCodec
-	legacySubspace paramtypes.Subspace
-	stakingKeeper  types.StakingKeeper
-	upgradeKeeper  types.UpgradeKeeper
+	storeKey         storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	legacySubspace   paramtypes.Subspace
+	consensusHost    types.ConsensusHost
+	upgradeKeeper    types.UpgradeKeeper
+	connectionKeeper types.ConnectionKeeper
+	router           *types.Router
+	hooks            types.ClientStatusHooks
 }
 
-// NewKeeper creates a new NewKeeper instance
+// NewKeeper creates a new NewKeeper instance. The 09-localhost light client module is registered on the
+// light client router, all other light client modules must be registered by the application.
+// If a staking keeper is provided the tendermint consensus host is used to validate the clients of the
+// running chain, otherwise a consensus host must be set with SetConsensusHost.
 func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, legacySubspace paramtypes.Subspace, sk types.StakingKeeper, uk types.UpgradeKeeper) Keeper {
 	// set KeyTable if it has not already been set
 	if !legacySubspace.HasKeyTable() {
 		legacySubspace = legacySubspace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	router := types.NewRouter(key)
+	router.AddRoute(exported.Localhost, localhost.NewLightClientModule(cdc, key))
+
+	var consensusHost types.ConsensusHost
+	if sk != nil {
+		consensusHost = ibctm.NewConsensusHost(sk)
+	}
+
 	return Keeper{
 		storeKey:       key,
 		cdc:            cdc,
 		legacySubspace: legacySubspace,
-		stakingKeeper:  sk,
+		consensusHost:  consensusHost,
 		upgradeKeeper:  uk,
+		router:         router,
+	}
+}
+
+// GetRouter returns the light client module router.
+func (k Keeper) GetRouter() *types.Router {
+	return k.router
+}
+
+// Route returns the light client module registered for the client type of the given client identifier.
+func (k Keeper) Route(clientID string) (exported.LightClientModule, bool) {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return nil, false
 	}
+
+	return k.router.GetRoute(clientType)
 }
 
 // Logger returns a module-specific logger.
@@ -59,13 +87,22 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // CreateLocalhostClient initialises the 09-localhost client state and sets it in state.
 func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
-	var clientState localhost.ClientState
-	return clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil)
+	clientModule, found := k.Route(exported.LocalhostClientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, exported.LocalhostClientID)
+	}
+
+	return clientModule.Initialize(ctx, exported.LocalhostClientID, nil, nil)
 }
 
 // UpdateLocalhostClient updates the 09-localhost client to the latest block height and chain ID.
 func (k Keeper) UpdateLocalhostClient(ctx sdk.Context, clientState exported.ClientState) []exported.Height {
-	return clientState.UpdateState(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil)
+	clientModule, found := k.Route(exported.LocalhostClientID)
+	if !found {
+		panic(errorsmod.Wrap(types.ErrRouteNotFound, exported.LocalhostClientID))
+	}
+
+	return clientModule.UpdateState(ctx, exported.LocalhostClientID, nil)
 }
 
 // GenerateClientIdentifier returns the next client identifier.
@@ -254,95 +291,48 @@ func (k Keeper) GetLatestClientConsensusState(ctx sdk.Context, clientID string)
 	return k.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
 }
 
-// GetSelfConsensusState introspects the (self) past historical info at a given height
-// and returns the expected consensus state at that height.
-// For now, can only retrieve self consensus states for the current revision
+// GetSelfConsensusState returns the consensus state of the host chain at the given height. The consensus state is
+// read from the history of self consensus states if it is stored, otherwise it is retrieved from the consensus host.
 func (k Keeper) GetSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error) {
-	selfHeight, ok := height.(types.Height)
-	if !ok {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", types.Height{}, height)
-	}
-	// check that height revision matches chainID revision
-	revision := types.ParseChainID(ctx.ChainID())
-	if revision != height.GetRevisionNumber() {
-		return nil, errorsmod.Wrapf(types.ErrInvalidHeight, "chainID revision number does not match height revision number: expected %d, got %d", revision, height.GetRevisionNumber())
-	}
-	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, int64(selfHeight.RevisionHeight))
-	if !found {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrNotFound, "no historical info found at height %d", selfHeight.RevisionHeight)
+	if consensusState, found := k.GetHistoricalSelfConsensusState(ctx, height); found {
+		return consensusState, nil
 	}
 
-	consensusState := &ibctm.ConsensusState{
-		Timestamp:          histInfo.Header.Time,
-		Root:               commitmenttypes.NewMerkleRoot(histInfo.Header.GetAppHash()),
-		NextValidatorsHash: histInfo.Header.NextValidatorsHash,
+	if k.consensusHost == nil {
+		return nil, errorsmod.Wrap(ibcerrors.ErrNotFound, "consensus host is not set")
 	}
-	return consensusState, nil
+
+	return k.consensusHost.GetSelfConsensusState(ctx, height)
 }
 
-// ValidateSelfClient validates the client parameters for a client of the running chain
-// This function is only used to validate the client state the counterparty stores for this chain
-// Client must be in same revision as the executing chain
+// ValidateSelfClient validates the client parameters for a client of the running chain using the consensus host.
+// This function is only used to validate the client state the counterparty stores for this chain.
 func (k Keeper) ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error {
-	tmClient, ok := clientState.(*ibctm.ClientState)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "client must be a Tendermint client, expected: %T, got: %T",
-			&ibctm.ClientState{}, tmClient)
-	}
-
-	if !tmClient.FrozenHeight.IsZero() {
-		return types.ErrClientFrozen
+	if k.consensusHost == nil {
+		return errorsmod.Wrap(ibcerrors.ErrNotFound, "consensus host is not set")
 	}
 
-	if ctx.ChainID() != tmClient.ChainId {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "invalid chain-id. expected: %s, got: %s",
-			ctx.ChainID(), tmClient.ChainId)
-	}
-
-	revision := types.ParseChainID(ctx.ChainID())
-
-	// client must be in the same revision as executing chain
-	if tmClient.LatestHeight.RevisionNumber != revision {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "client is not in the same revision as the chain. expected revision: %d, got: %d",
-			tmClient.LatestHeight.RevisionNumber, revision)
-	}
-
-	selfHeight := types.NewHeight(revision, uint64(ctx.BlockHeight()))
-	if tmClient.LatestHeight.GTE(selfHeight) {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "client has LatestHeight %d greater than or equal to chain height %d",
-			tmClient.LatestHeight, selfHeight)
-	}
-
-	expectedProofSpecs := commitmenttypes.GetSDKSpecs()
-	if !reflect.DeepEqual(expectedProofSpecs, tmClient.ProofSpecs) {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "client has invalid proof specs. expected: %v got: %v",
-			expectedProofSpecs, tmClient.ProofSpecs)
-	}
+	return k.consensusHost.ValidateSelfClient(ctx, clientState)
+}
 
-	if err := light.ValidateTrustLevel(tmClient.TrustLevel.ToTendermint()); err != nil {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "trust-level invalid: %v", err)
+// SetConsensusHost sets the consensus host of the running chain. It panics if the consensus host is nil.
+func (k *Keeper) SetConsensusHost(consensusHost types.ConsensusHost) {
+	if consensusHost == nil {
+		panic(fmt.Errorf("cannot set a nil consensus host"))
 	}
 
-	expectedUbdPeriod := k.stakingKeeper.UnbondingTime(ctx)
-	if expectedUbdPeriod != tmClient.UnbondingPeriod {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "invalid unbonding period. expected: %s, got: %s",
-			expectedUbdPeriod, tmClient.UnbondingPeriod)
-	}
+	k.consensusHost = consensusHost
+}
 
-	if tmClient.UnbondingPeriod < tmClient.TrustingPeriod {
-		return errorsmod.Wrapf(types.ErrInvalidClient, "unbonding period must be greater than trusting period. unbonding period (%d) < trusting period (%d)",
-			tmClient.UnbondingPeriod, tmClient.TrustingPeriod)
+// SetConnectionKeeper sets the connection keeper used to look up the delay periods of the connections of a client
+// when verifying proofs with VerifyClientMembership and VerifyClientNonMembership. It panics if the connection
+// keeper is nil.
+func (k *Keeper) SetConnectionKeeper(connectionKeeper types.ConnectionKeeper) {
+	if connectionKeeper == nil {
+		panic(fmt.Errorf("cannot set a nil connection keeper"))
 	}
 
-	if len(tmClient.UpgradePath) != 0 {
-		// For now, SDK IBC implementation assumes that upgrade path (if defined) is defined by SDK upgrade module
-		expectedUpgradePath := []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
-		if !reflect.DeepEqual(expectedUpgradePath, tmClient.UpgradePath) {
-			return errorsmod.Wrapf(types.ErrInvalidClient, "upgrade path must be the upgrade path defined by upgrade module. expected %v, got %v",
-				expectedUpgradePath, tmClient.UpgradePath)
-		}
-	}
-	return nil
+	k.connectionKeeper = connectionKeeper
 }
 
 // GetUpgradePlan executes the upgrade keeper GetUpgradePlan function.
@@ -389,6 +379,44 @@ func (k Keeper) IterateClientStates(ctx sdk.Context, prefix []byte, cb func(clie
 	}
 }
 
+// nextClientState returns the identifier and client state of the first client whose identifier follows the
+// provided client identifier in store order, or of the first client if the provided identifier is empty.
+// False is returned if no client follows the provided client. Each call seeks the client store once, so
+// callers iterating over clients with a persisted cursor do a bounded amount of work per client.
+func (k Keeper) nextClientState(ctx sdk.Context, clientID string) (string, exported.ClientState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientStorePrefix := host.PrefixedClientStoreKey(nil)
+
+	start := clientStorePrefix
+	if clientID != "" {
+		// skip all keys of the provided client
+		start = sdk.PrefixEndBytes(host.FullClientKey(clientID, nil))
+	}
+
+	for {
+		iterator := store.Iterator(start, sdk.PrefixEndBytes(clientStorePrefix))
+
+		var nextClientID string
+		valid := iterator.Valid()
+		if valid {
+			nextClientID, _, _ = strings.Cut(string(iterator.Key()[len(clientStorePrefix):]), "/")
+		}
+
+		// the iterator is closed before reading the client state, as callers may write to the store
+		sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+		if !valid {
+			return "", nil, false
+		}
+
+		if clientState, found := k.GetClientState(ctx, nextClientID); found {
+			return nextClientID, clientState, true
+		}
+
+		start = sdk.PrefixEndBytes(host.FullClientKey(nextClientID, nil))
+	}
+}
+
 // GetAllClients returns all stored light client State objects.
 func (k Keeper) GetAllClients(ctx sdk.Context) []exported.ClientState {
 	var states []exported.ClientState
@@ -408,12 +436,140 @@ func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
 }
 
 // GetClientStatus returns the status for a given clientState. If the client type is not in the allowed
-// clients param field, Unauthorized is returned, otherwise the client state status is returned.
+// clients param field or no light client module is registered for it, Unauthorized is returned, otherwise
+// the client status is returned by the light client module.
 func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
 	if !k.GetParams(ctx).IsAllowedClient(clientState.ClientType()) {
 		return exported.Unauthorized
 	}
-	return clientState.Status(ctx, k.ClientStore(ctx, clientID), k.cdc)
+
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return exported.Unauthorized
+	}
+
+	return clientModule.Status(ctx, clientID)
+}
+
+// GetClientLatestHeight returns the latest height of the client with the given client identifier.
+// A zero value height is returned if no light client module is registered for the client type.
+func (k Keeper) GetClientLatestHeight(ctx sdk.Context, clientID string) types.Height {
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return types.ZeroHeight()
+	}
+
+	latestHeight, ok := clientModule.LatestHeight(ctx, clientID).(types.Height)
+	if !ok {
+		return types.ZeroHeight()
+	}
+
+	return latestHeight
+}
+
+// GetClientTimestampAtHeight returns the timestamp of the consensus state of the client with the given
+// client identifier at the provided height.
+func (k Keeper) GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	return clientModule.TimestampAtHeight(ctx, clientID, height)
+}
+
+// VerifyClientMembership verifies a proof of the existence of a value at the given path in the counterparty state tracked
+// by the client with the given client identifier. Gas is consumed for the proof before it is verified. The client must
+// be active and the delay periods must be at least the delay periods of the connections of the client, if any.
+func (k Keeper) VerifyClientMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientModule, err := k.getVerificationLightClientModule(ctx, clientID, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	return clientModule.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyClientNonMembership verifies a proof of the absence of the given path in the counterparty state tracked by the
+// client with the given client identifier. Gas is consumed for the proof before it is verified. The client must be
+// active and the delay periods must be at least the delay periods of the connections of the client, if any.
+func (k Keeper) VerifyClientNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientModule, err := k.getVerificationLightClientModule(ctx, clientID, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// getVerificationLightClientModule consumes gas for the given proof and returns the light client module of the client
+// with the given client identifier if the client is active and the delay periods are not below the delay periods of
+// the connections of the client.
+func (k Keeper) getVerificationLightClientModule(ctx sdk.Context, clientID string, delayTimePeriod, delayBlockPeriod uint64, proof []byte) (exported.LightClientModule, error) {
+	k.ConsumeProofGas(ctx, proof)
+
+	if err := k.checkVerificationDelay(ctx, clientID, delayTimePeriod, delayBlockPeriod); err != nil {
+		return nil, err
+	}
+
+	return k.getActiveLightClientModule(ctx, clientID)
+}
+
+// checkVerificationDelay returns an error if the given delay periods are below the delay periods of the connections of
+// the client, as proofs must not be accepted before the delay periods of the connections have passed. No delay is
+// enforced if the connection keeper is not set.
+func (k Keeper) checkVerificationDelay(ctx sdk.Context, clientID string, delayTimePeriod, delayBlockPeriod uint64) error {
+	if k.connectionKeeper == nil {
+		return nil
+	}
+
+	minTimeDelay, minBlockDelay := k.connectionKeeper.GetClientDelayPeriods(ctx, clientID)
+	if delayTimePeriod < minTimeDelay {
+		return errorsmod.Wrapf(types.ErrInvalidDelayPeriod, "time delay (%d) is less than the connection delay period (%d) of client (%s)", delayTimePeriod, minTimeDelay, clientID)
+	}
+
+	if delayBlockPeriod < minBlockDelay {
+		return errorsmod.Wrapf(types.ErrInvalidDelayPeriod, "block delay (%d) is less than the connection block delay (%d) of client (%s)", delayBlockPeriod, minBlockDelay, clientID)
+	}
+
+	return nil
+}
+
+// getActiveLightClientModule returns the light client module of the client with the given client identifier
+// if the client is active.
+func (k Keeper) getActiveLightClientModule(ctx sdk.Context, clientID string) (exported.LightClientModule, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrClientNotFound, clientID)
+	}
+
+	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return nil, errorsmod.Wrapf(types.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	return clientModule, nil
 }
 
 // GetParams returns the total set of ibc-client parameters.
@@ -435,3 +591,14 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	bz := k.cdc.MustMarshal(&params)
 	store.Set([]byte(types.ParamsKey), bz)
 }
+
+// ConsumeProofGas consumes gas proportional to the size of the provided proof and the number of hash operations
+// required to verify it, as configured by the proof gas parameters. It must be called before the proof is verified
+// by a light client, such that oversized proofs run out of gas before being verified. The size of the proof is
+// charged before the proof is decoded to count its hash operations, such that oversized proofs run out of gas
+// before being decoded.
+func (k Keeper) ConsumeProofGas(ctx sdk.Context, proof []byte) {
+	params := k.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(commitmenttypes.ProofByteGas(proof, params.ProofGasCostPerByte), "ibc proof size")
+	ctx.GasMeter().ConsumeGas(commitmenttypes.ProofHashOpGas(proof, params.ProofGasCostPerHashOp), "ibc proof hash operations")
+}
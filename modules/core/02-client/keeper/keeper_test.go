@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -21,6 +22,8 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
@@ -511,3 +514,335 @@ func (suite *KeeperTestSuite) TestUnsetParams() {
 		suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetParams(ctx)
 	})
 }
+
+func (suite *KeeperTestSuite) TestConsumeProofGas() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	proof, _ := suite.chainB.QueryProof(host.FullClientStateKey(path.EndpointB.ClientID))
+	suite.Require().NotZero(commitmenttypes.ProofHashOps(proof))
+
+	testCases := []struct {
+		name   string
+		params types.Params
+	}{
+		{"default params", types.DefaultParams()},
+		{"custom params", types.Params{ProofGasCostPerByte: 3, ProofGasCostPerHashOp: 100}},
+		{"proof gas disabled", types.Params{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			ctx := suite.chainA.GetContext()
+			clientKeeper.SetParams(ctx, tc.params)
+
+			// the gas consumed by reading the params is measured with an empty proof
+			gasBefore := ctx.GasMeter().GasConsumed()
+			clientKeeper.ConsumeProofGas(ctx, nil)
+			paramsGas := ctx.GasMeter().GasConsumed() - gasBefore
+
+			gasBefore = ctx.GasMeter().GasConsumed()
+			clientKeeper.ConsumeProofGas(ctx, proof)
+			proofGas := ctx.GasMeter().GasConsumed() - gasBefore - paramsGas
+
+			expGas := commitmenttypes.ProofVerificationGas(proof, tc.params.ProofGasCostPerByte, tc.params.ProofGasCostPerHashOp)
+			suite.Require().Equal(expGas, proofGas)
+		})
+	}
+
+	suite.Run("proof size is charged before the proof is decoded", func() {
+		clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+		ctx := suite.chainA.GetContext()
+		clientKeeper.SetParams(ctx, types.DefaultParams())
+
+		// the gas limit covers reading the params but not the size of the proof
+		gasLimit := ctx.GasMeter().GasConsumed() + 10_000
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		oversizedProof := make([]byte, gasLimit)
+
+		defer func() {
+			r := recover()
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			suite.Require().True(ok, "expected out of gas panic, got %v", r)
+			suite.Require().Equal("ibc proof size", outOfGas.Descriptor)
+		}()
+
+		clientKeeper.ConsumeProofGas(ctx, oversizedProof)
+	})
+}
+
+func (suite *KeeperTestSuite) TestVerifyClientMembership() {
+	var (
+		path                  *ibctesting.Path
+		delayTime, delayBlock uint64
+	)
+
+	delayPeriod := uint64(time.Minute.Nanoseconds())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"time delay below connection delay period",
+			func() {
+				delayTime--
+			},
+			types.ErrInvalidDelayPeriod,
+		},
+		{
+			"block delay below connection block delay",
+			func() {
+				delayBlock--
+			},
+			types.ErrInvalidDelayPeriod,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = ibctm.FrozenHeight
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ConnectionConfig.DelayPeriod = delayPeriod
+			path.EndpointB.ConnectionConfig.DelayPeriod = delayPeriod
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			existingKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+			existingProof, proofHeight := suite.chainB.QueryProof(existingKey)
+			absentKey := host.ConnectionKey(ibctesting.InvalidID)
+			absentProof, _ := suite.chainB.QueryProof(absentKey)
+
+			existingPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+			absentPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			delayTime, delayBlock = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetClientDelayPeriods(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().Equal(delayPeriod, delayTime)
+			suite.Require().NotZero(delayBlock)
+
+			// let the delay periods of the connection pass
+			suite.coordinator.IncrementTimeBy(time.Duration(delayPeriod))
+			suite.coordinator.CommitNBlocks(suite.chainA, delayBlock+1)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			params := clientKeeper.GetParams(ctx)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			membershipErr := clientKeeper.VerifyClientMembership(ctx, path.EndpointA.ClientID, proofHeight, delayTime, delayBlock, existingProof, existingPath, value)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, commitmenttypes.ProofVerificationGas(existingProof, params.ProofGasCostPerByte, params.ProofGasCostPerHashOp))
+
+			gasBefore = ctx.GasMeter().GasConsumed()
+			nonMembershipErr := clientKeeper.VerifyClientNonMembership(ctx, path.EndpointA.ClientID, proofHeight, delayTime, delayBlock, absentProof, absentPath)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, commitmenttypes.ProofVerificationGas(absentProof, params.ProofGasCostPerByte, params.ProofGasCostPerHashOp))
+
+			if tc.expErr == nil {
+				suite.Require().NoError(membershipErr)
+				suite.Require().NoError(nonMembershipErr)
+			} else {
+				suite.Require().ErrorIs(membershipErr, tc.expErr)
+				suite.Require().ErrorIs(nonMembershipErr, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRoute() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	testCases := []struct {
+		name     string
+		clientID string
+		expFound bool
+	}{
+		{"tendermint client", path.EndpointA.ClientID, true},
+		{"localhost client", exported.LocalhostClientID, true},
+		{"client type not registered", types.FormatClientIdentifier("99-unregistered", 0), false},
+		{"invalid client identifier", ibctesting.InvalidID, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(tc.clientID)
+
+			suite.Require().Equal(tc.expFound, found)
+			suite.Require().Equal(tc.expFound, clientModule != nil)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateClientRouteNotFound() {
+	app := suite.chainA.GetSimApp()
+
+	// only the 09-localhost light client module is registered on the router of a new keeper
+	clientKeeper := keeper.NewKeeper(app.AppCodec(), app.GetKey(exported.StoreKey), app.GetSubspace(exported.ModuleName), app.StakingKeeper, app.UpgradeKeeper)
+
+	clientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+
+	_, err := clientKeeper.CreateClient(suite.chainA.GetContext(), clientState, suite.consensusState)
+	suite.Require().ErrorIs(err, types.ErrRouteNotFound)
+}
+
+func (suite *KeeperTestSuite) TestGetClientLatestHeight() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	latestHeight := clientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().Equal(path.EndpointA.GetClientState().GetLatestHeight(), latestHeight)
+
+	latestHeight = clientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), ibctesting.InvalidID)
+	suite.Require().True(latestHeight.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGetClientTimestampAtHeight() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	height := path.EndpointA.GetClientState().GetLatestHeight()
+
+	timestamp, err := clientKeeper.GetClientTimestampAtHeight(suite.chainA.GetContext(), path.EndpointA.ClientID, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.GetConsensusState(height).GetTimestamp(), timestamp)
+
+	_, err = clientKeeper.GetClientTimestampAtHeight(suite.chainA.GetContext(), ibctesting.InvalidID, height)
+	suite.Require().ErrorIs(err, types.ErrRouteNotFound)
+}
+
+func (suite *KeeperTestSuite) TestConsensusHost() {
+	var (
+		consensusHost *ibctestingmock.ConsensusHost
+		expErr        error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: calls forwarded to the wrapped consensus host",
+			func() {},
+		},
+		{
+			"failure: callbacks return error",
+			func() {
+				expErr = ibcerrors.ErrInvalidRequest
+				consensusHost.GetSelfConsensusStateFn = func(_ sdk.Context, _ exported.Height) (exported.ConsensusState, error) {
+					return nil, expErr
+				}
+				consensusHost.ValidateSelfClientFn = func(_ sdk.Context, _ exported.ClientState) error {
+					return expErr
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expErr = nil
+
+			consensusHost = ibctestingmock.NewConsensusHost(ibctm.NewConsensusHost(suite.chainA.GetSimApp().StakingKeeper))
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.SetConsensusHost(consensusHost)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			height := types.GetSelfHeight(ctx)
+			height.RevisionHeight--
+
+			consensusState, err := clientKeeper.GetSelfConsensusState(ctx, height)
+			if expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(consensusState)
+			} else {
+				suite.Require().ErrorIs(err, expErr)
+			}
+
+			clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+			err = clientKeeper.ValidateSelfClient(ctx, clientState)
+			if expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, expErr)
+			}
+		})
+	}
+}
+
+// TestConsensusHostConnectionHandshake asserts that the connection handshake uses the consensus host
+// set on the client keeper after the initialization of the IBC keeper.
+func (suite *KeeperTestSuite) TestConsensusHostConnectionHandshake() {
+	var validated, consensusStateQueried bool
+
+	consensusHost := ibctestingmock.NewConsensusHost(ibctm.NewConsensusHost(suite.chainA.GetSimApp().StakingKeeper))
+	consensusHost.ValidateSelfClientFn = func(ctx sdk.Context, clientState exported.ClientState) error {
+		validated = true
+		return consensusHost.ConsensusHost.ValidateSelfClient(ctx, clientState)
+	}
+	consensusHost.GetSelfConsensusStateFn = func(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error) {
+		consensusStateQueried = true
+		return consensusHost.ConsensusHost.GetSelfConsensusState(ctx, height)
+	}
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetConsensusHost(consensusHost)
+
+	path := ibctesting.NewPath(suite.chainB, suite.chainA)
+	suite.coordinator.SetupConnections(path)
+
+	suite.Require().True(validated)
+	suite.Require().True(consensusStateQueried)
+}
+
+func (suite *KeeperTestSuite) TestConsensusHostNotSet() {
+	app := suite.chainA.GetSimApp()
+	clientKeeper := keeper.NewKeeper(app.AppCodec(), app.GetKey(exported.StoreKey), app.GetSubspace(exported.ModuleName), nil, app.UpgradeKeeper)
+
+	_, err := clientKeeper.GetSelfConsensusState(suite.chainA.GetContext(), types.GetSelfHeight(suite.chainA.GetContext()))
+	suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+
+	clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+	err = clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), clientState)
+	suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+
+	suite.Require().Panics(func() {
+		clientKeeper.SetConsensusHost(nil)
+	})
+}
@@ -47,3 +47,34 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated client to self-manage params")
 	return nil
 }
+
+// Migrate6to7 migrates from consensus version 6 to 7.
+// This migration sets the client status check limit and the client status hook gas limit
+// to their default values.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ClientStatusCheckLimit = types.DefaultClientStatusCheckLimit
+	params.ClientStatusHookGasLimit = types.DefaultClientStatusHookGasLimit
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated client status params")
+	return nil
+}
+
+// Migrate7to8 migrates from consensus version 7 to 8.
+// This migration sets the proof gas costs per byte and per hash operation to their default values.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ProofGasCostPerByte = types.DefaultProofGasCostPerByte
+	params.ProofGasCostPerHashOp = types.DefaultProofGasCostPerHashOp
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated proof gas params")
+	return nil
+}
@@ -20,7 +20,7 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 				subspace := suite.chainA.GetSimApp().GetSubspace(ibcexported.ModuleName)
 				subspace.SetParamSet(suite.chainA.GetContext(), &params)
 			},
-			types.DefaultParams(),
+			types.NewParams(types.DefaultAllowedClients...),
 		},
 	}
 
@@ -41,3 +41,37 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 		})
 	}
 }
+
+// TestMigrate6to7 tests the migration setting the client status params to their default values
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper
+
+	params := types.DefaultParams()
+	params.ClientStatusCheckLimit = 0
+	params.ClientStatusHookGasLimit = 0
+	clientKeeper.SetParams(ctx, params)
+
+	migrator := keeper.NewMigrator(clientKeeper)
+	err := migrator.Migrate6to7(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), clientKeeper.GetParams(ctx))
+}
+
+// TestMigrate7to8 tests the migration setting the proof gas params to their default values
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper
+
+	params := types.DefaultParams()
+	params.ProofGasCostPerByte = 0
+	params.ProofGasCostPerHashOp = 0
+	clientKeeper.SetParams(ctx, params)
+
+	migrator := keeper.NewMigrator(clientKeeper)
+	err := migrator.Migrate7to8(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), clientKeeper.GetParams(ctx))
+}
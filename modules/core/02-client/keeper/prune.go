@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+)
+
+// PruneExpiredConsensusStates prunes all expired consensus states of the given client. An error is returned
+// if the client does not exist or its light client module does not support consensus state pruning.
+// The number of pruned consensus states is returned.
+func (k Keeper) PruneExpiredConsensusStates(ctx sdk.Context, clientID string) (uint64, error) {
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return 0, errorsmod.Wrapf(types.ErrClientNotFound, "cannot prune consensus states of client with ID %s", clientID)
+	}
+
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	pruned, err := clientModule.PruneExpiredConsensusStates(ctx, clientID, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	k.Logger(ctx).Info("pruned expired consensus states", "client-id", clientID, "pruned", pruned)
+
+	return pruned, nil
+}
+
+// PruneExpiredConsensusStatesWithLimit prunes at most limit expired consensus states of clients whose light client
+// module supports consensus state pruning. At most limit clients are visited, in order of their client identifiers,
+// and pruning resumes from the last visited client of the previous call, wrapping around once all clients have
+// been visited. The total number of pruned consensus states is returned.
+func (k Keeper) PruneExpiredConsensusStatesWithLimit(ctx sdk.Context, limit uint64) uint64 {
+	cursor := k.getConsensusStatePruneCursor(ctx)
+
+	var totalPruned uint64
+	for visited := uint64(0); visited < limit; visited++ {
+		clientID, _, found := k.nextClientState(ctx, cursor)
+		if !found {
+			// all clients have been visited, the next call starts from the first client
+			cursor = ""
+			break
+		}
+
+		totalPruned += k.pruneExpiredConsensusStatesWithLimit(ctx, clientID, limit-totalPruned)
+		if totalPruned >= limit {
+			// the client may have more expired consensus states, the next call resumes from it
+			break
+		}
+
+		cursor = clientID
+	}
+
+	k.setConsensusStatePruneCursor(ctx, cursor)
+
+	return totalPruned
+}
+
+// pruneExpiredConsensusStatesWithLimit prunes at most limit expired consensus states of the given client and
+// returns the number of pruned consensus states. Clients whose light client module does not support consensus
+// state pruning are skipped.
+func (k Keeper) pruneExpiredConsensusStatesWithLimit(ctx sdk.Context, clientID string, limit uint64) uint64 {
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return 0
+	}
+
+	pruned, err := clientModule.PruneExpiredConsensusStates(ctx, clientID, limit)
+	if err != nil {
+		if !errors.Is(err, types.ErrConsensusStatePruningNotSupported) {
+			k.Logger(ctx).Error("failed to prune expired consensus states", "client-id", clientID, "error", err)
+		}
+		return 0
+	}
+
+	if pruned > 0 {
+		k.Logger(ctx).Debug("pruned expired consensus states", "client-id", clientID, "pruned", pruned)
+	}
+
+	return pruned
+}
+
+// getConsensusStatePruneCursor returns the identifier of the last client visited by PruneExpiredConsensusStatesWithLimit.
+func (k Keeper) getConsensusStatePruneCursor(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get([]byte(types.KeyConsensusStatePruneCursor)))
+}
+
+// setConsensusStatePruneCursor stores the identifier of the last client visited by PruneExpiredConsensusStatesWithLimit.
+func (k Keeper) setConsensusStatePruneCursor(ctx sdk.Context, clientID string) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.KeyConsensusStatePruneCursor), []byte(clientID))
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// setupClientWithConsensusStates creates a tendermint client on chainA with two consensus states and returns its client identifier.
+// The consensus states expire once the time is incremented by the trusting period.
+func (suite *KeeperTestSuite) setupClientWithConsensusStates() string {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	return path.EndpointA.ClientID
+}
+
+func (suite *KeeperTestSuite) countConsensusStates(clientID string) int {
+	var count int
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+	ibctm.IterateConsensusStateAscending(clientStore, func(_ exported.Height) bool {
+		count++
+		return false
+	})
+
+	return count
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStates() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success: all expired consensus states pruned",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+			},
+			2,
+			nil,
+		},
+		{
+			"success: no consensus states expired",
+			func() {},
+			0,
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			0,
+			types.ErrClientNotFound,
+		},
+		{
+			"failure: client does not support pruning",
+			func() {
+				clientID = suite.solomachine.CreateClient(suite.chainA)
+			},
+			0,
+			types.ErrConsensusStatePruningNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID = suite.setupClientWithConsensusStates()
+
+			tc.malleate()
+
+			pruned, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneExpiredConsensusStates(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+				suite.Require().Equal(2-int(tc.expPruned), suite.countConsensusStates(clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStatesWithLimit() {
+	testCases := []struct {
+		name      string
+		limit     uint64
+		expPruned uint64
+	}{
+		{"all expired consensus states pruned within limit", 10, 4},
+		{"expired consensus states pruned up to limit across clients", 3, 3},
+		{"zero limit prunes nothing", 0, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientIDs := []string{suite.setupClientWithConsensusStates(), suite.setupClientWithConsensusStates()}
+			// the solo machine client does not support pruning and is skipped
+			suite.solomachine.CreateClient(suite.chainA)
+
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+			pruned := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneExpiredConsensusStatesWithLimit(suite.chainA.GetContext(), tc.limit)
+			suite.Require().Equal(tc.expPruned, pruned)
+
+			var remaining int
+			for _, clientID := range clientIDs {
+				remaining += suite.countConsensusStates(clientID)
+			}
+			suite.Require().Equal(4-int(tc.expPruned), remaining)
+		})
+	}
+}
+
+// TestPruneExpiredConsensusStatesWithLimitCursor tests that at most limit clients are visited per call and that
+// pruning resumes from the last visited client of the previous call.
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStatesWithLimitCursor() {
+	clientIDs := []string{suite.setupClientWithConsensusStates(), suite.setupClientWithConsensusStates()}
+	// the solo machine client is the first client in store order and does not support pruning
+	suite.solomachine.CreateClient(suite.chainA)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	// each call visits a single client: the solo machine client, the first tendermint client until all of its
+	// expired consensus states are pruned, the second tendermint client likewise, and then wraps around
+	expPruned := []uint64{0, 1, 1, 0, 1, 1, 0}
+	for i, exp := range expPruned {
+		pruned := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneExpiredConsensusStatesWithLimit(suite.chainA.GetContext(), 1)
+		suite.Require().Equal(exp, pruned, "call %d", i)
+	}
+
+	for _, clientID := range clientIDs {
+		suite.Require().Zero(suite.countConsensusStates(clientID))
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+)
+
+// GetHistoricalSelfConsensusState returns the consensus state of the host chain at the given height from the
+// history of self consensus states stored by the client keeper.
+func (k Keeper) GetHistoricalSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SelfConsensusStateKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return k.MustUnmarshalConsensusState(bz), true
+}
+
+// SetHistoricalSelfConsensusState stores the consensus state of the host chain at the given height.
+func (k Keeper) SetHistoricalSelfConsensusState(ctx sdk.Context, height exported.Height, consensusState exported.ConsensusState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SelfConsensusStateKey(height), k.MustMarshalConsensusState(consensusState))
+}
+
+// IterateHistoricalSelfConsensusStates iterates over the stored consensus states of the host chain in ascending
+// height order and performs a callback function. Iteration stops if the callback returns true.
+func (k Keeper) IterateHistoricalSelfConsensusStates(ctx sdk.Context, cb func(height types.Height, consensusState exported.ConsensusState) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SelfConsensusStatePrefixKey())
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := types.ParseSelfConsensusStateHeight(iterator.Key()[len(types.SelfConsensusStatePrefixKey()):])
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(height, k.MustUnmarshalConsensusState(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// TrackSelfConsensusState stores the consensus state of the host chain at the current height and prunes the
+// stored consensus states which are older than the self consensus state retention. All stored consensus states
+// are pruned if the retention is zero.
+func (k Keeper) TrackSelfConsensusState(ctx sdk.Context) {
+	retention := k.GetParams(ctx).SelfConsensusStateRetention
+	selfHeight := types.GetSelfHeight(ctx)
+
+	if retention > 0 {
+		consensusState := &ibctm.ConsensusState{
+			Timestamp:          ctx.BlockTime(),
+			Root:               commitmenttypes.NewMerkleRoot(ctx.BlockHeader().AppHash),
+			NextValidatorsHash: ctx.BlockHeader().NextValidatorsHash,
+		}
+
+		k.SetHistoricalSelfConsensusState(ctx, selfHeight, consensusState)
+	}
+
+	// prune the consensus states below the earliest height within the retention
+	var end []byte
+	if retention > 0 {
+		if selfHeight.RevisionHeight < retention {
+			return
+		}
+
+		end = types.SelfConsensusStateKey(types.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight-retention+1))
+	} else {
+		end = sdk.PrefixEndBytes(types.SelfConsensusStatePrefixKey())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SelfConsensusStatePrefixKey(), end)
+
+	// collect the keys before deleting them, as the store cannot be written while iterating
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
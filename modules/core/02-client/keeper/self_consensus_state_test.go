@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctestingmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+// setSelfConsensusStateRetention sets the self consensus state retention on chainA.
+func (suite *KeeperTestSuite) setSelfConsensusStateRetention(retention uint64) {
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	params := clientKeeper.GetParams(suite.chainA.GetContext())
+	params.SelfConsensusStateRetention = retention
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+func (suite *KeeperTestSuite) selfConsensusStateHeights() []types.Height {
+	var heights []types.Height
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.IterateHistoricalSelfConsensusStates(suite.chainA.GetContext(), func(height types.Height, _ exported.ConsensusState) bool {
+		heights = append(heights, height)
+		return false
+	})
+
+	return heights
+}
+
+func (suite *KeeperTestSuite) TestTrackSelfConsensusState() {
+	testCases := []struct {
+		name       string
+		retention  uint64
+		numBlocks  uint64
+		expHeights uint64
+	}{
+		{"history disabled", 0, 5, 0},
+		{"fewer blocks than retention", 10, 3, 4},
+		{"consensus states pruned beyond retention", 3, 5, 3},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setSelfConsensusStateRetention(tc.retention)
+			suite.coordinator.CommitNBlocks(suite.chainA, tc.numBlocks)
+
+			heights := suite.selfConsensusStateHeights()
+			suite.Require().Len(heights, int(tc.expHeights))
+
+			// the consensus states of the most recent blocks are retained
+			selfHeight := types.GetSelfHeight(suite.chainA.GetContext())
+			for i, height := range heights {
+				suite.Require().Equal(selfHeight.RevisionHeight-tc.expHeights+uint64(i)+1, height.RevisionHeight)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTrackSelfConsensusStateRetentionDisabled() {
+	suite.setSelfConsensusStateRetention(3)
+	suite.coordinator.CommitNBlocks(suite.chainA, 3)
+	suite.Require().Len(suite.selfConsensusStateHeights(), 3)
+
+	// all stored consensus states are pruned once the history is disabled
+	suite.setSelfConsensusStateRetention(0)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.TrackSelfConsensusState(suite.chainA.GetContext())
+	suite.Require().Empty(suite.selfConsensusStateHeights())
+}
+
+func (suite *KeeperTestSuite) TestGetSelfConsensusStateFromHistory() {
+	suite.setSelfConsensusStateRetention(5)
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+
+	ctx := suite.chainA.GetContext()
+	height := types.GetSelfHeight(ctx)
+	height.RevisionHeight--
+
+	// the stored consensus state matches the consensus state derived from the staking historical info
+	expConsensusState, err := ibctm.NewConsensusHost(suite.chainA.GetSimApp().StakingKeeper).GetSelfConsensusState(ctx, height)
+	suite.Require().NoError(err)
+
+	consensusHost := ibctestingmock.NewConsensusHost(nil)
+	consensusHost.GetSelfConsensusStateFn = func(_ sdk.Context, _ exported.Height) (exported.ConsensusState, error) {
+		return nil, ibcerrors.ErrNotFound
+	}
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientKeeper.SetConsensusHost(consensusHost)
+
+	consensusState, err := clientKeeper.GetSelfConsensusState(ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expConsensusState, consensusState)
+
+	// heights which are not stored are retrieved from the consensus host
+	_, err = clientKeeper.GetSelfConsensusState(ctx, types.NewHeight(height.RevisionNumber, height.RevisionHeight+10))
+	suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+}
+
+func (suite *KeeperTestSuite) TestQuerySelfConsensusStates() {
+	suite.setSelfConsensusStateRetention(3)
+	suite.coordinator.CommitNBlocks(suite.chainA, 3)
+
+	ctx := suite.chainA.GetContext()
+	res, err := suite.chainA.QueryServer.SelfConsensusStates(sdk.WrapSDKContext(ctx), &types.QuerySelfConsensusStatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ConsensusStates, 3)
+
+	for i, consensusStateWithHeight := range res.ConsensusStates {
+		suite.Require().Equal(suite.selfConsensusStateHeights()[i], consensusStateWithHeight.Height)
+
+		consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetHistoricalSelfConsensusState(ctx, consensusStateWithHeight.Height)
+		suite.Require().True(found)
+
+		expAny, err := types.PackConsensusState(consensusState)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expAny, consensusStateWithHeight.ConsensusState)
+	}
+
+	_, err = suite.chainA.QueryServer.SelfConsensusStates(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+}
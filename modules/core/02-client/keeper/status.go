@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// SetHooks sets the client status hooks. The method panics if the hooks have already been set.
+func (k *Keeper) SetHooks(hooks types.ClientStatusHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set client status hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// CheckClientStatuses checks the status of at most limit clients and calls the client status hooks for the
+// clients whose status moved to Expired or Frozen since it was last observed. The check resumes after the last
+// checked client of the previous call and wraps around once all clients have been checked. The status of clients
+// is not tracked if no hooks are set.
+func (k Keeper) CheckClientStatuses(ctx sdk.Context, limit uint64) {
+	if k.hooks == nil {
+		return
+	}
+
+	cursor := k.getClientStatusCursor(ctx)
+	for checked := uint64(0); checked < limit; checked++ {
+		clientID, clientState, found := k.nextClientState(ctx, cursor)
+		if !found {
+			// all clients have been checked, the next check starts from the first client
+			cursor = ""
+			break
+		}
+
+		k.trackClientStatus(ctx, clientID, k.GetClientStatus(ctx, clientState, clientID))
+		cursor = clientID
+	}
+
+	k.setClientStatusCursor(ctx, cursor)
+}
+
+// checkClientStatus checks the status of the given client and calls the client status hooks if its status
+// moved to Expired or Frozen since it was last observed.
+func (k Keeper) checkClientStatus(ctx sdk.Context, clientID string) {
+	if k.hooks == nil {
+		return
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return
+	}
+
+	k.trackClientStatus(ctx, clientID, k.GetClientStatus(ctx, clientState, clientID))
+}
+
+// trackClientStatus stores the status of a client if it changed since it was last observed and calls the
+// client status hooks if the client expired or was frozen.
+func (k Keeper) trackClientStatus(ctx sdk.Context, clientID string, status exported.Status) {
+	if status == k.getLastClientStatus(ctx, clientID) {
+		return
+	}
+
+	k.setLastClientStatus(ctx, clientID, status)
+
+	switch status {
+	case exported.Expired:
+		k.Logger(ctx).Info("client expired", "client-id", clientID)
+		k.callClientStatusHook(ctx, clientID, status, k.hooks.OnClientExpired)
+	case exported.Frozen:
+		k.Logger(ctx).Info("client frozen", "client-id", clientID)
+		k.callClientStatusHook(ctx, clientID, status, k.hooks.OnClientFrozen)
+	}
+}
+
+// callClientStatusHook calls the provided client status hook in a cached context limited to the client status
+// hook gas limit. The state changes of the hook are discarded if it panics or runs out of gas, and the panic is
+// recovered, so that a faulty hook cannot halt the chain. The gas consumed by the hook, up to the gas limit, is
+// consumed by the provided context. A client status hook gas limit of 0 disables the hooks, in which case the
+// status change is still tracked but not reported to the hooks.
+func (k Keeper) callClientStatusHook(ctx sdk.Context, clientID string, status exported.Status, hook func(sdk.Context, string)) {
+	gasLimit := k.GetParams(ctx).ClientStatusHookGasLimit
+	if gasLimit == 0 {
+		k.Logger(ctx).Info("client status hook is disabled", "client-id", clientID, "status", status)
+		return
+	}
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("client status hook failed", "client-id", clientID, "status", status, "panic", r)
+		}
+
+		// the gas is consumed once the panic of the hook is recovered, such that the provided context
+		// running out of gas panics as it would outside of the hook
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc client %s hook", status))
+	}()
+
+	hook(cachedCtx, clientID)
+	writeFn()
+}
+
+// getLastClientStatus returns the last observed status of a client. Clients are created Active, so a client
+// whose status has not been observed yet is assumed to be Active.
+func (k Keeper) getLastClientStatus(ctx sdk.Context, clientID string) exported.Status {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClientStatusKey(clientID))
+	if len(bz) == 0 {
+		return exported.Active
+	}
+
+	return exported.Status(bz)
+}
+
+// setLastClientStatus stores the last observed status of a client.
+func (k Keeper) setLastClientStatus(ctx sdk.Context, clientID string, status exported.Status) {
+	ctx.KVStore(k.storeKey).Set(types.ClientStatusKey(clientID), []byte(status))
+}
+
+// getClientStatusCursor returns the identifier of the last client whose status was checked by CheckClientStatuses.
+func (k Keeper) getClientStatusCursor(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get([]byte(types.KeyClientStatusCursor)))
+}
+
+// setClientStatusCursor stores the identifier of the last client whose status was checked by CheckClientStatuses.
+func (k Keeper) setClientStatusCursor(ctx sdk.Context, clientID string) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.KeyClientStatusCursor), []byte(clientID))
+}
+
+// identifiedClientStatus returns the status of a client along with the time left until it expires and
+// the timestamp of its latest consensus state.
+func (k Keeper) identifiedClientStatus(ctx sdk.Context, clientID string, clientState exported.ClientState) types.IdentifiedClientStatus {
+	clientStatus := types.IdentifiedClientStatus{
+		ClientId: clientID,
+		Status:   k.GetClientStatus(ctx, clientState, clientID).String(),
+	}
+
+	consensusState, found := k.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
+	if !found {
+		return clientStatus
+	}
+
+	clientStatus.LatestConsensusTimestamp = consensusState.GetTimestamp()
+
+	expiringClientState, ok := clientState.(exported.ExpiringClientState)
+	if !ok || clientStatus.Status != exported.Active.String() {
+		return clientStatus
+	}
+
+	expiryTime := expiringClientState.ExpiryTime(time.Unix(0, int64(consensusState.GetTimestamp())))
+	if timeUntilExpiry := expiryTime.Sub(ctx.BlockTime()); timeUntilExpiry > 0 {
+		clientStatus.TimeUntilExpiry = timeUntilExpiry
+	}
+
+	return clientStatus
+}
@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+var _ clienttypes.ClientStatusHooks = (*mockClientStatusHooks)(nil)
+
+// mockClientStatusHooks records the clients for which the client status hooks are called.
+type mockClientStatusHooks struct {
+	expired []string
+	frozen  []string
+}
+
+func (h *mockClientStatusHooks) OnClientExpired(_ sdk.Context, clientID string) {
+	h.expired = append(h.expired, clientID)
+}
+
+func (h *mockClientStatusHooks) OnClientFrozen(_ sdk.Context, clientID string) {
+	h.frozen = append(h.frozen, clientID)
+}
+
+func (suite *KeeperTestSuite) TestClientStatusHooks() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expExpired bool
+		expFrozen  bool
+	}{
+		{
+			"no hooks called for active client",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+			},
+			false,
+			false,
+		},
+		{
+			"expiry detected by client status check",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+			},
+			true,
+			false,
+		},
+		{
+			"expiry reported only once",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+			},
+			true,
+			false,
+		},
+		{
+			"no hooks called with a client status hook gas limit of 0",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.ClientStatusHookGasLimit = 0
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+			},
+			false,
+			false,
+		},
+		{
+			"freeze detected by client status check",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+			},
+			false,
+			true,
+		},
+		{
+			"freeze detected on misbehaviour",
+			func() {
+				trustedHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				// a conflicting header at the latest height of the client is misbehaviour
+				latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+				consensusState, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, latestHeight)
+				suite.Require().True(found)
+
+				header := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(latestHeight.RevisionHeight), trustedHeight, consensusState.(*ibctm.ConsensusState).Timestamp.Add(time.Second),
+					suite.chainB.Vals, suite.chainB.Vals, suite.chainB.Vals, suite.chainB.Signers)
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Frozen, path.EndpointA.GetClientState().Status(suite.chainA.GetContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID), suite.chainA.App.AppCodec()))
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			hooks := &mockClientStatusHooks{}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(hooks)
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			tc.malleate()
+
+			if tc.expExpired {
+				suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.expired)
+			} else {
+				suite.Require().Empty(hooks.expired)
+			}
+
+			if tc.expFrozen {
+				suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.frozen)
+			} else {
+				suite.Require().Empty(hooks.frozen)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientStatuses() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	expiredPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(expiredPath)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod - time.Hour)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+
+	ctx := suite.chainA.GetContext()
+	res, err := suite.chainA.QueryServer.ClientStatuses(sdk.WrapSDKContext(ctx), &clienttypes.QueryClientStatusesRequest{})
+	suite.Require().NoError(err)
+
+	statuses := make(map[string]clienttypes.IdentifiedClientStatus)
+	for _, clientStatus := range res.ClientStatuses {
+		statuses[clientStatus.ClientId] = clientStatus
+	}
+
+	clientStatus, ok := statuses[path.EndpointA.ClientID]
+	suite.Require().True(ok)
+	suite.Require().Equal(exported.Active.String(), clientStatus.Status)
+
+	consensusState, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, path.EndpointA.GetClientState().GetLatestHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(consensusState.GetTimestamp(), clientStatus.LatestConsensusTimestamp)
+
+	expiry := consensusState.(*ibctm.ConsensusState).Timestamp.Add(ibctesting.TrustingPeriod)
+	suite.Require().Equal(expiry.Sub(ctx.BlockTime()), clientStatus.TimeUntilExpiry)
+
+	clientStatus, ok = statuses[expiredPath.EndpointA.ClientID]
+	suite.Require().True(ok)
+	suite.Require().Equal(exported.Expired.String(), clientStatus.Status)
+	suite.Require().Zero(clientStatus.TimeUntilExpiry)
+	suite.Require().NotZero(clientStatus.LatestConsensusTimestamp)
+}
+
+func (suite *KeeperTestSuite) TestCheckClientStatusesWithLimit() {
+	hooks := &mockClientStatusHooks{}
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(hooks)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	otherPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(otherPath)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// a single client is checked per call, resuming after the last checked client
+	clientKeeper.CheckClientStatuses(suite.chainA.GetContext(), 1)
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.expired)
+
+	clientKeeper.CheckClientStatuses(suite.chainA.GetContext(), 1)
+	suite.Require().Equal([]string{path.EndpointA.ClientID, otherPath.EndpointA.ClientID}, hooks.expired)
+
+	// the remaining clients are checked and the check wraps around to the first client
+	clientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+	clientKeeper.CheckClientStatuses(suite.chainA.GetContext(), clienttypes.DefaultClientStatusCheckLimit)
+	suite.Require().Equal([]string{path.EndpointA.ClientID, otherPath.EndpointA.ClientID}, hooks.expired)
+}
+
+var _ clienttypes.ClientStatusHooks = (*faultyClientStatusHooks)(nil)
+
+// faultyClientStatusHooks emits an event and then panics or consumes more gas than the client status hook gas limit.
+type faultyClientStatusHooks struct {
+	outOfGas bool
+}
+
+func (h faultyClientStatusHooks) OnClientExpired(ctx sdk.Context, clientID string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent("client_expired_hook", sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID)))
+
+	if h.outOfGas {
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "faulty hook")
+	}
+
+	panic("faulty hook")
+}
+
+func (faultyClientStatusHooks) OnClientFrozen(_ sdk.Context, _ string) {}
+
+func (suite *KeeperTestSuite) TestFaultyClientStatusHooks() {
+	for _, outOfGas := range []bool{false, true} {
+		suite.Run(fmt.Sprintf("out of gas: %t", outOfGas), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(faultyClientStatusHooks{outOfGas: outOfGas})
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().NotPanics(func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(ctx, clienttypes.DefaultClientStatusCheckLimit)
+			})
+
+			// the state changes of the failed hook are discarded and the gas consumed by the hook is bounded
+			for _, event := range ctx.EventManager().Events() {
+				suite.Require().NotEqual("client_expired_hook", event.Type)
+			}
+			suite.Require().LessOrEqual(ctx.GasMeter().GasConsumed(), 2*clienttypes.DefaultClientStatusHookGasLimit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFaultyClientStatusHooksOutOfGas() {
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(faultyClientStatusHooks{outOfGas: true})
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	// the panic of the hook is recovered and the gas it consumed runs the context out of gas
+	ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewGasMeter(clienttypes.DefaultClientStatusHookGasLimit / 2))
+	suite.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc client %s hook", exported.Expired)}, func() {
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckClientStatuses(ctx, clienttypes.DefaultClientStatusCheckLimit)
+	})
+}
@@ -345,6 +345,25 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// consensus_state_prune_limit defines the maximum number of expired consensus states pruned and of clients
+	// visited at the beginning of each block. Pruning resumes from the last visited client in the next block.
+	// Background pruning is disabled if it is zero.
+	ConsensusStatePruneLimit uint64 `protobuf:"varint,2,opt,name=consensus_state_prune_limit,json=consensusStatePruneLimit,proto3" json:"consensus_state_prune_limit,omitempty"`
+	// self_consensus_state_retention defines the number of blocks for which the consensus state of the host
+	// chain is stored at the beginning of each block. The history of self consensus states is disabled if it is zero.
+	SelfConsensusStateRetention uint64 `protobuf:"varint,3,opt,name=self_consensus_state_retention,json=selfConsensusStateRetention,proto3" json:"self_consensus_state_retention,omitempty"`
+	// proof_gas_cost_per_byte defines the gas consumed per byte of a proof before it is verified by a light client.
+	ProofGasCostPerByte uint64 `protobuf:"varint,4,opt,name=proof_gas_cost_per_byte,json=proofGasCostPerByte,proto3" json:"proof_gas_cost_per_byte,omitempty"`
+	// proof_gas_cost_per_hash_op defines the gas consumed per hash operation performed while verifying the ICS-23
+	// commitment proofs of a merkle proof.
+	ProofGasCostPerHashOp uint64 `protobuf:"varint,5,opt,name=proof_gas_cost_per_hash_op,json=proofGasCostPerHashOp,proto3" json:"proof_gas_cost_per_hash_op,omitempty"`
+	// client_status_check_limit defines the maximum number of clients whose status is checked at the end of each
+	// block. The check resumes from the last checked client in the next block. The end block check is disabled if
+	// it is zero.
+	ClientStatusCheckLimit uint64 `protobuf:"varint,6,opt,name=client_status_check_limit,json=clientStatusCheckLimit,proto3" json:"client_status_check_limit,omitempty"`
+	// client_status_hook_gas_limit defines the gas limit of each call to the client status hooks. The client status
+	// hooks are disabled if it is zero.
+	ClientStatusHookGasLimit uint64 `protobuf:"varint,7,opt,name=client_status_hook_gas_limit,json=clientStatusHookGasLimit,proto3" json:"client_status_hook_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -387,6 +406,48 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetConsensusStatePruneLimit() uint64 {
+	if m != nil {
+		return m.ConsensusStatePruneLimit
+	}
+	return 0
+}
+
+func (m *Params) GetSelfConsensusStateRetention() uint64 {
+	if m != nil {
+		return m.SelfConsensusStateRetention
+	}
+	return 0
+}
+
+func (m *Params) GetProofGasCostPerByte() uint64 {
+	if m != nil {
+		return m.ProofGasCostPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetProofGasCostPerHashOp() uint64 {
+	if m != nil {
+		return m.ProofGasCostPerHashOp
+	}
+	return 0
+}
+
+func (m *Params) GetClientStatusCheckLimit() uint64 {
+	if m != nil {
+		return m.ClientStatusCheckLimit
+	}
+	return 0
+}
+
+func (m *Params) GetClientStatusHookGasLimit() uint64 {
+	if m != nil {
+		return m.ClientStatusHookGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -400,47 +461,57 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6b, 0xd4, 0x40,
-	0x14, 0x4f, 0xda, 0x75, 0xe9, 0x4e, 0xa4, 0xab, 0x71, 0x0b, 0xeb, 0x2a, 0xd9, 0x25, 0x08, 0x2e,
-	0xc5, 0x26, 0xdd, 0x15, 0x6c, 0x29, 0x78, 0xb0, 0xbd, 0xb4, 0x17, 0x29, 0x91, 0x22, 0x08, 0xb2,
-	0x24, 0x93, 0x69, 0x76, 0x24, 0x99, 0x09, 0x99, 0x49, 0xa4, 0xdf, 0xc0, 0xa3, 0xe0, 0xc5, 0x63,
-	0x3f, 0x84, 0x1f, 0xa2, 0x78, 0xea, 0xd1, 0x93, 0xc8, 0xee, 0xc5, 0x6f, 0xe0, 0x55, 0x32, 0x33,
-	0x71, 0x37, 0xfe, 0xa9, 0x82, 0xb7, 0x79, 0xef, 0xfd, 0xe6, 0xbd, 0xdf, 0xef, 0xf1, 0x9b, 0x01,
-	0x7d, 0x1c, 0x40, 0x17, 0xd2, 0x0c, 0xb9, 0x30, 0xc6, 0x88, 0x70, 0xb7, 0x18, 0xa9, 0x93, 0x93,
-	0x66, 0x94, 0x53, 0xd3, 0xc4, 0x01, 0x74, 0x4a, 0x80, 0xa3, 0xd2, 0xc5, 0xa8, 0xd7, 0x89, 0x68,
-	0x44, 0x45, 0xd9, 0x2d, 0x4f, 0x12, 0xd9, 0xbb, 0x1d, 0x51, 0x1a, 0xc5, 0xc8, 0x15, 0x51, 0x90,
-	0x9f, 0xba, 0x3e, 0x39, 0x53, 0xa5, 0x7b, 0x90, 0xb2, 0x84, 0x32, 0x37, 0x4f, 0xa3, 0xcc, 0x0f,
-	0x91, 0x5b, 0x8c, 0x02, 0xc4, 0xfd, 0x51, 0x15, 0x57, 0x0d, 0x24, 0x6a, 0x22, 0x3b, 0xcb, 0x40,
-	0x96, 0xec, 0x04, 0x6c, 0x1c, 0x85, 0x88, 0x70, 0x7c, 0x8a, 0x51, 0x78, 0x20, 0x88, 0x3c, 0xe3,
-	0x3e, 0x47, 0xe6, 0x1d, 0xd0, 0x92, 0xbc, 0x26, 0x38, 0xec, 0xea, 0x03, 0x7d, 0xd8, 0xf2, 0xd6,
-	0x64, 0xe2, 0x28, 0x34, 0x77, 0xc0, 0x75, 0x55, 0x64, 0x25, 0xb8, 0xbb, 0x32, 0xd0, 0x87, 0xc6,
-	0xb8, 0xe3, 0x48, 0xa2, 0x4e, 0x45, 0xd4, 0x79, 0x42, 0xce, 0x3c, 0x03, 0x2e, 0xba, 0xda, 0xef,
-	0x74, 0xd0, 0x3d, 0xa0, 0x84, 0x21, 0xc2, 0x72, 0x26, 0x52, 0xcf, 0x31, 0x9f, 0x1e, 0x22, 0x1c,
-	0x4d, 0xb9, 0xb9, 0x0b, 0x9a, 0x53, 0x71, 0x12, 0xf3, 0x8c, 0x71, 0xcf, 0xf9, 0x75, 0x45, 0x8e,
-	0xc4, 0xee, 0x37, 0x2e, 0x3e, 0xf7, 0x35, 0x4f, 0xe1, 0xcd, 0xc7, 0xa0, 0x0d, 0xab, 0xae, 0xff,
-	0x40, 0x69, 0x1d, 0xd6, 0x28, 0x94, 0xac, 0x36, 0xa4, 0xf6, 0x3a, 0x37, 0x76, 0xf5, 0x16, 0x5e,
-	0x82, 0x1b, 0x3f, 0x4d, 0x65, 0xdd, 0x95, 0xc1, 0xea, 0xd0, 0x18, 0x3f, 0xf8, 0x1d, 0xf3, 0x3f,
-	0xe9, 0x56, 0x5a, 0xda, 0x75, 0x52, 0xcc, 0xbe, 0xd4, 0x41, 0x47, 0xb2, 0x3a, 0x49, 0x43, 0x9f,
-	0xa3, 0xe3, 0x8c, 0xa6, 0x94, 0xf9, 0xb1, 0xd9, 0x01, 0xd7, 0x38, 0xe6, 0x31, 0x52, 0x84, 0x64,
-	0x60, 0x0e, 0x80, 0x11, 0x22, 0x06, 0x33, 0x9c, 0x72, 0x4c, 0x89, 0xd0, 0xdf, 0xf2, 0x96, 0x53,
-	0xe6, 0x26, 0xb8, 0xc9, 0xf2, 0xe0, 0x15, 0x82, 0x7c, 0xb2, 0x10, 0xb5, 0x2a, 0x70, 0x6d, 0x55,
-	0x38, 0xa8, 0xb4, 0x6d, 0x83, 0x0e, 0xcb, 0x03, 0xc6, 0x31, 0xcf, 0x39, 0x5a, 0x82, 0x37, 0x04,
-	0xdc, 0x5c, 0xd4, 0xaa, 0x1b, 0x7b, 0xf6, 0x9b, 0xf3, 0xbe, 0xf6, 0xf1, 0xc3, 0x56, 0x4f, 0xf9,
-	0x2b, 0xa2, 0x85, 0xa3, 0xec, 0x58, 0x4a, 0xe7, 0x88, 0x70, 0xfb, 0x9b, 0x0e, 0xda, 0x27, 0xd2,
-	0x9a, 0xff, 0xad, 0xe6, 0x11, 0x68, 0xa4, 0xb1, 0x4f, 0x84, 0x00, 0x63, 0x7c, 0xd7, 0x51, 0x63,
-	0x2b, 0xe7, 0x57, 0xa3, 0x8f, 0x63, 0x9f, 0xa8, 0x0d, 0x0b, 0xbc, 0x79, 0x08, 0x36, 0x14, 0x26,
-	0x9c, 0xd4, 0x4c, 0xdc, 0xb8, 0xc2, 0x31, 0xb7, 0xaa, 0x2b, 0x4b, 0x4f, 0x64, 0x6f, 0xb3, 0x54,
-	0xfc, 0xfe, 0xbc, 0xaf, 0x7d, 0x3d, 0xef, 0xeb, 0x7f, 0x51, 0x1e, 0x82, 0xa6, 0x72, 0xf9, 0x7d,
-	0xd0, 0xce, 0x50, 0x81, 0x19, 0xa6, 0x64, 0x42, 0xf2, 0x24, 0x40, 0x99, 0x50, 0xde, 0xf0, 0xd6,
-	0xab, 0xf4, 0x53, 0x91, 0xad, 0x01, 0xd5, 0xbb, 0x58, 0xa9, 0x03, 0x65, 0xc7, 0xbd, 0xb5, 0x8a,
-	0x87, 0x3d, 0x02, 0xcd, 0x63, 0x3f, 0xf3, 0x13, 0x56, 0x5e, 0xf6, 0xe3, 0x98, 0xbe, 0xfe, 0x21,
-	0x92, 0x75, 0xf5, 0xc1, 0xea, 0xb0, 0xe5, 0xad, 0xab, 0xb4, 0x14, 0xc2, 0xf6, 0xbd, 0x8b, 0x99,
-	0xa5, 0x5f, 0xce, 0x2c, 0xfd, 0xcb, 0xcc, 0xd2, 0xdf, 0xce, 0x2d, 0xed, 0x72, 0x6e, 0x69, 0x9f,
-	0xe6, 0x96, 0xf6, 0x62, 0x37, 0xc2, 0x7c, 0x9a, 0x07, 0x0e, 0xa4, 0x89, 0xfa, 0x33, 0x5c, 0x1c,
-	0xc0, 0xad, 0x88, 0xba, 0xc5, 0x8e, 0x9b, 0xd0, 0x30, 0x8f, 0x11, 0x93, 0x3f, 0xdc, 0xf6, 0x78,
-	0x4b, 0x7d, 0x72, 0xfc, 0x2c, 0x45, 0x2c, 0x68, 0x8a, 0xdd, 0x3d, 0xfc, 0x1e, 0x00, 0x00, 0xff,
-	0xff, 0x80, 0xfe, 0x7c, 0xd0, 0x04, 0x05, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x9b, 0x34, 0x34, 0x13, 0xd4, 0xc0, 0x34, 0x81, 0x34, 0xad, 0x92, 0x28, 0x42, 0x22,
+	0xaa, 0xa8, 0xdd, 0x04, 0x44, 0x7f, 0xa4, 0x22, 0xd1, 0x2c, 0x9a, 0x4a, 0x08, 0x22, 0xa3, 0x0a,
+	0x09, 0x09, 0x59, 0x63, 0x7b, 0x62, 0x0f, 0x75, 0x3c, 0x96, 0x67, 0x1c, 0x94, 0x37, 0x60, 0x89,
+	0xc4, 0x86, 0x65, 0x79, 0x07, 0x1e, 0xa2, 0x62, 0xd5, 0x25, 0x2b, 0x84, 0xda, 0x0d, 0x6f, 0xc0,
+	0x16, 0x79, 0x66, 0x7c, 0x13, 0xe7, 0xf6, 0xf6, 0x5e, 0xe9, 0xee, 0x66, 0xce, 0xf9, 0xbe, 0x73,
+	0xbe, 0x6f, 0xe6, 0x8c, 0x0d, 0x3a, 0xc4, 0x76, 0x0c, 0x87, 0xc6, 0xd8, 0x70, 0x02, 0x82, 0x43,
+	0x6e, 0xcc, 0x07, 0x6a, 0xa5, 0x47, 0x31, 0xe5, 0x14, 0x42, 0x62, 0x3b, 0x7a, 0x0a, 0xd0, 0x55,
+	0x78, 0x3e, 0x68, 0xd5, 0x3d, 0xea, 0x51, 0x91, 0x36, 0xd2, 0x95, 0x44, 0xb6, 0x76, 0x3d, 0x4a,
+	0xbd, 0x00, 0x1b, 0x62, 0x67, 0x27, 0x53, 0x03, 0x85, 0x0b, 0x95, 0xfa, 0xc8, 0xa1, 0x6c, 0x46,
+	0x99, 0x91, 0x44, 0x5e, 0x8c, 0x5c, 0x6c, 0xcc, 0x07, 0x36, 0xe6, 0x68, 0x90, 0xed, 0xb3, 0x02,
+	0x12, 0x65, 0xc9, 0xca, 0x72, 0x23, 0x53, 0xbd, 0x19, 0x68, 0x5c, 0xb9, 0x38, 0xe4, 0x64, 0x4a,
+	0xb0, 0x3b, 0x12, 0x42, 0xbe, 0xe5, 0x88, 0x63, 0xb8, 0x07, 0x2a, 0x52, 0x97, 0x45, 0xdc, 0xa6,
+	0xd6, 0xd5, 0xfa, 0x15, 0x73, 0x4b, 0x06, 0xae, 0x5c, 0x78, 0x0c, 0xde, 0x55, 0x49, 0x96, 0x82,
+	0x9b, 0x1b, 0x5d, 0xad, 0x5f, 0x1d, 0xd6, 0x75, 0x29, 0x54, 0xcf, 0x84, 0xea, 0x5f, 0x86, 0x0b,
+	0xb3, 0xea, 0x2c, 0xab, 0xf6, 0x7e, 0xd5, 0x40, 0x73, 0x44, 0x43, 0x86, 0x43, 0x96, 0x30, 0x11,
+	0xfa, 0x8e, 0x70, 0x7f, 0x8c, 0x89, 0xe7, 0x73, 0x78, 0x02, 0xca, 0xbe, 0x58, 0x89, 0x7e, 0xd5,
+	0x61, 0x4b, 0x7f, 0xf9, 0x88, 0x74, 0x89, 0xbd, 0x28, 0xdd, 0xfd, 0xdd, 0x29, 0x98, 0x0a, 0x0f,
+	0xcf, 0x41, 0xcd, 0xc9, 0xaa, 0xbe, 0x81, 0xa4, 0x6d, 0x27, 0x27, 0x21, 0x55, 0xd5, 0x90, 0xde,
+	0xf3, 0xda, 0xd8, 0xf3, 0xa7, 0xf0, 0x03, 0x78, 0x6f, 0xad, 0x2b, 0x6b, 0x6e, 0x74, 0x8b, 0xfd,
+	0xea, 0xf0, 0x93, 0xa7, 0x94, 0xbf, 0xca, 0xb7, 0xf2, 0x52, 0xcb, 0x8b, 0x62, 0xbd, 0x7b, 0x0d,
+	0xd4, 0xa5, 0xaa, 0xeb, 0xc8, 0x45, 0x1c, 0x4f, 0x62, 0x1a, 0x51, 0x86, 0x02, 0x58, 0x07, 0x9b,
+	0x9c, 0xf0, 0x00, 0x2b, 0x41, 0x72, 0x03, 0xbb, 0xa0, 0xea, 0x62, 0xe6, 0xc4, 0x24, 0xe2, 0x84,
+	0x86, 0xc2, 0x7f, 0xc5, 0x5c, 0x0d, 0xc1, 0x03, 0xf0, 0x3e, 0x4b, 0xec, 0x1f, 0xb1, 0xc3, 0xad,
+	0xa5, 0xa9, 0xa2, 0xc0, 0xd5, 0x54, 0x62, 0x94, 0x79, 0x3b, 0x02, 0x75, 0x96, 0xd8, 0x8c, 0x13,
+	0x9e, 0x70, 0xbc, 0x02, 0x2f, 0x09, 0x38, 0x5c, 0xe6, 0x32, 0xc6, 0x59, 0xef, 0xe7, 0xdb, 0x4e,
+	0xe1, 0xcf, 0x3f, 0x0e, 0x5b, 0x6a, 0xbe, 0x3c, 0x3a, 0xd7, 0xd5, 0x38, 0xa6, 0xd6, 0x39, 0x0e,
+	0x79, 0xef, 0x3f, 0x0d, 0xd4, 0xae, 0xe5, 0x68, 0xbe, 0xb5, 0x9b, 0xcf, 0x41, 0x29, 0x0a, 0x50,
+	0x28, 0x0c, 0x54, 0x87, 0xfb, 0xba, 0x6a, 0x9b, 0x4d, 0x7e, 0xd6, 0x7a, 0x12, 0xa0, 0x50, 0x9d,
+	0xb0, 0xc0, 0xc3, 0x31, 0x68, 0x28, 0x8c, 0x6b, 0xe5, 0x86, 0xb8, 0xf4, 0xcc, 0xc4, 0xec, 0x64,
+	0x94, 0x95, 0x27, 0x72, 0x76, 0x90, 0x3a, 0xfe, 0xed, 0xb6, 0x53, 0xf8, 0xf7, 0xb6, 0xa3, 0xbd,
+	0xc6, 0xb9, 0x0b, 0xca, 0x6a, 0xca, 0x3f, 0x06, 0xb5, 0x18, 0xcf, 0x09, 0x23, 0x34, 0xb4, 0xc2,
+	0x64, 0x66, 0xe3, 0x58, 0x38, 0x2f, 0x99, 0xdb, 0x59, 0xf8, 0x6b, 0x11, 0xcd, 0x01, 0xd5, 0xbb,
+	0xd8, 0xc8, 0x03, 0x65, 0xc5, 0xb3, 0xad, 0x4c, 0x47, 0xef, 0xf7, 0x22, 0x28, 0x4f, 0x50, 0x8c,
+	0x66, 0x2c, 0x65, 0xa3, 0x20, 0xa0, 0x3f, 0xbd, 0x70, 0xc9, 0x9a, 0x5a, 0xb7, 0xd8, 0xaf, 0x98,
+	0xdb, 0x2a, 0x2c, 0x9d, 0x30, 0x78, 0x0e, 0xf6, 0xd6, 0xa6, 0xd8, 0x8a, 0xe2, 0x24, 0xc4, 0x56,
+	0x40, 0x66, 0x24, 0x6b, 0xd9, 0xcc, 0x0f, 0xe7, 0x24, 0x05, 0x7c, 0x95, 0xe6, 0xe1, 0x08, 0xb4,
+	0x19, 0x0e, 0xa6, 0xd6, 0x7a, 0x8d, 0x18, 0xa7, 0xbe, 0x09, 0x95, 0x17, 0x54, 0x32, 0xf7, 0x52,
+	0x54, 0xfe, 0x09, 0x98, 0x19, 0x04, 0x7e, 0x06, 0x3e, 0x8c, 0x62, 0x4a, 0xa7, 0x96, 0x87, 0x98,
+	0xe5, 0x50, 0xc6, 0xad, 0x08, 0xc7, 0x96, 0xbd, 0x50, 0xb7, 0x52, 0x32, 0x77, 0x44, 0xfa, 0x12,
+	0xb1, 0x11, 0x65, 0x7c, 0x82, 0xe3, 0x8b, 0x05, 0xc7, 0xf0, 0x14, 0xb4, 0x9e, 0x60, 0xf9, 0x88,
+	0xf9, 0x16, 0x8d, 0x9a, 0x9b, 0x82, 0xd8, 0x58, 0x23, 0x8e, 0x11, 0xf3, 0xbf, 0x89, 0xe0, 0x29,
+	0xd8, 0x5d, 0xb9, 0xfb, 0x84, 0x59, 0x8e, 0x8f, 0x9d, 0x1b, 0x65, 0xb9, 0x2c, 0x98, 0x1f, 0x2c,
+	0xbf, 0x5b, 0x09, 0x1b, 0xa5, 0x69, 0x69, 0xf8, 0x0b, 0xb0, 0x9f, 0xa7, 0xfa, 0x94, 0xde, 0x08,
+	0x09, 0x92, 0xfd, 0x8e, 0x3a, 0xb0, 0x15, 0xf6, 0x98, 0xd2, 0x9b, 0x4b, 0xc4, 0x04, 0xff, 0xc2,
+	0xbc, 0x7b, 0x68, 0x6b, 0xf7, 0x0f, 0x6d, 0xed, 0x9f, 0x87, 0xb6, 0xf6, 0xcb, 0x63, 0xbb, 0x70,
+	0xff, 0xd8, 0x2e, 0xfc, 0xf5, 0xd8, 0x2e, 0x7c, 0x7f, 0xe2, 0x11, 0xee, 0x27, 0xb6, 0xee, 0xd0,
+	0x99, 0xfa, 0x48, 0x1b, 0xc4, 0x76, 0x0e, 0x3d, 0x6a, 0xcc, 0x8f, 0x8d, 0x19, 0x75, 0x93, 0x00,
+	0x33, 0xf9, 0x4b, 0x39, 0x1a, 0x1e, 0xaa, 0xbf, 0x0a, 0x5f, 0x44, 0x98, 0xd9, 0x65, 0x31, 0xac,
+	0x9f, 0xfe, 0x3f, 0x00, 0x0a, 0xe4, 0xe9, 0xbb, 0x75, 0x06, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -770,6 +841,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClientStatusHookGasLimit != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ClientStatusHookGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClientStatusCheckLimit != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ClientStatusCheckLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProofGasCostPerHashOp != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ProofGasCostPerHashOp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProofGasCostPerByte != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ProofGasCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SelfConsensusStateRetention != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.SelfConsensusStateRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusStatePruneLimit != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ConsensusStatePruneLimit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -919,6 +1020,24 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.ConsensusStatePruneLimit != 0 {
+		n += 1 + sovClient(uint64(m.ConsensusStatePruneLimit))
+	}
+	if m.SelfConsensusStateRetention != 0 {
+		n += 1 + sovClient(uint64(m.SelfConsensusStateRetention))
+	}
+	if m.ProofGasCostPerByte != 0 {
+		n += 1 + sovClient(uint64(m.ProofGasCostPerByte))
+	}
+	if m.ProofGasCostPerHashOp != 0 {
+		n += 1 + sovClient(uint64(m.ProofGasCostPerHashOp))
+	}
+	if m.ClientStatusCheckLimit != 0 {
+		n += 1 + sovClient(uint64(m.ClientStatusCheckLimit))
+	}
+	if m.ClientStatusHookGasLimit != 0 {
+		n += 1 + sovClient(uint64(m.ClientStatusHookGasLimit))
+	}
 	return n
 }
 
@@ -1791,6 +1910,120 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStatePruneLimit", wireType)
+			}
+			m.ConsensusStatePruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStatePruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfConsensusStateRetention", wireType)
+			}
+			m.SelfConsensusStateRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfConsensusStateRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofGasCostPerByte", wireType)
+			}
+			m.ProofGasCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofGasCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofGasCostPerHashOp", wireType)
+			}
+			m.ProofGasCostPerHashOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofGasCostPerHashOp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatusCheckLimit", wireType)
+			}
+			m.ClientStatusCheckLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientStatusCheckLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatusHookGasLimit", wireType)
+			}
+			m.ClientStatusHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientStatusHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
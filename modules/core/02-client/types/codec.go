@@ -49,6 +49,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpgradeClient{},
 		&MsgSubmitMisbehaviour{},
 		&MsgUpdateParams{},
+		&MsgRecoverClient{},
+		&MsgIBCSoftwareUpgrade{},
+		&MsgPruneConsensusStates{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ConsensusHost defines an interface which encapsulates the consensus of the host chain. It is used
+// during the connection handshake to validate the client of the host chain stored by the counterparty
+// and to retrieve the expected consensus states of the host chain.
+type ConsensusHost interface {
+	// GetSelfConsensusState returns the consensus state of the host chain at the given height.
+	GetSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error)
+
+	// ValidateSelfClient validates the client parameters for a client of the host chain.
+	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
+}
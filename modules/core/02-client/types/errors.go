@@ -36,4 +36,9 @@ var (
 	ErrClientNotActive                        = errorsmod.Register(SubModuleName, 29, "client state is not active")
 	ErrFailedMembershipVerification           = errorsmod.Register(SubModuleName, 30, "membership verification failed")
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
+	ErrInvalidRecoveryClient                  = errorsmod.Register(SubModuleName, 32, "invalid recovery client")
+	ErrConsensusStatePruningNotSupported      = errorsmod.Register(SubModuleName, 33, "consensus state pruning not supported")
+	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 34, "light client module route not found")
+	ErrBatchVerificationNotSupported          = errorsmod.Register(SubModuleName, 35, "batch membership verification not supported")
+	ErrInvalidDelayPeriod                     = errorsmod.Register(SubModuleName, 36, "delay period is less than the connection delay period")
 )
@@ -21,13 +21,15 @@ const (
 
 // IBC client events vars
 var (
-	EventTypeCreateClient          = "create_client"
-	EventTypeUpdateClient          = "update_client"
-	EventTypeUpgradeClient         = "upgrade_client"
-	EventTypeSubmitMisbehaviour    = "client_misbehaviour"
-	EventTypeUpdateClientProposal  = "update_client_proposal"
-	EventTypeUpgradeChain          = "upgrade_chain"
-	EventTypeUpgradeClientProposal = "upgrade_client_proposal"
+	EventTypeCreateClient               = "create_client"
+	EventTypeUpdateClient               = "update_client"
+	EventTypeUpgradeClient              = "upgrade_client"
+	EventTypeSubmitMisbehaviour         = "client_misbehaviour"
+	EventTypeUpdateClientProposal       = "update_client_proposal"
+	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeUpgradeClientProposal      = "upgrade_client_proposal"
+	EventTypeRecoverClient              = "recover_client"
+	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
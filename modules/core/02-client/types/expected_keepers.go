@@ -24,3 +24,8 @@ type UpgradeKeeper interface {
 	SetUpgradedConsensusState(ctx sdk.Context, planHeight int64, bz []byte) error
 	ScheduleUpgrade(ctx sdk.Context, plan upgradetypes.Plan) error
 }
+
+// ConnectionKeeper expected connection keeper
+type ConnectionKeeper interface {
+	GetClientDelayPeriods(ctx sdk.Context, clientID string) (timeDelay uint64, blockDelay uint64)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClientStatusHooks defines the hooks which modules may register with the 02-client keeper in order to be
+// notified when the status of a client moves to Expired or Frozen. Status changes are detected when a client
+// is updated or misbehaviour is submitted, and by the bounded end block check over all clients. Hooks are
+// called in a cached context limited to the client status hook gas limit, and panics of hooks are recovered.
+type ClientStatusHooks interface {
+	// OnClientExpired is called once the status of the client moves to Expired.
+	OnClientExpired(ctx sdk.Context, clientID string)
+	// OnClientFrozen is called once the status of the client moves to Frozen.
+	OnClientFrozen(ctx sdk.Context, clientID string)
+}
+
+var _ ClientStatusHooks = MultiClientStatusHooks{}
+
+// MultiClientStatusHooks combines multiple client status hooks, which are called in the order they are provided.
+type MultiClientStatusHooks []ClientStatusHooks
+
+// NewMultiClientStatusHooks returns the hooks combining the provided client status hooks.
+func NewMultiClientStatusHooks(hooks ...ClientStatusHooks) MultiClientStatusHooks {
+	return hooks
+}
+
+// OnClientExpired implements ClientStatusHooks.
+func (h MultiClientStatusHooks) OnClientExpired(ctx sdk.Context, clientID string) {
+	for _, hook := range h {
+		hook.OnClientExpired(ctx, clientID)
+	}
+}
+
+// OnClientFrozen implements ClientStatusHooks.
+func (h MultiClientStatusHooks) OnClientFrozen(ctx sdk.Context, clientID string) {
+	for _, hook := range h {
+		hook.OnClientFrozen(ctx, clientID)
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -28,8 +29,53 @@ const (
 
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
+
+	// KeyClientStatusPrefix is the key prefix used to store the last observed status of each client
+	// in the keeper.
+	KeyClientStatusPrefix = "clientStatus"
+
+	// KeyClientStatusCursor is the key used to store the identifier of the last client whose status was
+	// checked at the end of a block in the keeper.
+	KeyClientStatusCursor = "clientStatusCursor"
+
+	// KeyConsensusStatePruneCursor is the key used to store the identifier of the last client visited by the
+	// background pruning of expired consensus states in the keeper.
+	KeyConsensusStatePruneCursor = "consensusStatePruneCursor"
+
+	// KeySelfConsensusStatePrefix is the key prefix used to store the consensus states of the host chain
+	// in the keeper.
+	KeySelfConsensusStatePrefix = "selfConsensusStates"
 )
 
+// ClientStatusKey returns the store key under which the last observed status of a client is stored.
+func ClientStatusKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientStatusPrefix, clientID))
+}
+
+// SelfConsensusStatePrefixKey returns the store key prefix under which the consensus states of the host chain are stored.
+func SelfConsensusStatePrefixKey() []byte {
+	return []byte(fmt.Sprintf("%s/", KeySelfConsensusStatePrefix))
+}
+
+// SelfConsensusStateKey returns the store key under which the consensus state of the host chain at the given height
+// is stored. The height is big endian encoded so that the consensus states are iterated in ascending height order.
+func SelfConsensusStateKey(height exported.Height) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], height.GetRevisionNumber())
+	binary.BigEndian.PutUint64(bz[8:], height.GetRevisionHeight())
+
+	return append(SelfConsensusStatePrefixKey(), bz...)
+}
+
+// ParseSelfConsensusStateHeight parses the height from a self consensus state key with the key prefix removed.
+func ParseSelfConsensusStateHeight(key []byte) (Height, error) {
+	if len(key) != 16 {
+		return Height{}, errorsmod.Wrapf(ErrInvalidHeight, "self consensus state key must be 16 bytes, got %d", len(key))
+	}
+
+	return NewHeight(binary.BigEndian.Uint64(key[:8]), binary.BigEndian.Uint64(key[8:])), nil
+}
+
 // FormatClientIdentifier returns the client identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatClientIdentifier(clientType string, sequence uint64) string {
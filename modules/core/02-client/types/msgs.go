@@ -1,10 +1,13 @@
 package types
 
 import (
+	"reflect"
+
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
@@ -17,11 +20,15 @@ var (
 	_ sdk.Msg = (*MsgSubmitMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgUpgradeClient)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
+	_ sdk.Msg = (*MsgPruneConsensusStates)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitMisbehaviour)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpgradeClient)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgIBCSoftwareUpgrade)(nil)
 )
 
 // NewMsgCreateClient creates a new MsgCreateClient instance
@@ -283,3 +290,122 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgRecoverClient creates a new MsgRecoverClient instance
+func NewMsgRecoverClient(signer, subjectClientID, substituteClientID string) *MsgRecoverClient {
+	return &MsgRecoverClient{
+		Signer:             signer,
+		SubjectClientId:    subjectClientID,
+		SubstituteClientId: substituteClientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRecoverClient.
+func (msg *MsgRecoverClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubjectClientId); err != nil {
+		return err
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubstituteClientId); err != nil {
+		return err
+	}
+
+	if msg.SubjectClientId == msg.SubstituteClientId {
+		return errorsmod.Wrap(ErrInvalidSubstitute, "subject and substitute client identifiers are equal")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRecoverClient message.
+func (msg *MsgRecoverClient) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgIBCSoftwareUpgrade creates a new MsgIBCSoftwareUpgrade instance
+func NewMsgIBCSoftwareUpgrade(signer string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (*MsgIBCSoftwareUpgrade, error) {
+	anyClient, err := PackClientState(upgradedClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgIBCSoftwareUpgrade{
+		Signer:              signer,
+		Plan:                plan,
+		UpgradedClientState: anyClient,
+	}, nil
+}
+
+// ValidateBasic performs basic checks on a MsgIBCSoftwareUpgrade.
+func (msg *MsgIBCSoftwareUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.UpgradedClientState == nil {
+		return errorsmod.Wrap(ErrInvalidUpgradeClient, "upgraded client state cannot be nil")
+	}
+
+	clientState, err := UnpackClientState(msg.UpgradedClientState)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack upgraded client state")
+	}
+
+	if !reflect.DeepEqual(clientState, clientState.ZeroCustomFields()) {
+		return errorsmod.Wrap(ErrInvalidUpgradeClient, "upgraded client state is not zeroed out")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgIBCSoftwareUpgrade message.
+func (msg *MsgIBCSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgIBCSoftwareUpgrade) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(msg.UpgradedClientState, new(exported.ClientState))
+}
+
+// NewMsgPruneConsensusStates creates a new MsgPruneConsensusStates instance
+func NewMsgPruneConsensusStates(clientID, signer string) *MsgPruneConsensusStates {
+	return &MsgPruneConsensusStates{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneConsensusStates.
+func (msg *MsgPruneConsensusStates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSigners returns the expected signers for a MsgPruneConsensusStates message.
+func (msg *MsgPruneConsensusStates) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -678,3 +682,206 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgRecoverClientValidateBasic tests ValidateBasic for MsgRecoverClient
+func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
+	var msg *types.MsgRecoverClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifiers",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid subject client ID",
+			func() {
+				msg.SubjectClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid substitute client ID",
+			func() {
+				msg.SubstituteClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: subject and substitute client IDs are the same",
+			func() {
+				msg.SubstituteClientId = ibctesting.FirstClientID
+			},
+			types.ErrInvalidSubstitute,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRecoverClient(
+			suite.chainA.App.GetIBCKeeper().GetAuthority(),
+			ibctesting.FirstClientID,
+			ibctesting.SecondClientID,
+		)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expError == nil {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+// TestMsgRecoverClientGetSigners tests GetSigners for MsgRecoverClient
+func TestMsgRecoverClientGetSigners(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expPass bool
+	}{
+		{"success: valid address", sdk.AccAddress(ibctesting.TestAccAddress), true},
+		{"failure: nil address", nil, false},
+	}
+
+	for _, tc := range testCases {
+		msg := types.MsgRecoverClient{
+			Signer:             tc.address.String(),
+			SubjectClientId:    ibctesting.FirstClientID,
+			SubstituteClientId: ibctesting.SecondClientID,
+		}
+		if tc.expPass {
+			require.Equal(t, []sdk.AccAddress{tc.address}, msg.GetSigners())
+		} else {
+			require.Panics(t, func() {
+				msg.GetSigners()
+			})
+		}
+	}
+}
+
+// TestMsgPruneConsensusStatesValidateBasic tests ValidateBasic for MsgPruneConsensusStates
+func (suite *TypesTestSuite) TestMsgPruneConsensusStatesValidateBasic() {
+	var msg *types.MsgPruneConsensusStates
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgPruneConsensusStates(ibctesting.FirstClientID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expError == nil {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+// TestMsgIBCSoftwareUpgradeValidateBasic tests ValidateBasic for MsgIBCSoftwareUpgrade
+func (suite *TypesTestSuite) TestMsgIBCSoftwareUpgradeValidateBasic() {
+	var msg *types.MsgIBCSoftwareUpgrade
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer, plan and upgraded client state",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid plan",
+			func() {
+				msg.Plan.Height = 0
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: nil upgraded client state",
+			func() {
+				msg.UpgradedClientState = nil
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+		{
+			"failure: upgraded client state is not zeroed out",
+			func() {
+				clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+
+				var err error
+				msg.UpgradedClientState, err = types.PackClientState(clientState)
+				suite.Require().NoError(err)
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+		plan := upgradetypes.Plan{
+			Name:   "upgrade IBC clients",
+			Height: 1000,
+		}
+
+		var err error
+		msg, err = types.NewMsgIBCSoftwareUpgrade(suite.chainA.App.GetIBCKeeper().GetAuthority(), plan, clientState.ZeroCustomFields())
+		suite.Require().NoError(err)
+
+		tc.malleate()
+
+		err = msg.ValidateBasic()
+		if tc.expError == nil {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
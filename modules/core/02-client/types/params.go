@@ -10,6 +10,29 @@ import (
 // DefaultAllowedClients are the default clients for the AllowedClients parameter.
 var DefaultAllowedClients = []string{exported.Solomachine, exported.Tendermint, exported.Localhost}
 
+// DefaultConsensusStatePruneLimit is the default limit of expired consensus states pruned at the beginning
+// of each block. Background pruning is disabled by default.
+const DefaultConsensusStatePruneLimit = uint64(0)
+
+// DefaultSelfConsensusStateRetention is the default number of blocks for which the consensus state of the host chain
+// is stored. The history of self consensus states is disabled by default.
+const DefaultSelfConsensusStateRetention = uint64(0)
+
+// DefaultProofGasCostPerByte is the default gas consumed per byte of a proof before it is verified.
+const DefaultProofGasCostPerByte = uint64(1)
+
+// DefaultProofGasCostPerHashOp is the default gas consumed per hash operation of a merkle proof. It is calibrated
+// with the proof verification benchmarks of 23-commitment, which take roughly a microsecond per hash operation.
+const DefaultProofGasCostPerHashOp = uint64(20)
+
+// DefaultClientStatusCheckLimit is the default maximum number of clients whose status is checked at the end
+// of each block. A limit of 0 disables the client status check.
+const DefaultClientStatusCheckLimit = uint64(100)
+
+// DefaultClientStatusHookGasLimit is the default gas limit of each call to the client status hooks. A gas limit
+// of 0 disables the client status hooks.
+const DefaultClientStatusHookGasLimit = uint64(1_000_000)
+
 // NewParams creates a new parameter configuration for the ibc client module
 func NewParams(allowedClients ...string) Params {
 	return Params{
@@ -19,7 +42,14 @@ func NewParams(allowedClients ...string) Params {
 
 // DefaultParams is the default parameter configuration for the ibc-client module.
 func DefaultParams() Params {
-	return NewParams(DefaultAllowedClients...)
+	params := NewParams(DefaultAllowedClients...)
+	params.ConsensusStatePruneLimit = DefaultConsensusStatePruneLimit
+	params.SelfConsensusStateRetention = DefaultSelfConsensusStateRetention
+	params.ProofGasCostPerByte = DefaultProofGasCostPerByte
+	params.ProofGasCostPerHashOp = DefaultProofGasCostPerHashOp
+	params.ClientStatusCheckLimit = DefaultClientStatusCheckLimit
+	params.ClientStatusHookGasLimit = DefaultClientStatusHookGasLimit
+	return params
 }
 
 // Validate all ibc-client module parameters
@@ -11,19 +11,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -705,6 +710,285 @@ func (m *QueryClientStatusResponse) GetStatus() string {
 	return ""
 }
 
+// QueryClientStatusesRequest is the request type for the Query/ClientStatuses RPC
+// method
+type QueryClientStatusesRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClientStatusesRequest) Reset()         { *m = QueryClientStatusesRequest{} }
+func (m *QueryClientStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStatusesRequest) ProtoMessage()    {}
+func (*QueryClientStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+}
+func (m *QueryClientStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStatusesRequest.Merge(m, src)
+}
+func (m *QueryClientStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientStatusesRequest proto.InternalMessageInfo
+
+func (m *QueryClientStatusesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryClientStatusesResponse is the response type for the Query/ClientStatuses RPC
+// method.
+type QueryClientStatusesResponse struct {
+	// list of client statuses
+	ClientStatuses []IdentifiedClientStatus `protobuf:"bytes,1,rep,name=client_statuses,json=clientStatuses,proto3" json:"client_statuses"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClientStatusesResponse) Reset()         { *m = QueryClientStatusesResponse{} }
+func (m *QueryClientStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStatusesResponse) ProtoMessage()    {}
+func (*QueryClientStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+}
+func (m *QueryClientStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStatusesResponse.Merge(m, src)
+}
+func (m *QueryClientStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryClientStatusesResponse) GetClientStatuses() []IdentifiedClientStatus {
+	if m != nil {
+		return m.ClientStatuses
+	}
+	return nil
+}
+
+func (m *QueryClientStatusesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// IdentifiedClientStatus defines the status of a client along with the time left until it expires.
+type IdentifiedClientStatus struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// client status
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// time left until the client expires, zero if the client is not active or does not expire
+	TimeUntilExpiry time.Duration `protobuf:"bytes,3,opt,name=time_until_expiry,json=timeUntilExpiry,proto3,stdduration" json:"time_until_expiry"`
+	// timestamp in nanoseconds of the latest consensus state of the client, zero if it is not found
+	LatestConsensusTimestamp uint64 `protobuf:"varint,4,opt,name=latest_consensus_timestamp,json=latestConsensusTimestamp,proto3" json:"latest_consensus_timestamp,omitempty"`
+}
+
+func (m *IdentifiedClientStatus) Reset()         { *m = IdentifiedClientStatus{} }
+func (m *IdentifiedClientStatus) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientStatus) ProtoMessage()    {}
+func (*IdentifiedClientStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+}
+func (m *IdentifiedClientStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedClientStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientStatus.Merge(m, src)
+}
+func (m *IdentifiedClientStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientStatus proto.InternalMessageInfo
+
+func (m *IdentifiedClientStatus) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *IdentifiedClientStatus) GetTimeUntilExpiry() time.Duration {
+	if m != nil {
+		return m.TimeUntilExpiry
+	}
+	return 0
+}
+
+func (m *IdentifiedClientStatus) GetLatestConsensusTimestamp() uint64 {
+	if m != nil {
+		return m.LatestConsensusTimestamp
+	}
+	return 0
+}
+
+// QuerySelfConsensusStatesRequest is the request type for the Query/SelfConsensusStates RPC
+// method
+type QuerySelfConsensusStatesRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySelfConsensusStatesRequest) Reset()         { *m = QuerySelfConsensusStatesRequest{} }
+func (m *QuerySelfConsensusStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfConsensusStatesRequest) ProtoMessage()    {}
+func (*QuerySelfConsensusStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+}
+func (m *QuerySelfConsensusStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfConsensusStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfConsensusStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySelfConsensusStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfConsensusStatesRequest.Merge(m, src)
+}
+func (m *QuerySelfConsensusStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfConsensusStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfConsensusStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelfConsensusStatesRequest proto.InternalMessageInfo
+
+func (m *QuerySelfConsensusStatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySelfConsensusStatesResponse is the response type for the Query/SelfConsensusStates RPC
+// method.
+type QuerySelfConsensusStatesResponse struct {
+	// consensus states of the host chain along with their heights
+	ConsensusStates []ConsensusStateWithHeight `protobuf:"bytes,1,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySelfConsensusStatesResponse) Reset()         { *m = QuerySelfConsensusStatesResponse{} }
+func (m *QuerySelfConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfConsensusStatesResponse) ProtoMessage()    {}
+func (*QuerySelfConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+}
+func (m *QuerySelfConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySelfConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfConsensusStatesResponse.Merge(m, src)
+}
+func (m *QuerySelfConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelfConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *QuerySelfConsensusStatesResponse) GetConsensusStates() []ConsensusStateWithHeight {
+	if m != nil {
+		return m.ConsensusStates
+	}
+	return nil
+}
+
+func (m *QuerySelfConsensusStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryClientParamsRequest is the request type for the Query/ClientParams RPC
 // method.
 type QueryClientParamsRequest struct {
@@ -714,7 +998,7 @@ func (m *QueryClientParamsRequest) Reset()         { *m = QueryClientParamsReque
 func (m *QueryClientParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsRequest) ProtoMessage()    {}
 func (*QueryClientParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
 }
 func (m *QueryClientParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1038,7 @@ func (m *QueryClientParamsResponse) Reset()         { *m = QueryClientParamsResp
 func (m *QueryClientParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsResponse) ProtoMessage()    {}
 func (*QueryClientParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
 }
 func (m *QueryClientParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1083,7 @@ func (m *QueryUpgradedClientStateRequest) Reset()         { *m = QueryUpgradedCl
 func (m *QueryUpgradedClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
 }
 func (m *QueryUpgradedClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,7 +1123,7 @@ func (m *QueryUpgradedClientStateResponse) Reset()         { *m = QueryUpgradedC
 func (m *QueryUpgradedClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{20}
 }
 func (m *QueryUpgradedClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1168,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{21}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1208,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{22}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,117 +1244,439 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() *types
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
-	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
-	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.core.client.v1.QueryClientStatesRequest")
-	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.core.client.v1.QueryClientStatesResponse")
-	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.core.client.v1.QueryConsensusStateRequest")
-	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.core.client.v1.QueryConsensusStateResponse")
-	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.core.client.v1.QueryConsensusStatesRequest")
-	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryConsensusStatesResponse")
-	proto.RegisterType((*QueryConsensusStateHeightsRequest)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsRequest")
-	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
-	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
-	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
-	proto.RegisterType((*QueryClientParamsRequest)(nil), "ibc.core.client.v1.QueryClientParamsRequest")
-	proto.RegisterType((*QueryClientParamsResponse)(nil), "ibc.core.client.v1.QueryClientParamsResponse")
-	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
-	proto.RegisterType((*QueryUpgradedClientStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedClientStateResponse")
-	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateRequest")
-	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
+// QueryVerifyMembershipRequest is the request type for the Query/VerifyMembership RPC method
+type QueryVerifyMembershipRequest struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the proof to be verified by the client.
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the height of the commitment root at which the proof is verified.
+	ProofHeight Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the commitment key path.
+	MerklePath types1.MerklePath `protobuf:"bytes,4,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path"`
+	// the value which is proven.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// optional time delay, such as the delay period of the connection associated with the client.
+	TimeDelay uint64 `protobuf:"varint,6,opt,name=time_delay,json=timeDelay,proto3" json:"time_delay,omitempty"`
+	// optional block delay, such as the block delay of the connection associated with the client.
+	BlockDelay uint64 `protobuf:"varint,7,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty"`
+}
+
+func (m *QueryVerifyMembershipRequest) Reset()         { *m = QueryVerifyMembershipRequest{} }
+func (m *QueryVerifyMembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMembershipRequest) ProtoMessage()    {}
+func (*QueryVerifyMembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{23}
+}
+func (m *QueryVerifyMembershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMembershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMembershipRequest.Merge(m, src)
+}
+func (m *QueryVerifyMembershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMembershipRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
+var xxx_messageInfo_QueryVerifyMembershipRequest proto.InternalMessageInfo
 
-var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1051 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xa4, 0x69, 0xd4, 0x3e, 0xbb, 0x09, 0x9a, 0x26, 0xa9, 0xbb, 0x8d, 0x1c, 0x67, 0x83,
-	0x68, 0x5a, 0x92, 0x9d, 0xc4, 0xa1, 0x49, 0x84, 0x84, 0x04, 0xa9, 0x54, 0xda, 0x4b, 0x29, 0x8b,
-	0x10, 0x08, 0x09, 0x45, 0xbb, 0xeb, 0xc9, 0x66, 0x25, 0x7b, 0xc7, 0xf5, 0xec, 0x5a, 0x8a, 0xaa,
-	0x5c, 0x7a, 0xe2, 0x06, 0x12, 0x12, 0x57, 0x24, 0x8e, 0x1c, 0x2a, 0x0e, 0x48, 0x5c, 0x39, 0x41,
-	0x8e, 0x45, 0x70, 0xe0, 0x44, 0x51, 0xc2, 0x1f, 0x82, 0x3c, 0x33, 0x6b, 0xef, 0xda, 0xe3, 0x7a,
-	0x8d, 0x42, 0x6f, 0xbb, 0xef, 0xe7, 0xf7, 0xbe, 0xf7, 0xfc, 0xde, 0x1a, 0xca, 0x81, 0xeb, 0x11,
-	0x8f, 0xb5, 0x28, 0xf1, 0xea, 0x01, 0x0d, 0x23, 0xd2, 0xde, 0x24, 0x8f, 0x63, 0xda, 0x3a, 0xb2,
-	0x9a, 0x2d, 0x16, 0x31, 0x8c, 0x03, 0xd7, 0xb3, 0x3a, 0x7a, 0x4b, 0xea, 0xad, 0xf6, 0xa6, 0x71,
-	0xdb, 0x63, 0xbc, 0xc1, 0x38, 0x71, 0x1d, 0x4e, 0xa5, 0x31, 0x69, 0x6f, 0xba, 0x34, 0x72, 0x36,
-	0x49, 0xd3, 0xf1, 0x83, 0xd0, 0x89, 0x02, 0x16, 0x4a, 0x7f, 0x63, 0x49, 0x13, 0x5f, 0x45, 0x92,
-	0x06, 0xd7, 0x7d, 0xc6, 0xfc, 0x3a, 0x25, 0xe2, 0xcd, 0x8d, 0x0f, 0x88, 0x13, 0xaa, 0xdc, 0xc6,
-	0xa2, 0x52, 0x39, 0xcd, 0x80, 0x38, 0x61, 0xc8, 0x22, 0x11, 0x98, 0x2b, 0xed, 0x9c, 0xcf, 0x7c,
-	0x26, 0x1e, 0x49, 0xe7, 0x49, 0x4a, 0xcd, 0x6d, 0xb8, 0xf6, 0x61, 0x07, 0xd1, 0x5d, 0x91, 0xe3,
-	0xa3, 0xc8, 0x89, 0xa8, 0x4d, 0x1f, 0xc7, 0x94, 0x47, 0xf8, 0x06, 0x5c, 0x96, 0x99, 0xf7, 0x83,
-	0x5a, 0x09, 0x55, 0xd0, 0xea, 0x65, 0xfb, 0x92, 0x14, 0x3c, 0xa8, 0x99, 0xcf, 0x10, 0x94, 0x06,
-	0x1d, 0x79, 0x93, 0x85, 0x9c, 0xe2, 0x1d, 0x28, 0x2a, 0x4f, 0xde, 0x91, 0x0b, 0xe7, 0x42, 0x75,
-	0xce, 0x92, 0xf8, 0xac, 0x04, 0xba, 0xf5, 0x5e, 0x78, 0x64, 0x17, 0xbc, 0x5e, 0x00, 0x3c, 0x07,
-	0x17, 0x9b, 0x2d, 0xc6, 0x0e, 0x4a, 0x93, 0x15, 0xb4, 0x5a, 0xb4, 0xe5, 0x0b, 0xbe, 0x0b, 0x45,
-	0xf1, 0xb0, 0x7f, 0x48, 0x03, 0xff, 0x30, 0x2a, 0x5d, 0x10, 0xe1, 0x0c, 0x6b, 0x90, 0x6a, 0xeb,
-	0xbe, 0xb0, 0xd8, 0x9b, 0x3a, 0xf9, 0x6b, 0x69, 0xc2, 0x2e, 0x08, 0x2f, 0x29, 0x32, 0xdd, 0x41,
-	0xbc, 0x3c, 0xa9, 0xf4, 0x1e, 0x40, 0xaf, 0x11, 0x0a, 0xed, 0x1b, 0x96, 0xec, 0x9a, 0xd5, 0xe9,
-	0x9a, 0x25, 0x5b, 0xac, 0xba, 0x66, 0x3d, 0x72, 0xfc, 0x84, 0x25, 0x3b, 0xe5, 0x69, 0xfe, 0x81,
-	0xe0, 0xba, 0x26, 0x89, 0x62, 0x25, 0x84, 0x2b, 0x69, 0x56, 0x78, 0x09, 0x55, 0x2e, 0xac, 0x16,
-	0xaa, 0xb7, 0x74, 0x75, 0x3c, 0xa8, 0xd1, 0x30, 0x0a, 0x0e, 0x02, 0x5a, 0x4b, 0x85, 0xda, 0x2b,
-	0x77, 0xca, 0xfa, 0xfe, 0xc5, 0xd2, 0x82, 0x56, 0xcd, 0xed, 0x62, 0x8a, 0x4b, 0x8e, 0xdf, 0xcf,
-	0x54, 0x35, 0x29, 0xaa, 0xba, 0x39, 0xb2, 0x2a, 0x09, 0x36, 0x53, 0xd6, 0x0f, 0x08, 0x0c, 0x59,
-	0x56, 0x47, 0x15, 0xf2, 0x98, 0xe7, 0x9e, 0x13, 0x7c, 0x13, 0x66, 0x5b, 0xb4, 0x1d, 0xf0, 0x80,
-	0x85, 0xfb, 0x61, 0xdc, 0x70, 0x69, 0x4b, 0x20, 0x99, 0xb2, 0x67, 0x12, 0xf1, 0x43, 0x21, 0xcd,
-	0x18, 0xa6, 0xfa, 0x9c, 0x32, 0x94, 0x8d, 0xc4, 0x2b, 0x70, 0xa5, 0xde, 0xa9, 0x2f, 0x4a, 0xcc,
-	0xa6, 0x2a, 0x68, 0xf5, 0x92, 0x5d, 0x94, 0x42, 0xd5, 0xed, 0x9f, 0x10, 0xdc, 0xd0, 0x42, 0x56,
-	0xbd, 0x78, 0x07, 0x66, 0xbd, 0x44, 0x93, 0x63, 0x48, 0x67, 0xbc, 0x4c, 0x98, 0xff, 0x73, 0x4e,
-	0x9f, 0xea, 0x91, 0xf3, 0x5c, 0x6c, 0xdf, 0xd3, 0xb4, 0xfc, 0xbf, 0x0c, 0xf2, 0x2f, 0x08, 0x16,
-	0xf5, 0x20, 0x14, 0x7f, 0x9f, 0xc3, 0x6b, 0x7d, 0xfc, 0x25, 0xe3, 0xbc, 0xa6, 0x2b, 0x37, 0x1b,
-	0xe6, 0x93, 0x20, 0x3a, 0xcc, 0x10, 0x30, 0x9b, 0xa5, 0xf7, 0x1c, 0x47, 0xf7, 0x0b, 0x04, 0xcb,
-	0x9a, 0x42, 0x64, 0xf6, 0x57, 0xcb, 0xe9, 0xaf, 0x08, 0xcc, 0x97, 0x41, 0x51, 0xcc, 0x7e, 0x0a,
-	0xd7, 0xfa, 0x98, 0x55, 0xe3, 0x94, 0x10, 0x3c, 0x7a, 0x9e, 0xe6, 0x3d, 0x5d, 0x86, 0xf3, 0x23,
-	0x75, 0x67, 0x60, 0x95, 0xc6, 0xb9, 0xa8, 0x34, 0xb7, 0x06, 0xd6, 0x63, 0xdc, 0x2b, 0x7c, 0x01,
-	0xa6, 0xb9, 0x90, 0x28, 0x37, 0xf5, 0x66, 0x1a, 0x99, 0x6c, 0x8f, 0x9c, 0x96, 0xd3, 0x48, 0xb2,
-	0x99, 0x1f, 0x64, 0x02, 0x26, 0x3a, 0x15, 0xb0, 0x0a, 0xd3, 0x4d, 0x21, 0x51, 0x3f, 0x6d, 0x2d,
-	0x71, 0xca, 0x47, 0x59, 0x9a, 0xcb, 0xb0, 0x24, 0x02, 0x7e, 0xdc, 0xf4, 0x5b, 0x4e, 0x2d, 0xb3,
-	0x5e, 0x93, 0x9c, 0x75, 0xa8, 0x0c, 0x37, 0x51, 0xa9, 0xef, 0xc3, 0x7c, 0xac, 0xd4, 0xfb, 0xb9,
-	0x2f, 0xe1, 0xd5, 0x78, 0x30, 0xa2, 0xf9, 0xba, 0x1a, 0x9a, 0x6e, 0x36, 0xdd, 0x0a, 0x36, 0x63,
-	0x58, 0x79, 0xa9, 0x95, 0x82, 0xf5, 0x10, 0x4a, 0x3d, 0x58, 0x63, 0xac, 0xbf, 0x85, 0x58, 0x1b,
-	0xb7, 0xfa, 0x5b, 0x11, 0x2e, 0x8a, 0xbc, 0xf8, 0x5b, 0x04, 0x85, 0x14, 0x6c, 0xfc, 0xa6, 0x8e,
-	0xeb, 0x21, 0x1f, 0x1a, 0xc6, 0x5a, 0x3e, 0x63, 0x59, 0x84, 0x79, 0xe7, 0xe9, 0xef, 0xff, 0x7c,
-	0x3d, 0x49, 0xf0, 0x3a, 0x19, 0xfa, 0xa9, 0xa4, 0x36, 0x12, 0x79, 0xd2, 0x1d, 0xc5, 0x63, 0xfc,
-	0x0d, 0x82, 0x62, 0xfa, 0x58, 0xe2, 0x5c, 0x59, 0x93, 0x49, 0x33, 0xd6, 0x73, 0x5a, 0x2b, 0x90,
-	0xb7, 0x04, 0xc8, 0x15, 0xbc, 0x3c, 0x12, 0x24, 0x7e, 0x81, 0x60, 0x26, 0xcb, 0x2b, 0xb6, 0x86,
-	0x27, 0xd3, 0xb5, 0xdf, 0x20, 0xb9, 0xed, 0x15, 0xbc, 0xba, 0x80, 0x77, 0x80, 0x6b, 0x5a, 0x78,
-	0x7d, 0x8b, 0x3d, 0x4d, 0x23, 0x49, 0x8e, 0x31, 0x79, 0xd2, 0x77, 0xd6, 0x8f, 0x89, 0x5c, 0x53,
-	0x29, 0x85, 0x14, 0x1c, 0xe3, 0x67, 0x08, 0x66, 0xfb, 0x0e, 0x09, 0xce, 0x0b, 0xb9, 0xdb, 0x80,
-	0x8d, 0xfc, 0x0e, 0xaa, 0xc8, 0x5d, 0x51, 0x64, 0x15, 0x6f, 0x8c, 0x5b, 0x24, 0x3e, 0x41, 0x30,
-	0xaf, 0xdd, 0xd2, 0xf8, 0x4e, 0x4e, 0x14, 0xd9, 0x03, 0x63, 0x6c, 0x8f, 0xeb, 0xa6, 0x4a, 0x78,
-	0x57, 0x94, 0xf0, 0x36, 0xde, 0x1d, 0xbb, 0x4f, 0xea, 0x66, 0xe0, 0xef, 0x32, 0x63, 0x1f, 0xe7,
-	0x1b, 0xfb, 0x78, 0xac, 0xb1, 0xef, 0xed, 0xf0, 0xdc, 0xbf, 0xcd, 0x38, 0xcb, 0xf7, 0x97, 0x5d,
-	0x90, 0x72, 0x1d, 0x8f, 0x04, 0x99, 0xb9, 0x02, 0x23, 0x41, 0x66, 0xef, 0x82, 0x69, 0x0a, 0x90,
-	0x8b, 0xd8, 0xd0, 0x81, 0x94, 0x77, 0x00, 0xff, 0x88, 0xe0, 0xaa, 0x66, 0xc1, 0xe3, 0xad, 0xa1,
-	0xa9, 0x86, 0x5f, 0x0c, 0xe3, 0xad, 0xf1, 0x9c, 0x14, 0xcc, 0xaa, 0x80, 0xb9, 0x86, 0x6f, 0xeb,
-	0x60, 0x6a, 0xaf, 0x0b, 0xc7, 0x3f, 0x23, 0x58, 0xd0, 0xdf, 0x00, 0xbc, 0x3d, 0x1a, 0x84, 0x76,
-	0xb7, 0xec, 0x8c, 0xed, 0x97, 0x67, 0x16, 0x86, 0x9d, 0x21, 0xbe, 0x67, 0x9f, 0x9c, 0x96, 0xd1,
-	0xf3, 0xd3, 0x32, 0xfa, 0xfb, 0xb4, 0x8c, 0xbe, 0x3a, 0x2b, 0x4f, 0x3c, 0x3f, 0x2b, 0x4f, 0xfc,
-	0x79, 0x56, 0x9e, 0xf8, 0x6c, 0xd7, 0x0f, 0xa2, 0xc3, 0xd8, 0xb5, 0x3c, 0xd6, 0x20, 0xea, 0x1f,
-	0x75, 0xe0, 0x7a, 0xeb, 0x3e, 0x23, 0xed, 0x1d, 0xd2, 0x60, 0xb5, 0xb8, 0x4e, 0xb9, 0xcc, 0xb3,
-	0x51, 0x5d, 0x57, 0xa9, 0xa2, 0xa3, 0x26, 0xe5, 0xee, 0xb4, 0xb8, 0x66, 0x5b, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0xd5, 0xf9, 0x22, 0x36, 0xbd, 0x0f, 0x00, 0x00,
+func (m *QueryVerifyMembershipRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryVerifyMembershipRequest) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryVerifyMembershipRequest) GetProofHeight() Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return Height{}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ClientState queries an IBC light client.
-	ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error)
-	// ClientStates queries all the IBC light clients of a chain.
-	ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error)
-	// ConsensusState queries a consensus state associated with a client state at
-	// a given height.
+func (m *QueryVerifyMembershipRequest) GetMerklePath() types1.MerklePath {
+	if m != nil {
+		return m.MerklePath
+	}
+	return types1.MerklePath{}
+}
+
+func (m *QueryVerifyMembershipRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryVerifyMembershipRequest) GetTimeDelay() uint64 {
+	if m != nil {
+		return m.TimeDelay
+	}
+	return 0
+}
+
+func (m *QueryVerifyMembershipRequest) GetBlockDelay() uint64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+// QueryVerifyMembershipResponse is the response type for the Query/VerifyMembership RPC method
+type QueryVerifyMembershipResponse struct {
+	// boolean indicating success or failure of proof verification.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *QueryVerifyMembershipResponse) Reset()         { *m = QueryVerifyMembershipResponse{} }
+func (m *QueryVerifyMembershipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMembershipResponse) ProtoMessage()    {}
+func (*QueryVerifyMembershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{24}
+}
+func (m *QueryVerifyMembershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMembershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMembershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMembershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMembershipResponse.Merge(m, src)
+}
+func (m *QueryVerifyMembershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMembershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMembershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyMembershipResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyMembershipResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+// QueryVerifyNonMembershipRequest is the request type for the Query/VerifyNonMembership RPC method
+type QueryVerifyNonMembershipRequest struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the proof to be verified by the client.
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the height of the commitment root at which the proof is verified.
+	ProofHeight Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the commitment key path.
+	MerklePath types1.MerklePath `protobuf:"bytes,4,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path"`
+	// optional time delay, such as the delay period of the connection associated with the client.
+	TimeDelay uint64 `protobuf:"varint,5,opt,name=time_delay,json=timeDelay,proto3" json:"time_delay,omitempty"`
+	// optional block delay, such as the block delay of the connection associated with the client.
+	BlockDelay uint64 `protobuf:"varint,6,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty"`
+}
+
+func (m *QueryVerifyNonMembershipRequest) Reset()         { *m = QueryVerifyNonMembershipRequest{} }
+func (m *QueryVerifyNonMembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyNonMembershipRequest) ProtoMessage()    {}
+func (*QueryVerifyNonMembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{25}
+}
+func (m *QueryVerifyNonMembershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyNonMembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyNonMembershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyNonMembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyNonMembershipRequest.Merge(m, src)
+}
+func (m *QueryVerifyNonMembershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyNonMembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyNonMembershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyNonMembershipRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyNonMembershipRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryVerifyNonMembershipRequest) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryVerifyNonMembershipRequest) GetProofHeight() Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return Height{}
+}
+
+func (m *QueryVerifyNonMembershipRequest) GetMerklePath() types1.MerklePath {
+	if m != nil {
+		return m.MerklePath
+	}
+	return types1.MerklePath{}
+}
+
+func (m *QueryVerifyNonMembershipRequest) GetTimeDelay() uint64 {
+	if m != nil {
+		return m.TimeDelay
+	}
+	return 0
+}
+
+func (m *QueryVerifyNonMembershipRequest) GetBlockDelay() uint64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+// QueryVerifyNonMembershipResponse is the response type for the Query/VerifyNonMembership RPC method
+type QueryVerifyNonMembershipResponse struct {
+	// boolean indicating success or failure of proof verification.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *QueryVerifyNonMembershipResponse) Reset()         { *m = QueryVerifyNonMembershipResponse{} }
+func (m *QueryVerifyNonMembershipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyNonMembershipResponse) ProtoMessage()    {}
+func (*QueryVerifyNonMembershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{26}
+}
+func (m *QueryVerifyNonMembershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyNonMembershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyNonMembershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyNonMembershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyNonMembershipResponse.Merge(m, src)
+}
+func (m *QueryVerifyNonMembershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyNonMembershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyNonMembershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyNonMembershipResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyNonMembershipResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
+	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
+	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.core.client.v1.QueryClientStatesRequest")
+	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.core.client.v1.QueryClientStatesResponse")
+	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.core.client.v1.QueryConsensusStateRequest")
+	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.core.client.v1.QueryConsensusStateResponse")
+	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.core.client.v1.QueryConsensusStatesRequest")
+	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryConsensusStatesResponse")
+	proto.RegisterType((*QueryConsensusStateHeightsRequest)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsRequest")
+	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
+	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
+	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
+	proto.RegisterType((*QueryClientStatusesRequest)(nil), "ibc.core.client.v1.QueryClientStatusesRequest")
+	proto.RegisterType((*QueryClientStatusesResponse)(nil), "ibc.core.client.v1.QueryClientStatusesResponse")
+	proto.RegisterType((*IdentifiedClientStatus)(nil), "ibc.core.client.v1.IdentifiedClientStatus")
+	proto.RegisterType((*QuerySelfConsensusStatesRequest)(nil), "ibc.core.client.v1.QuerySelfConsensusStatesRequest")
+	proto.RegisterType((*QuerySelfConsensusStatesResponse)(nil), "ibc.core.client.v1.QuerySelfConsensusStatesResponse")
+	proto.RegisterType((*QueryClientParamsRequest)(nil), "ibc.core.client.v1.QueryClientParamsRequest")
+	proto.RegisterType((*QueryClientParamsResponse)(nil), "ibc.core.client.v1.QueryClientParamsResponse")
+	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
+	proto.RegisterType((*QueryUpgradedClientStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedClientStateResponse")
+	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateRequest")
+	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryVerifyMembershipRequest)(nil), "ibc.core.client.v1.QueryVerifyMembershipRequest")
+	proto.RegisterType((*QueryVerifyMembershipResponse)(nil), "ibc.core.client.v1.QueryVerifyMembershipResponse")
+	proto.RegisterType((*QueryVerifyNonMembershipRequest)(nil), "ibc.core.client.v1.QueryVerifyNonMembershipRequest")
+	proto.RegisterType((*QueryVerifyNonMembershipResponse)(nil), "ibc.core.client.v1.QueryVerifyNonMembershipResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
+
+var fileDescriptor_dc42cdfd1d52d76e = []byte{
+	// 1522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x84, 0x24, 0x84, 0xe7, 0x90, 0xf0, 0x9d, 0x40, 0x70, 0x16, 0xb0, 0xcd, 0xe6, 0x4b,
+	0x49, 0x02, 0xd9, 0xc5, 0xe6, 0x47, 0x00, 0x51, 0xa9, 0x0d, 0x94, 0xc2, 0x81, 0x1f, 0x35, 0xa5,
+	0xbf, 0xa4, 0xca, 0x5a, 0xaf, 0xc7, 0xf6, 0x8a, 0xf5, 0xae, 0xf1, 0xec, 0x5a, 0x8d, 0x10, 0x17,
+	0x4e, 0xdc, 0x5a, 0xa9, 0x52, 0xdb, 0x53, 0x2b, 0x55, 0x3d, 0xf5, 0x80, 0x90, 0x5a, 0x89, 0x43,
+	0x2f, 0xed, 0xa5, 0x45, 0x3d, 0x21, 0xb5, 0x87, 0x1e, 0xaa, 0x52, 0x91, 0x4a, 0xfd, 0x37, 0xaa,
+	0x9d, 0x9d, 0xb5, 0x77, 0xed, 0xd9, 0x78, 0x5d, 0x85, 0x4a, 0xed, 0xcd, 0x3b, 0xef, 0xbd, 0x79,
+	0x9f, 0xf7, 0x79, 0x6f, 0xde, 0xbc, 0x91, 0x21, 0x63, 0x94, 0x75, 0x55, 0xb7, 0x5b, 0x44, 0xd5,
+	0x4d, 0x83, 0x58, 0x8e, 0xda, 0xce, 0xab, 0xb7, 0x5d, 0xd2, 0x5a, 0x57, 0x9a, 0x2d, 0xdb, 0xb1,
+	0x31, 0x36, 0xca, 0xba, 0xe2, 0xc9, 0x15, 0x5f, 0xae, 0xb4, 0xf3, 0xd2, 0xb2, 0x6e, 0xd3, 0x86,
+	0x4d, 0xd5, 0xb2, 0x46, 0x89, 0xaf, 0xac, 0xb6, 0xf3, 0x65, 0xe2, 0x68, 0x79, 0xb5, 0xa9, 0xd5,
+	0x0c, 0x4b, 0x73, 0x0c, 0xdb, 0xf2, 0xed, 0xa5, 0x7d, 0x5c, 0x37, 0x50, 0x0b, 0x6f, 0x2e, 0x65,
+	0x05, 0xce, 0xb9, 0x1b, 0x5f, 0xe1, 0x70, 0x57, 0xc1, 0x6e, 0x34, 0x0c, 0xa7, 0x11, 0x28, 0x75,
+	0xbe, 0xb8, 0xe2, 0x7c, 0xcd, 0xb6, 0x6b, 0x26, 0x51, 0xd9, 0x57, 0xd9, 0xad, 0xaa, 0x9a, 0x15,
+	0x38, 0xc9, 0xf4, 0x8a, 0x2a, 0x6e, 0x2b, 0x8c, 0x70, 0x3f, 0x97, 0x6b, 0x4d, 0x43, 0xd5, 0x2c,
+	0xcb, 0x76, 0x98, 0x90, 0x72, 0xe9, 0xee, 0x9a, 0x5d, 0xb3, 0xd9, 0x4f, 0xd5, 0xfb, 0xe5, 0xaf,
+	0xca, 0xa7, 0x60, 0xef, 0x6b, 0x5e, 0x1c, 0xe7, 0x19, 0xd8, 0x1b, 0x8e, 0xe6, 0x90, 0x22, 0xb9,
+	0xed, 0x12, 0xea, 0xe0, 0x7d, 0xb0, 0xc3, 0x0f, 0xa1, 0x64, 0x54, 0xd2, 0x28, 0x87, 0x16, 0x77,
+	0x14, 0x27, 0xfd, 0x85, 0xcb, 0x15, 0xf9, 0x01, 0x82, 0x74, 0xbf, 0x21, 0x6d, 0xda, 0x16, 0x25,
+	0x78, 0x15, 0xa6, 0xb8, 0x25, 0xf5, 0xd6, 0x99, 0x71, 0xaa, 0xb0, 0x5b, 0xf1, 0xf1, 0x29, 0x01,
+	0x7e, 0xe5, 0x65, 0x6b, 0xbd, 0x98, 0xd2, 0xbb, 0x1b, 0xe0, 0xdd, 0x30, 0xde, 0x6c, 0xd9, 0x76,
+	0x35, 0x3d, 0x9a, 0x43, 0x8b, 0x53, 0x45, 0xff, 0x03, 0x9f, 0x87, 0x29, 0xf6, 0xa3, 0x54, 0x27,
+	0x46, 0xad, 0xee, 0xa4, 0xb7, 0xb1, 0xed, 0x24, 0xa5, 0x3f, 0xa1, 0xca, 0x25, 0xa6, 0xb1, 0x36,
+	0xf6, 0xf8, 0xb7, 0xec, 0x48, 0x31, 0xc5, 0xac, 0xfc, 0x25, 0xb9, 0xdc, 0x8f, 0x97, 0x06, 0x91,
+	0x5e, 0x04, 0xe8, 0xa6, 0x9b, 0xa3, 0x7d, 0x41, 0xf1, 0xf3, 0xad, 0x78, 0xb5, 0xa1, 0xf8, 0xb9,
+	0xe6, 0xb5, 0xa1, 0x5c, 0xd7, 0x6a, 0x01, 0x4b, 0xc5, 0x90, 0xa5, 0xfc, 0x33, 0x82, 0x79, 0x81,
+	0x13, 0xce, 0x8a, 0x05, 0x3b, 0xc3, 0xac, 0xd0, 0x34, 0xca, 0x6d, 0x5b, 0x4c, 0x15, 0x96, 0x44,
+	0x71, 0x5c, 0xae, 0x10, 0xcb, 0x31, 0xaa, 0x06, 0xa9, 0x84, 0xb6, 0x5a, 0xcb, 0x78, 0x61, 0x7d,
+	0xf9, 0x34, 0x3b, 0x27, 0x14, 0xd3, 0xe2, 0x54, 0x88, 0x4b, 0x8a, 0x5f, 0x8d, 0x44, 0x35, 0xca,
+	0xa2, 0x3a, 0x3c, 0x30, 0x2a, 0x1f, 0x6c, 0x24, 0xac, 0x87, 0x08, 0x24, 0x3f, 0x2c, 0x4f, 0x64,
+	0x51, 0x97, 0x26, 0xae, 0x13, 0x7c, 0x18, 0x66, 0x5a, 0xa4, 0x6d, 0x50, 0xc3, 0xb6, 0x4a, 0x96,
+	0xdb, 0x28, 0x93, 0x16, 0x43, 0x32, 0x56, 0x9c, 0x0e, 0x96, 0xaf, 0xb2, 0xd5, 0x88, 0x62, 0x28,
+	0xcf, 0x21, 0x45, 0x3f, 0x91, 0x78, 0x01, 0x76, 0x9a, 0x5e, 0x7c, 0x4e, 0xa0, 0x36, 0x96, 0x43,
+	0x8b, 0x93, 0xc5, 0x29, 0x7f, 0x91, 0x67, 0xfb, 0x11, 0x82, 0x7d, 0x42, 0xc8, 0x3c, 0x17, 0x2f,
+	0xc2, 0x8c, 0x1e, 0x48, 0x12, 0x14, 0xe9, 0xb4, 0x1e, 0xd9, 0xe6, 0x79, 0xd6, 0xe9, 0x3d, 0x31,
+	0x72, 0x9a, 0x88, 0xed, 0x8b, 0x82, 0x94, 0xff, 0x9d, 0x42, 0xfe, 0x1e, 0xc1, 0x7e, 0x31, 0x08,
+	0xce, 0xdf, 0xbb, 0xb0, 0xab, 0x87, 0xbf, 0xa0, 0x9c, 0x8f, 0x8a, 0xc2, 0x8d, 0x6e, 0xf3, 0xa6,
+	0xe1, 0xd4, 0x23, 0x04, 0xcc, 0x44, 0xe9, 0xdd, 0xc2, 0xd2, 0xbd, 0x8f, 0xe0, 0xa0, 0x20, 0x10,
+	0xdf, 0xfb, 0x3f, 0xcb, 0xe9, 0x0f, 0x08, 0xe4, 0xcd, 0xa0, 0x70, 0x66, 0xdf, 0x82, 0xbd, 0x3d,
+	0xcc, 0xf2, 0x72, 0x0a, 0x08, 0x1e, 0x5c, 0x4f, 0x7b, 0x74, 0x91, 0x87, 0xad, 0x23, 0x75, 0xb5,
+	0xaf, 0x95, 0xba, 0x89, 0xa8, 0x94, 0x8f, 0xc3, 0xbc, 0xc0, 0x90, 0x07, 0x3e, 0x07, 0x13, 0x94,
+	0xad, 0x70, 0x33, 0xfe, 0x25, 0x57, 0x40, 0xea, 0x33, 0xda, 0xfa, 0xd6, 0xfd, 0x5d, 0xe7, 0xd8,
+	0xf5, 0xb8, 0xe1, 0xe8, 0xde, 0x86, 0x99, 0x50, 0xf3, 0xf6, 0x44, 0x3c, 0x1d, 0xcb, 0x49, 0xdb,
+	0xb7, 0x4b, 0x79, 0x7a, 0xa6, 0xf5, 0x88, 0x8b, 0xad, 0xcb, 0xcb, 0xaf, 0x08, 0xe6, 0xc4, 0x9e,
+	0x37, 0xaf, 0xf0, 0x2e, 0xf3, 0xa3, 0x61, 0xe6, 0xf1, 0x35, 0xf8, 0x9f, 0x63, 0x34, 0x48, 0xc9,
+	0xb5, 0x1c, 0xc3, 0x2c, 0x91, 0xf7, 0x9a, 0x46, 0x6b, 0x9d, 0x37, 0xb5, 0xf9, 0xbe, 0x36, 0x79,
+	0x81, 0xcf, 0x22, 0x6b, 0x93, 0x5e, 0x90, 0x9f, 0x3c, 0xcd, 0xa2, 0xe2, 0x8c, 0x67, 0x7d, 0xd3,
+	0x33, 0x7e, 0x85, 0xd9, 0xe2, 0x73, 0x20, 0xf1, 0xd6, 0xdd, 0x2d, 0x71, 0x4f, 0x87, 0x3a, 0x5a,
+	0xa3, 0xc9, 0xfa, 0xf8, 0x58, 0x31, 0xed, 0x6b, 0x74, 0x0e, 0xc9, 0xeb, 0x81, 0x5c, 0x36, 0x20,
+	0xcb, 0x32, 0x74, 0x83, 0x98, 0xd5, 0x98, 0xe6, 0xb8, 0x55, 0xd5, 0xf0, 0x23, 0x82, 0x5c, 0xbc,
+	0xaf, 0x7f, 0x59, 0x0f, 0x94, 0x22, 0xc7, 0xf5, 0xba, 0xd6, 0xd2, 0x1a, 0x01, 0x61, 0xf2, 0x35,
+	0x98, 0x17, 0xc8, 0x78, 0x80, 0x05, 0x98, 0x68, 0xb2, 0x15, 0xce, 0xa4, 0xb0, 0xf3, 0x70, 0x1b,
+	0xae, 0x29, 0x1f, 0xe4, 0x49, 0xba, 0xd9, 0xac, 0xb5, 0xb4, 0x4a, 0x64, 0x3e, 0x09, 0x7c, 0x9a,
+	0x90, 0x8b, 0x57, 0xe1, 0xae, 0x2f, 0xc1, 0x1e, 0x97, 0x8b, 0x4b, 0x89, 0x47, 0xc9, 0x59, 0xb7,
+	0x7f, 0x47, 0xf9, 0xff, 0x20, 0x47, 0xbd, 0x89, 0x66, 0x18, 0xd9, 0x85, 0x85, 0x4d, 0xb5, 0x38,
+	0xac, 0xab, 0x90, 0xee, 0xc2, 0x1a, 0x62, 0x7e, 0x98, 0x73, 0x85, 0xfb, 0xca, 0x8f, 0x46, 0xf9,
+	0x3d, 0xfb, 0x06, 0x69, 0x19, 0xd5, 0xf5, 0x2b, 0xc4, 0x1b, 0x85, 0x68, 0xdd, 0x68, 0x26, 0xba,
+	0x99, 0x9e, 0xdf, 0x14, 0x82, 0x2f, 0x43, 0xaa, 0x41, 0x5a, 0xb7, 0x4c, 0x52, 0x6a, 0x6a, 0x4e,
+	0x9d, 0x1d, 0xcd, 0x54, 0x41, 0x0e, 0xed, 0xd1, 0x7d, 0xb6, 0xb4, 0xf3, 0xca, 0x15, 0xa6, 0x7a,
+	0x5d, 0x73, 0xea, 0x7c, 0x2f, 0x68, 0x74, 0x56, 0x3c, 0x94, 0x6d, 0xcd, 0x74, 0x49, 0x7a, 0xdc,
+	0x47, 0xc9, 0x3e, 0xf0, 0x01, 0x00, 0xd6, 0x5b, 0x2a, 0xc4, 0xd4, 0xd6, 0xd3, 0x13, 0xec, 0xe8,
+	0xef, 0xf0, 0x56, 0x2e, 0x78, 0x0b, 0x38, 0x0b, 0xa9, 0xb2, 0x69, 0xeb, 0xb7, 0xb8, 0x7c, 0x3b,
+	0x93, 0x03, 0x5b, 0x62, 0x0a, 0xf2, 0x19, 0x38, 0x10, 0x43, 0x1c, 0x4f, 0x55, 0x1a, 0xb6, 0x53,
+	0x57, 0xd7, 0x09, 0xf5, 0xab, 0x77, 0xb2, 0x18, 0x7c, 0xca, 0x5f, 0x8c, 0x42, 0x36, 0x64, 0x7b,
+	0xd5, 0xb6, 0xfe, 0x93, 0xbc, 0x47, 0x19, 0x1e, 0x1f, 0xc0, 0xf0, 0x44, 0x1f, 0xc3, 0xe7, 0x20,
+	0x17, 0xcf, 0xd2, 0x20, 0x92, 0x0b, 0x1f, 0x63, 0x18, 0x67, 0xe6, 0xf8, 0x33, 0x04, 0xa9, 0xd0,
+	0x81, 0xc4, 0x47, 0x44, 0x8c, 0xc4, 0xbc, 0x41, 0xa5, 0xa3, 0xc9, 0x94, 0x7d, 0x38, 0xf2, 0xc9,
+	0x7b, 0x3f, 0xfd, 0xf1, 0xe1, 0xa8, 0x8a, 0x57, 0xd4, 0xd8, 0xe7, 0x38, 0x6f, 0xd4, 0xea, 0x9d,
+	0x4e, 0x7a, 0xef, 0xe2, 0x8f, 0x10, 0x4c, 0x9d, 0x0f, 0xbf, 0x9c, 0x12, 0x79, 0x0d, 0x7a, 0xa8,
+	0xb4, 0x92, 0x50, 0x9b, 0x83, 0x5c, 0x62, 0x20, 0x17, 0xf0, 0xc1, 0x81, 0x20, 0xf1, 0x53, 0x04,
+	0xd3, 0xd1, 0x8e, 0x81, 0x95, 0x78, 0x67, 0xa2, 0xc6, 0x26, 0xa9, 0x89, 0xf5, 0x39, 0x3c, 0x93,
+	0xc1, 0xab, 0xe2, 0x8a, 0x10, 0x5e, 0xcf, 0x7d, 0x17, 0xa6, 0x51, 0x0d, 0xde, 0x69, 0xea, 0x9d,
+	0x9e, 0x17, 0xdf, 0x5d, 0xd5, 0x3f, 0x12, 0x21, 0x81, 0xbf, 0x70, 0x17, 0x3f, 0x40, 0x30, 0xd3,
+	0x73, 0xbf, 0xe2, 0xa4, 0x90, 0x3b, 0x09, 0x38, 0x96, 0xdc, 0x80, 0x07, 0x79, 0x9a, 0x05, 0x59,
+	0xc0, 0xc7, 0x86, 0x0d, 0x12, 0x3f, 0x46, 0xb0, 0x47, 0x38, 0xc0, 0xe3, 0x93, 0x09, 0x51, 0x44,
+	0xdf, 0x1e, 0xd2, 0xa9, 0x61, 0xcd, 0x78, 0x08, 0x2f, 0xb1, 0x10, 0xce, 0xe2, 0xd3, 0x43, 0xe7,
+	0x89, 0x3f, 0x27, 0xf0, 0xe7, 0x91, 0xb2, 0x77, 0x93, 0x95, 0xbd, 0x3b, 0x54, 0xd9, 0xbb, 0x74,
+	0xe8, 0xb3, 0xe9, 0x46, 0xf9, 0xfe, 0xd4, 0x3b, 0x02, 0xd1, 0x79, 0x59, 0x49, 0xe4, 0xb8, 0x5b,
+	0x1e, 0x6a, 0x62, 0x7d, 0x0e, 0xf5, 0x08, 0x83, 0x7a, 0x08, 0x2f, 0x0c, 0x84, 0x4a, 0x28, 0xfe,
+	0x0a, 0xc1, 0xac, 0x60, 0x4a, 0xc4, 0xc7, 0x63, 0xbd, 0xc6, 0xcf, 0xaf, 0xd2, 0x89, 0xe1, 0x8c,
+	0x38, 0xde, 0x3c, 0xc3, 0x7b, 0x04, 0x2f, 0x89, 0xf0, 0x52, 0x62, 0x56, 0x7b, 0x67, 0x15, 0xea,
+	0x9d, 0xbb, 0x5d, 0xbd, 0x57, 0x27, 0x8e, 0x3f, 0x47, 0x31, 0xe3, 0x89, 0x94, 0x1f, 0xc2, 0x22,
+	0xa8, 0x83, 0xfb, 0x7f, 0x3e, 0x5c, 0x46, 0x0c, 0xf1, 0xb2, 0x7c, 0x48, 0x84, 0xb8, 0xcd, 0x4c,
+	0x4b, 0x8d, 0x8e, 0xed, 0x59, 0xb4, 0x8c, 0xbf, 0x41, 0x30, 0x2b, 0xb8, 0x89, 0x36, 0xa1, 0x39,
+	0xfe, 0x76, 0x97, 0x4e, 0x0c, 0x67, 0xc4, 0x91, 0x9f, 0xe9, 0x22, 0x57, 0xe4, 0xa5, 0x4d, 0x90,
+	0x5b, 0xb6, 0xd5, 0x83, 0xfe, 0xfd, 0xce, 0x51, 0xf3, 0xc7, 0xe5, 0x81, 0x47, 0x2d, 0x32, 0xa5,
+	0x4b, 0x2b, 0x09, 0xb5, 0x39, 0x50, 0x99, 0x61, 0xdc, 0x8f, 0x25, 0x11, 0x46, 0x7f, 0x4e, 0xc7,
+	0x5f, 0x23, 0x98, 0x15, 0x0c, 0xe0, 0x9b, 0xf0, 0x19, 0x3f, 0xd1, 0x4b, 0x27, 0x86, 0x33, 0xe2,
+	0x30, 0x0b, 0x0c, 0xe6, 0x51, 0xbc, 0x2c, 0x82, 0x29, 0x9c, 0xfe, 0x29, 0xfe, 0x16, 0xc1, 0x9c,
+	0x78, 0x46, 0xc7, 0xa7, 0x06, 0x83, 0x10, 0xde, 0x90, 0xab, 0x43, 0xdb, 0x25, 0xe9, 0x68, 0x71,
+	0xcf, 0x04, 0xba, 0x56, 0x7c, 0xfc, 0x2c, 0x83, 0x9e, 0x3c, 0xcb, 0xa0, 0xdf, 0x9f, 0x65, 0xd0,
+	0x07, 0x1b, 0x99, 0x91, 0x27, 0x1b, 0x99, 0x91, 0x5f, 0x36, 0x32, 0x23, 0xef, 0x9c, 0xae, 0x19,
+	0x4e, 0xdd, 0x2d, 0x7b, 0x43, 0x9e, 0xca, 0xff, 0x6c, 0x30, 0xca, 0xfa, 0x4a, 0xcd, 0x56, 0xdb,
+	0xab, 0x6a, 0xc3, 0xae, 0xb8, 0x26, 0xa1, 0xbe, 0x9f, 0x63, 0x85, 0x15, 0xee, 0xca, 0x59, 0x6f,
+	0x12, 0x5a, 0x9e, 0x60, 0xaf, 0x8d, 0xe3, 0x7f, 0x0d, 0x00, 0xce, 0xde, 0xe8, 0x83, 0x04, 0x19,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ClientState queries an IBC light client.
+	ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error)
+	// ClientStates queries all the IBC light clients of a chain.
+	ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error)
+	// ConsensusState queries a consensus state associated with a client state at
+	// a given height.
 	ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error)
 	// ConsensusStates queries all the consensus state associated with a given
 	// client.
@@ -1079,6 +1685,14 @@ type QueryClient interface {
 	ConsensusStateHeights(ctx context.Context, in *QueryConsensusStateHeightsRequest, opts ...grpc.CallOption) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(ctx context.Context, in *QueryClientStatusRequest, opts ...grpc.CallOption) (*QueryClientStatusResponse, error)
+	// ClientStatuses queries the status of all IBC clients along with the time left until they expire.
+	ClientStatuses(ctx context.Context, in *QueryClientStatusesRequest, opts ...grpc.CallOption) (*QueryClientStatusesResponse, error)
+	// SelfConsensusStates queries the consensus states of the host chain stored by the ibc client submodule.
+	SelfConsensusStates(ctx context.Context, in *QuerySelfConsensusStatesRequest, opts ...grpc.CallOption) (*QuerySelfConsensusStatesResponse, error)
+	// VerifyMembership queries an IBC light client for proof verification of a value at a given key path.
+	VerifyMembership(ctx context.Context, in *QueryVerifyMembershipRequest, opts ...grpc.CallOption) (*QueryVerifyMembershipResponse, error)
+	// VerifyNonMembership queries an IBC light client for proof verification of the absence of a key path.
+	VerifyNonMembership(ctx context.Context, in *QueryVerifyNonMembershipRequest, opts ...grpc.CallOption) (*QueryVerifyNonMembershipResponse, error)
 	// ClientParams queries all parameters of the ibc client submodule.
 	ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1149,6 +1763,42 @@ func (c *queryClient) ClientStatus(ctx context.Context, in *QueryClientStatusReq
 	return out, nil
 }
 
+func (c *queryClient) ClientStatuses(ctx context.Context, in *QueryClientStatusesRequest, opts ...grpc.CallOption) (*QueryClientStatusesResponse, error) {
+	out := new(QueryClientStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SelfConsensusStates(ctx context.Context, in *QuerySelfConsensusStatesRequest, opts ...grpc.CallOption) (*QuerySelfConsensusStatesResponse, error) {
+	out := new(QuerySelfConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/SelfConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyMembership(ctx context.Context, in *QueryVerifyMembershipRequest, opts ...grpc.CallOption) (*QueryVerifyMembershipResponse, error) {
+	out := new(QueryVerifyMembershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/VerifyMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyNonMembership(ctx context.Context, in *QueryVerifyNonMembershipRequest, opts ...grpc.CallOption) (*QueryVerifyNonMembershipResponse, error) {
+	out := new(QueryVerifyNonMembershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/VerifyNonMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error) {
 	out := new(QueryClientParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientParams", in, out, opts...)
@@ -1192,6 +1842,14 @@ type QueryServer interface {
 	ConsensusStateHeights(context.Context, *QueryConsensusStateHeightsRequest) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(context.Context, *QueryClientStatusRequest) (*QueryClientStatusResponse, error)
+	// ClientStatuses queries the status of all IBC clients along with the time left until they expire.
+	ClientStatuses(context.Context, *QueryClientStatusesRequest) (*QueryClientStatusesResponse, error)
+	// SelfConsensusStates queries the consensus states of the host chain stored by the ibc client submodule.
+	SelfConsensusStates(context.Context, *QuerySelfConsensusStatesRequest) (*QuerySelfConsensusStatesResponse, error)
+	// VerifyMembership queries an IBC light client for proof verification of a value at a given key path.
+	VerifyMembership(context.Context, *QueryVerifyMembershipRequest) (*QueryVerifyMembershipResponse, error)
+	// VerifyNonMembership queries an IBC light client for proof verification of the absence of a key path.
+	VerifyNonMembership(context.Context, *QueryVerifyNonMembershipRequest) (*QueryVerifyNonMembershipResponse, error)
 	// ClientParams queries all parameters of the ibc client submodule.
 	ClientParams(context.Context, *QueryClientParamsRequest) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1222,6 +1880,18 @@ func (*UnimplementedQueryServer) ConsensusStateHeights(ctx context.Context, req
 func (*UnimplementedQueryServer) ClientStatus(ctx context.Context, req *QueryClientStatusRequest) (*QueryClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientStatus not implemented")
 }
+func (*UnimplementedQueryServer) ClientStatuses(ctx context.Context, req *QueryClientStatusesRequest) (*QueryClientStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStatuses not implemented")
+}
+func (*UnimplementedQueryServer) SelfConsensusStates(ctx context.Context, req *QuerySelfConsensusStatesRequest) (*QuerySelfConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfConsensusStates not implemented")
+}
+func (*UnimplementedQueryServer) VerifyMembership(ctx context.Context, req *QueryVerifyMembershipRequest) (*QueryVerifyMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMembership not implemented")
+}
+func (*UnimplementedQueryServer) VerifyNonMembership(ctx context.Context, req *QueryVerifyNonMembershipRequest) (*QueryVerifyNonMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyNonMembership not implemented")
+}
 func (*UnimplementedQueryServer) ClientParams(ctx context.Context, req *QueryClientParamsRequest) (*QueryClientParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientParams not implemented")
 }
@@ -1344,6 +2014,78 @@ func _Query_ClientStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClientStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ClientStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientStatuses(ctx, req.(*QueryClientStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SelfConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySelfConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SelfConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/SelfConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SelfConsensusStates(ctx, req.(*QuerySelfConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/VerifyMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyMembership(ctx, req.(*QueryVerifyMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyNonMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyNonMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyNonMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/VerifyNonMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyNonMembership(ctx, req.(*QueryVerifyNonMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClientParamsRequest)
 	if err := dec(in); err != nil {
@@ -1427,15 +2169,31 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_ClientStatus_Handler,
 		},
 		{
-			MethodName: "ClientParams",
-			Handler:    _Query_ClientParams_Handler,
+			MethodName: "ClientStatuses",
+			Handler:    _Query_ClientStatuses_Handler,
 		},
 		{
-			MethodName: "UpgradedClientState",
-			Handler:    _Query_UpgradedClientState_Handler,
+			MethodName: "SelfConsensusStates",
+			Handler:    _Query_SelfConsensusStates_Handler,
 		},
 		{
-			MethodName: "UpgradedConsensusState",
+			MethodName: "VerifyMembership",
+			Handler:    _Query_VerifyMembership_Handler,
+		},
+		{
+			MethodName: "VerifyNonMembership",
+			Handler:    _Query_VerifyNonMembership_Handler,
+		},
+		{
+			MethodName: "ClientParams",
+			Handler:    _Query_ClientParams_Handler,
+		},
+		{
+			MethodName: "UpgradedClientState",
+			Handler:    _Query_UpgradedClientState_Handler,
+		},
+		{
+			MethodName: "UpgradedConsensusState",
 			Handler:    _Query_UpgradedConsensusState_Handler,
 		},
 	},
@@ -1953,6 +2711,224 @@ func (m *QueryClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClientStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientStatuses) > 0 {
+		for iNdEx := len(m.ClientStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedClientStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedClientStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedClientStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestConsensusTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestConsensusTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeUntilExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelfConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelfConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusStates) > 0 {
+		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2127,51 +3103,258 @@ func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryVerifyMembershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryClientStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryVerifyMembershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVerifyMembershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TimeDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeDelay))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryClientStatesRequest) Size() (n int) {
-	if m == nil {
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.MerklePath.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMembershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMembershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMembershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyNonMembershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyNonMembershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyNonMembershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MerklePath.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyNonMembershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyNonMembershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyNonMembershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClientStatesRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2341,51 +3524,93 @@ func (m *QueryClientStatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryClientParamsRequest) Size() (n int) {
+func (m *QueryClientStatusesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryClientParamsResponse) Size() (n int) {
+func (m *QueryClientStatusesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
+	if len(m.ClientStatuses) > 0 {
+		for _, e := range m.ClientStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryUpgradedClientStateRequest) Size() (n int) {
+func (m *IdentifiedClientStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LatestConsensusTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.LatestConsensusTimestamp))
+	}
 	return n
 }
 
-func (m *QueryUpgradedClientStateResponse) Size() (n int) {
+func (m *QuerySelfConsensusStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		l = m.UpgradedClientState.Size()
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
+func (m *QuerySelfConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusStates) > 0 {
+		for _, e := range m.ConsensusStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2394,27 +3619,153 @@ func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
+func (m *QueryClientParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpgradedConsensusState != nil {
-		l = m.UpgradedConsensusState.Size()
+	if m.Params != nil {
+		l = m.Params.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *QueryUpgradedClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradedClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradedConsensusState != nil {
+		l = m.UpgradedConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyMembershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MerklePath.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeDelay != 0 {
+		n += 1 + sovQuery(uint64(m.TimeDelay))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	return n
+}
+
+func (m *QueryVerifyMembershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVerifyNonMembershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MerklePath.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeDelay != 0 {
+		n += 1 + sovQuery(uint64(m.TimeDelay))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	return n
+}
+
+func (m *QueryVerifyNonMembershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -3787,7 +5138,7 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3810,12 +5161,48 @@ func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3837,7 +5224,7 @@ func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3860,15 +5247,15 @@ func (m *QueryClientParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3895,63 +5282,47 @@ func (m *QueryClientParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClientStatuses = append(m.ClientStatuses, IdentifiedClientStatus{})
+			if err := m.ClientStatuses[len(m.ClientStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUpgradedClientStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedClientStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3973,7 +5344,7 @@ func (m *QueryUpgradedClientStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedClientStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3996,17 +5367,17 @@ func (m *QueryUpgradedClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedClientStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedClientStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4016,28 +5387,976 @@ func (m *QueryUpgradedClientStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpgradedClientState == nil {
-				m.UpgradedClientState = &types.Any{}
-			}
-			if err := m.UpgradedClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeUntilExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConsensusTimestamp", wireType)
+			}
+			m.LatestConsensusTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestConsensusTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySelfConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelfConsensusStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelfConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySelfConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelfConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelfConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradedClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradedClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradedClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradedClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradedClientState == nil {
+				m.UpgradedClientState = &types.Any{}
+			}
+			if err := m.UpgradedClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradedConsensusState == nil {
+				m.UpgradedConsensusState = &types.Any{}
+			}
+			if err := m.UpgradedConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMembershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMembershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMembershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerklePath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeDelay", wireType)
+			}
+			m.TimeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4059,7 +6378,7 @@ func (m *QueryUpgradedClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyMembershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4082,12 +6401,32 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyMembershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyMembershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4109,7 +6448,7 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyNonMembershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4132,15 +6471,81 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyNonMembershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyNonMembershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4167,13 +6572,151 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpgradedConsensusState == nil {
-				m.UpgradedConsensusState = &types.Any{}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.UpgradedConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerklePath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeDelay", wireType)
+			}
+			m.TimeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyNonMembershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyNonMembershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyNonMembershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
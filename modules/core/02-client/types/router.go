@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// The router is a map from client type to the LightClientModule which
+// implements all the light client functionality core IBC requires
+type Router struct {
+	routes        map[string]exported.LightClientModule
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewRouter returns an instance of the Router. The store provider gives the
+// registered light client modules access to the client prefixed stores.
+func NewRouter(key storetypes.StoreKey) *Router {
+	return &Router{
+		routes:        make(map[string]exported.LightClientModule),
+		storeProvider: NewStoreProvider(key),
+	}
+}
+
+// AddRoute adds the LightClientModule for a given client type. It returns the Router
+// so AddRoute calls can be linked. It will panic if the client type is invalid or
+// a route has already been registered for it.
+func (rtr *Router) AddRoute(clientType string, module exported.LightClientModule) *Router {
+	if err := ValidateClientType(clientType); err != nil {
+		panic(fmt.Sprintf("invalid client type %s: %v", clientType, err))
+	}
+	if rtr.HasRoute(clientType) {
+		panic(fmt.Sprintf("route %s has already been registered", clientType))
+	}
+
+	module.RegisterStoreProvider(rtr.storeProvider)
+	rtr.routes[clientType] = module
+	return rtr
+}
+
+// HasRoute returns true if the Router has a light client module registered for the client type or false otherwise.
+func (rtr *Router) HasRoute(clientType string) bool {
+	_, ok := rtr.routes[clientType]
+	return ok
+}
+
+// GetRoute returns the LightClientModule registered for the given client type.
+func (rtr *Router) GetRoute(clientType string) (exported.LightClientModule, bool) {
+	if !rtr.HasRoute(clientType) {
+		return nil, false
+	}
+	return rtr.routes[clientType], true
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+)
+
+func (suite *TypesTestSuite) TestRouter() {
+	var router *types.Router
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		clientType string
+		expPanic   bool
+	}{
+		{
+			"success",
+			func() {},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: route already registered",
+			func() {
+				router.AddRoute(exported.Tendermint, ibctm.NewLightClientModule(suite.chainA.Codec))
+			},
+			exported.Tendermint,
+			true,
+		},
+		{
+			"failure: invalid client type",
+			func() {},
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			router = types.NewRouter(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+
+			tc.malleate()
+
+			addRoute := func() {
+				router.AddRoute(tc.clientType, ibctm.NewLightClientModule(suite.chainA.Codec))
+			}
+
+			if tc.expPanic {
+				suite.Require().Panics(addRoute)
+				return
+			}
+
+			suite.Require().NotPanics(addRoute)
+			suite.Require().True(router.HasRoute(tc.clientType))
+
+			clientModule, found := router.GetRoute(tc.clientType)
+			suite.Require().True(found)
+			suite.Require().NotNil(clientModule)
+
+			_, found = router.GetRoute(exported.Solomachine)
+			suite.Require().False(found)
+		})
+	}
+}
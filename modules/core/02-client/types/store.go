@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientStoreProvider = (*storeProvider)(nil)
+
+// storeProvider provides the client prefixed stores of the IBC store to light client modules.
+type storeProvider struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewStoreProvider creates a new ClientStoreProvider for the provided IBC store key.
+func NewStoreProvider(storeKey storetypes.StoreKey) exported.ClientStoreProvider {
+	return storeProvider{
+		storeKey: storeKey,
+	}
+}
+
+// ClientStore returns isolated prefix store for each client so they can read/write in separate
+// namespace without being able to read/write other client's data
+func (s storeProvider) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	clientPrefix := []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
+	return prefix.NewStore(ctx.KVStore(s.storeKey), clientPrefix)
+}
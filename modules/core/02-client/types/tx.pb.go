@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -365,6 +366,260 @@ func (m *MsgSubmitMisbehaviourResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitMisbehaviourResponse proto.InternalMessageInfo
 
+// MsgRecoverClient defines the message used to recover a frozen or expired client.
+type MsgRecoverClient struct {
+	// the client identifier for the client to be updated if the proposal passes
+	SubjectClientId string `protobuf:"bytes,1,opt,name=subject_client_id,json=subjectClientId,proto3" json:"subject_client_id,omitempty"`
+	// the substitute client identifier for the client which will replace the subject
+	// client
+	SubstituteClientId string `protobuf:"bytes,2,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRecoverClient) Reset()         { *m = MsgRecoverClient{} }
+func (m *MsgRecoverClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverClient) ProtoMessage()    {}
+func (*MsgRecoverClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+}
+func (m *MsgRecoverClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverClient.Merge(m, src)
+}
+func (m *MsgRecoverClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverClient proto.InternalMessageInfo
+
+// MsgRecoverClientResponse defines the Msg/RecoverClient response type.
+type MsgRecoverClientResponse struct {
+}
+
+func (m *MsgRecoverClientResponse) Reset()         { *m = MsgRecoverClientResponse{} }
+func (m *MsgRecoverClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverClientResponse) ProtoMessage()    {}
+func (*MsgRecoverClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+}
+func (m *MsgRecoverClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverClientResponse.Merge(m, src)
+}
+func (m *MsgRecoverClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverClientResponse proto.InternalMessageInfo
+
+// MsgIBCSoftwareUpgrade defines the message used to schedule an upgrade of an IBC client using a v1 governance proposal
+type MsgIBCSoftwareUpgrade struct {
+	Plan types1.Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+	// An UpgradedClientState must be provided to perform an IBC breaking upgrade.
+	// This will make the chain commit to the correct upgraded (self) client state
+	// before the upgrade occurs, so that connecting chains can verify that the
+	// new upgraded client is valid by verifying a proof on the previous version
+	// of the chain. This will allow IBC connections to persist smoothly across
+	// planned chain upgrades. Correspondingly, the UpgradedClientState field has been
+	// deprecated in the Cosmos SDK to allow for this logic to exist solely in
+	// the 02-client module.
+	UpgradedClientState *types.Any `protobuf:"bytes,2,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgIBCSoftwareUpgrade) Reset()         { *m = MsgIBCSoftwareUpgrade{} }
+func (m *MsgIBCSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCSoftwareUpgrade) ProtoMessage()    {}
+func (*MsgIBCSoftwareUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+}
+func (m *MsgIBCSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCSoftwareUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCSoftwareUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIBCSoftwareUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCSoftwareUpgrade.Merge(m, src)
+}
+func (m *MsgIBCSoftwareUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCSoftwareUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCSoftwareUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIBCSoftwareUpgrade proto.InternalMessageInfo
+
+// MsgIBCSoftwareUpgradeResponse defines the Msg/IBCSoftwareUpgrade response type.
+type MsgIBCSoftwareUpgradeResponse struct {
+}
+
+func (m *MsgIBCSoftwareUpgradeResponse) Reset()         { *m = MsgIBCSoftwareUpgradeResponse{} }
+func (m *MsgIBCSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCSoftwareUpgradeResponse) ProtoMessage()    {}
+func (*MsgIBCSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+}
+func (m *MsgIBCSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCSoftwareUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCSoftwareUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIBCSoftwareUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCSoftwareUpgradeResponse.Merge(m, src)
+}
+func (m *MsgIBCSoftwareUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCSoftwareUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCSoftwareUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIBCSoftwareUpgradeResponse proto.InternalMessageInfo
+
+// MsgPruneConsensusStates defines the message used to prune all expired consensus states of a client.
+type MsgPruneConsensusStates struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneConsensusStates) Reset()         { *m = MsgPruneConsensusStates{} }
+func (m *MsgPruneConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStates) ProtoMessage()    {}
+func (*MsgPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{12}
+}
+func (m *MsgPruneConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStates proto.InternalMessageInfo
+
+// MsgPruneConsensusStatesResponse defines the Msg/PruneConsensusStates response type.
+type MsgPruneConsensusStatesResponse struct {
+	// the number of pruned consensus states
+	TotalPruned uint64 `protobuf:"varint,1,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+}
+
+func (m *MsgPruneConsensusStatesResponse) Reset()         { *m = MsgPruneConsensusStatesResponse{} }
+func (m *MsgPruneConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{13}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStatesResponse) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
 // MsgUpdateParams defines the sdk.Msg type to update the client parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -379,7 +634,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +685,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,6 +723,12 @@ func init() {
 	proto.RegisterType((*MsgUpgradeClientResponse)(nil), "ibc.core.client.v1.MsgUpgradeClientResponse")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviour")
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
+	proto.RegisterType((*MsgRecoverClient)(nil), "ibc.core.client.v1.MsgRecoverClient")
+	proto.RegisterType((*MsgRecoverClientResponse)(nil), "ibc.core.client.v1.MsgRecoverClientResponse")
+	proto.RegisterType((*MsgIBCSoftwareUpgrade)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgrade")
+	proto.RegisterType((*MsgIBCSoftwareUpgradeResponse)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgradeResponse")
+	proto.RegisterType((*MsgPruneConsensusStates)(nil), "ibc.core.client.v1.MsgPruneConsensusStates")
+	proto.RegisterType((*MsgPruneConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneConsensusStatesResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.client.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.client.v1.MsgUpdateParamsResponse")
 }
@@ -475,49 +736,63 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0xfe, 0x88, 0xc8, 0x35, 0x6d, 0xd1, 0xd1, 0xd2, 0xd4, 0xa5, 0x4e, 0x15, 0x18,
-	0x4a, 0xa1, 0x76, 0x5b, 0x86, 0x56, 0x45, 0x0c, 0x6d, 0x27, 0x86, 0x48, 0xc8, 0x15, 0x0b, 0x4b,
-	0xb1, 0x9d, 0xcb, 0xc5, 0x52, 0xec, 0xb3, 0x7c, 0x76, 0x44, 0x36, 0xc4, 0xc4, 0x08, 0x1b, 0x0b,
-	0x52, 0xff, 0x84, 0x4e, 0xfc, 0x0d, 0x65, 0xeb, 0xc8, 0x84, 0x50, 0x32, 0x94, 0x3f, 0x03, 0xd9,
-	0x77, 0x71, 0x6c, 0xd7, 0x4e, 0x03, 0x6c, 0xf6, 0xbd, 0xcf, 0xbb, 0xef, 0xf7, 0x3d, 0xbf, 0xf3,
-	0x81, 0x35, 0xcb, 0x30, 0x55, 0x93, 0x78, 0x48, 0x35, 0x3b, 0x16, 0x72, 0x7c, 0xb5, 0xbb, 0xab,
-	0xfa, 0xef, 0x14, 0xd7, 0x23, 0x3e, 0x81, 0xd0, 0x32, 0x4c, 0x25, 0x0c, 0x2a, 0x2c, 0xa8, 0x74,
-	0x77, 0xa5, 0x15, 0x93, 0x50, 0x9b, 0x50, 0xd5, 0xa6, 0x38, 0x64, 0x6d, 0x8a, 0x19, 0x2c, 0x2d,
-	0x61, 0x82, 0x49, 0xf4, 0xa8, 0x86, 0x4f, 0x7c, 0x75, 0x15, 0x13, 0x82, 0x3b, 0x48, 0x8d, 0xde,
-	0x8c, 0xa0, 0xa5, 0xea, 0x4e, 0x8f, 0x87, 0x6a, 0x39, 0xd2, 0x5c, 0x27, 0x02, 0xea, 0xdf, 0x44,
-	0xb0, 0xd8, 0xa0, 0xf8, 0xc4, 0x43, 0xba, 0x8f, 0x4e, 0xa2, 0x08, 0xdc, 0x07, 0x15, 0xc6, 0x9c,
-	0x51, 0x5f, 0xf7, 0x51, 0x55, 0xdc, 0x10, 0x37, 0xe7, 0xf6, 0x96, 0x14, 0x26, 0xa3, 0x0c, 0x65,
-	0x94, 0x23, 0xa7, 0xa7, 0xcd, 0x31, 0xf2, 0x34, 0x04, 0xe1, 0x0b, 0xb0, 0x68, 0x12, 0x87, 0x22,
-	0x87, 0x06, 0x94, 0xe7, 0x4e, 0x8d, 0xc9, 0x5d, 0x88, 0x61, 0x96, 0x7e, 0x1f, 0x94, 0xa8, 0x85,
-	0x1d, 0xe4, 0x55, 0xa7, 0x37, 0xc4, 0xcd, 0xb2, 0xc6, 0xdf, 0x0e, 0xef, 0x7d, 0x3c, 0xaf, 0x09,
-	0xbf, 0xcf, 0x6b, 0xc2, 0x87, 0xeb, 0x8b, 0x2d, 0xbe, 0x58, 0x5f, 0x05, 0x2b, 0x19, 0xdf, 0x1a,
-	0xa2, 0x6e, 0xb8, 0x61, 0xfd, 0x0b, 0xab, 0xe9, 0xb5, 0xdb, 0x1c, 0xd5, 0xb4, 0x06, 0xca, 0xbc,
-	0x26, 0xab, 0x19, 0x15, 0x54, 0xd6, 0xee, 0xb0, 0x85, 0x97, 0x4d, 0xf8, 0x1c, 0x2c, 0xf0, 0xa0,
-	0x8d, 0x28, 0xd5, 0xf1, 0x78, 0xdb, 0xf3, 0x8c, 0x6d, 0x30, 0xf4, 0x5f, 0x5c, 0x27, 0x9d, 0xc5,
-	0xae, 0xbf, 0x4f, 0x81, 0xbb, 0x51, 0x0c, 0x7b, 0x7a, 0x73, 0x22, 0xdb, 0xd9, 0xef, 0x34, 0xf5,
-	0x1f, 0xdf, 0x69, 0xfa, 0x2f, 0xbe, 0xd3, 0x0e, 0x58, 0x72, 0x3d, 0x42, 0x5a, 0x67, 0x01, 0xf3,
-	0x7a, 0xc6, 0xf6, 0xae, 0xce, 0x6c, 0x88, 0x9b, 0x15, 0x0d, 0x46, 0xb1, 0x74, 0x19, 0x47, 0x60,
-	0x3d, 0x93, 0x91, 0x91, 0x9f, 0x8d, 0x52, 0xa5, 0x54, 0x6a, 0xd1, 0x70, 0x94, 0x6e, 0x6f, 0xb3,
-	0x04, 0xaa, 0xd9, 0x56, 0xc6, 0x7d, 0xfe, 0x2a, 0x82, 0xe5, 0x06, 0xc5, 0xa7, 0x81, 0x61, 0x5b,
-	0x7e, 0xc3, 0xa2, 0x06, 0x6a, 0xeb, 0x5d, 0x8b, 0x04, 0xde, 0xf8, 0x66, 0x1f, 0x80, 0x8a, 0x9d,
-	0x80, 0xc7, 0x36, 0x3b, 0x45, 0x16, 0x0e, 0xc8, 0x72, 0x8e, 0xf3, 0xaa, 0x58, 0xaf, 0x81, 0xf5,
-	0x5c, 0x7b, 0x71, 0x01, 0xbd, 0xc4, 0x74, 0xbf, 0xd2, 0x3d, 0xdd, 0xa6, 0xf0, 0x01, 0x28, 0xeb,
-	0x81, 0xdf, 0x26, 0x9e, 0xe5, 0xf7, 0xb8, 0xf3, 0xd1, 0x02, 0x3c, 0x00, 0x25, 0x37, 0xe2, 0xb8,
-	0x69, 0x49, 0xb9, 0xf9, 0xcf, 0x51, 0xd8, 0x4e, 0xc7, 0x33, 0x97, 0x3f, 0x6b, 0x82, 0xc6, 0xf9,
-	0xc3, 0x85, 0xd0, 0xda, 0x68, 0xa7, 0xd4, 0xf8, 0xb2, 0x84, 0xa1, 0xab, 0xbd, 0xcf, 0x33, 0x60,
-	0xba, 0x41, 0x31, 0x7c, 0x0b, 0x2a, 0xa9, 0x9f, 0xc9, 0xc3, 0x3c, 0xb1, 0xcc, 0xc9, 0x95, 0x9e,
-	0x4c, 0x00, 0x0d, 0x95, 0x42, 0x85, 0xd4, 0xd1, 0x2e, 0x52, 0x48, 0x42, 0x85, 0x0a, 0x79, 0x47,
-	0x11, 0x9a, 0x60, 0x3e, 0x3d, 0xbf, 0x8f, 0x0a, 0xb3, 0x13, 0x94, 0xf4, 0x74, 0x12, 0x2a, 0x16,
-	0xf1, 0x00, 0xcc, 0x99, 0xc1, 0xc7, 0x05, 0x7b, 0xdc, 0x44, 0xa5, 0xdd, 0x89, 0xd1, 0x58, 0xb3,
-	0x05, 0x60, 0xb2, 0x60, 0x3e, 0x3d, 0xe3, 0x1b, 0xc8, 0xa0, 0x5b, 0x1a, 0x98, 0x1e, 0x06, 0x69,
-	0xf6, 0xfd, 0xf5, 0xc5, 0x96, 0x78, 0xac, 0x5d, 0xf6, 0x65, 0xf1, 0xaa, 0x2f, 0x8b, 0xbf, 0xfa,
-	0xb2, 0xf8, 0x69, 0x20, 0x0b, 0x57, 0x03, 0x59, 0xf8, 0x31, 0x90, 0x85, 0x37, 0x07, 0xd8, 0xf2,
-	0xdb, 0x81, 0xa1, 0x98, 0xc4, 0x56, 0xf9, 0x65, 0x67, 0x19, 0xe6, 0x36, 0x26, 0x6a, 0x77, 0x5f,
-	0xb5, 0x49, 0x33, 0xe8, 0x20, 0xca, 0xee, 0xad, 0x9d, 0xbd, 0x6d, 0x7e, 0x75, 0xf9, 0x3d, 0x17,
-	0x51, 0xa3, 0x14, 0x9d, 0xb4, 0x67, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x44, 0x4f, 0xa6, 0x1c,
-	0x55, 0x07, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x34, 0x1b, 0x91, 0x69, 0xb6, 0x01, 0x6f, 0xca, 0x66, 0xbd, 0xbb, 0x49, 0x09,
+	0x7b, 0x28, 0xfd, 0x61, 0x37, 0xad, 0x44, 0xab, 0x22, 0x0e, 0x6d, 0x38, 0xd0, 0x43, 0xa4, 0xca,
+	0x15, 0x17, 0x2e, 0xa9, 0xed, 0x4c, 0x5c, 0xa3, 0xd8, 0x63, 0x79, 0xc6, 0xa1, 0xb9, 0x21, 0x4e,
+	0x3d, 0x72, 0x84, 0x03, 0x52, 0xff, 0x84, 0x9e, 0xf8, 0x03, 0x38, 0x95, 0x5b, 0x8f, 0x9c, 0x10,
+	0x6a, 0x91, 0xca, 0x9f, 0x81, 0x32, 0x33, 0x76, 0x6c, 0xc7, 0x0e, 0x2e, 0xdc, 0xec, 0x79, 0x9f,
+	0x37, 0xef, 0xfb, 0xde, 0xbc, 0x79, 0x36, 0x78, 0x6d, 0xe9, 0x86, 0x62, 0x20, 0x0f, 0x2a, 0xc6,
+	0xc8, 0x82, 0x0e, 0x51, 0xc6, 0x1d, 0x85, 0x5c, 0xca, 0xae, 0x87, 0x08, 0x12, 0x45, 0x4b, 0x37,
+	0xe4, 0xa9, 0x51, 0x66, 0x46, 0x79, 0xdc, 0x91, 0x5e, 0x1a, 0x08, 0xdb, 0x08, 0x2b, 0x36, 0x36,
+	0xa7, 0xac, 0x8d, 0x4d, 0x06, 0x4b, 0x75, 0x13, 0x99, 0x88, 0x3e, 0x2a, 0xd3, 0x27, 0xbe, 0xfa,
+	0xca, 0x44, 0xc8, 0x1c, 0x41, 0x85, 0xbe, 0xe9, 0xfe, 0x50, 0xd1, 0x9c, 0x09, 0x37, 0xbd, 0xe3,
+	0x3b, 0xf9, 0xae, 0xe9, 0x69, 0x03, 0xa8, 0x8c, 0x3b, 0x3a, 0x24, 0x5a, 0x27, 0x78, 0xe7, 0x54,
+	0x2b, 0x45, 0x20, 0x57, 0x43, 0x81, 0xf6, 0x2f, 0x02, 0xa8, 0xf5, 0xb0, 0xd9, 0xf5, 0xa0, 0x46,
+	0x60, 0x97, 0x5a, 0xc4, 0x7d, 0x50, 0x65, 0x4c, 0x1f, 0x13, 0x8d, 0xc0, 0x86, 0xb0, 0x26, 0xac,
+	0x2f, 0xef, 0xd6, 0x65, 0x26, 0x46, 0x0e, 0xc4, 0xc8, 0x47, 0xce, 0x44, 0x5d, 0x66, 0xe4, 0xd9,
+	0x14, 0x14, 0x3f, 0x07, 0x35, 0x03, 0x39, 0x18, 0x3a, 0xd8, 0xc7, 0xdc, 0xb7, 0xb8, 0xc0, 0x77,
+	0x25, 0x84, 0x99, 0xfb, 0x87, 0xa0, 0x8c, 0x2d, 0xd3, 0x81, 0x5e, 0x63, 0x69, 0x4d, 0x58, 0xaf,
+	0xa8, 0xfc, 0xed, 0xf0, 0xc5, 0xd5, 0x75, 0xab, 0xf0, 0xf7, 0x75, 0xab, 0xf0, 0xfd, 0xe3, 0xcd,
+	0x06, 0x5f, 0x6c, 0xbf, 0x02, 0x2f, 0x13, 0xba, 0x55, 0x88, 0xdd, 0xe9, 0x86, 0xed, 0x1f, 0x59,
+	0x4e, 0x5f, 0xb9, 0x83, 0x59, 0x4e, 0xaf, 0x41, 0x85, 0xe7, 0x64, 0x0d, 0x68, 0x42, 0x15, 0xf5,
+	0x3d, 0xb6, 0x70, 0x32, 0x10, 0x3f, 0x03, 0x2b, 0xdc, 0x68, 0x43, 0x8c, 0x35, 0x73, 0xb1, 0xec,
+	0xe7, 0x8c, 0xed, 0x31, 0xf4, 0xbf, 0xa8, 0x8e, 0x2a, 0x0b, 0x55, 0xff, 0x56, 0x04, 0xef, 0x53,
+	0x1b, 0x3d, 0xbf, 0x3c, 0xb2, 0x93, 0xe7, 0x54, 0xfc, 0x1f, 0xe7, 0xb4, 0xf4, 0x84, 0x73, 0xda,
+	0x01, 0x75, 0xd7, 0x43, 0x68, 0xd8, 0xe7, 0xbd, 0xd6, 0x67, 0x7b, 0x37, 0x4a, 0x6b, 0xc2, 0x7a,
+	0x55, 0x15, 0xa9, 0x2d, 0x9e, 0xc6, 0x11, 0x78, 0x9b, 0xf0, 0x48, 0x84, 0x7f, 0x46, 0x5d, 0xa5,
+	0x98, 0x6b, 0x56, 0x73, 0x94, 0xff, 0xbd, 0xcc, 0x12, 0x68, 0x24, 0x4b, 0x19, 0xd6, 0xf9, 0x67,
+	0x01, 0xac, 0xf6, 0xb0, 0x79, 0xe6, 0xeb, 0xb6, 0x45, 0x7a, 0x16, 0xd6, 0xe1, 0x85, 0x36, 0xb6,
+	0x90, 0xef, 0x2d, 0x2e, 0xf6, 0x01, 0xa8, 0xda, 0x11, 0x78, 0x61, 0xb1, 0x63, 0x64, 0x66, 0x83,
+	0xac, 0xa6, 0x28, 0x6f, 0x08, 0xed, 0x16, 0x78, 0x9b, 0x2a, 0x2f, 0x4c, 0xe0, 0x27, 0x81, 0x36,
+	0x8a, 0x0a, 0x0d, 0x34, 0x86, 0x1e, 0xaf, 0xf0, 0x06, 0xf8, 0x00, 0xfb, 0xfa, 0x37, 0xd0, 0x20,
+	0xfd, 0x64, 0x0e, 0x35, 0x6e, 0xe8, 0x06, 0xa9, 0xec, 0x80, 0x3a, 0xf6, 0x75, 0x4c, 0x2c, 0xe2,
+	0x13, 0x18, 0xc1, 0x8b, 0x14, 0x17, 0x67, 0xb6, 0xd0, 0x23, 0x2b, 0x85, 0xda, 0x55, 0x6a, 0xe1,
+	0x63, 0xd2, 0x42, 0xdd, 0xbf, 0xb2, 0xc2, 0x9f, 0x1c, 0x77, 0xcf, 0xd0, 0x90, 0x7c, 0xab, 0x79,
+	0x90, 0x1f, 0x90, 0xf8, 0x29, 0x28, 0xb9, 0x23, 0xcd, 0xe1, 0x83, 0xe6, 0x8d, 0xcc, 0x46, 0x9b,
+	0x1c, 0x8c, 0x32, 0x3e, 0xda, 0xe4, 0xd3, 0x91, 0xe6, 0x1c, 0x97, 0x6e, 0xff, 0x68, 0x15, 0x54,
+	0xca, 0x8b, 0x5f, 0x82, 0x55, 0xce, 0x0c, 0xfa, 0xb9, 0x6f, 0xc2, 0x8b, 0xc0, 0xa5, 0x1b, 0xb9,
+	0x11, 0xb9, 0x13, 0x64, 0xa7, 0x33, 0x9f, 0x43, 0x98, 0x65, 0x9f, 0xde, 0xf0, 0x53, 0xcf, 0x77,
+	0x12, 0x1d, 0x8c, 0x17, 0xf7, 0xd7, 0x4c, 0x41, 0x71, 0xb1, 0x82, 0x2f, 0x40, 0x2b, 0x23, 0x40,
+	0xa0, 0x41, 0xfc, 0x08, 0x54, 0x09, 0x22, 0xda, 0xa8, 0xef, 0x4e, 0x29, 0x16, 0xab, 0xa4, 0x2e,
+	0xd3, 0x35, 0xea, 0x38, 0x68, 0x4f, 0x22, 0x23, 0xf2, 0x54, 0xf3, 0x34, 0x1b, 0x8b, 0x6f, 0x40,
+	0x45, 0xf3, 0xc9, 0x05, 0xf2, 0x2c, 0x32, 0xe1, 0xf2, 0x66, 0x0b, 0xe2, 0x01, 0x28, 0xbb, 0x94,
+	0xe3, 0xc5, 0x95, 0xe4, 0xf9, 0xcf, 0x9b, 0xcc, 0x76, 0xe2, 0x67, 0xc4, 0xf9, 0xc3, 0x95, 0xa9,
+	0xfa, 0xd9, 0x4e, 0xb1, 0x19, 0xc8, 0x1c, 0x02, 0xe1, 0xbb, 0x7f, 0x95, 0xc1, 0x52, 0x0f, 0x9b,
+	0xe2, 0x39, 0xa8, 0xc6, 0xbe, 0x48, 0x1f, 0xa7, 0x05, 0x4b, 0x8c, 0x7f, 0x69, 0x33, 0x07, 0x14,
+	0x96, 0xe8, 0x1c, 0x54, 0x63, 0xdf, 0x87, 0xac, 0x08, 0x51, 0x48, 0xda, 0xcc, 0x01, 0x85, 0x11,
+	0x0c, 0xf0, 0x3c, 0x3e, 0x04, 0xdf, 0x65, 0x7a, 0x47, 0x28, 0x69, 0x2b, 0x0f, 0x15, 0x06, 0xf1,
+	0x80, 0x98, 0x32, 0xc8, 0x3e, 0xc9, 0xd8, 0x63, 0x1e, 0x95, 0x3a, 0xb9, 0xd1, 0x68, 0x62, 0xf1,
+	0xd9, 0x93, 0x95, 0x58, 0x8c, 0x92, 0xb6, 0xf2, 0x50, 0xd1, 0xc4, 0x52, 0x06, 0x45, 0x56, 0x62,
+	0xf3, 0xa8, 0xd4, 0xc9, 0x8d, 0x86, 0x31, 0x2f, 0x41, 0x3d, 0xf5, 0xde, 0x66, 0x1d, 0x7b, 0x1a,
+	0x2c, 0xed, 0x3d, 0x01, 0x0e, 0x23, 0x0f, 0x81, 0x18, 0xed, 0x21, 0x7e, 0x21, 0x17, 0xf7, 0x24,
+	0x83, 0xa4, 0xcd, 0x1c, 0x50, 0x10, 0x47, 0x7a, 0xf6, 0xdd, 0xe3, 0xcd, 0x86, 0x70, 0xac, 0xde,
+	0xde, 0x37, 0x85, 0xbb, 0xfb, 0xa6, 0xf0, 0xe7, 0x7d, 0x53, 0xf8, 0xe1, 0xa1, 0x59, 0xb8, 0x7b,
+	0x68, 0x16, 0x7e, 0x7f, 0x68, 0x16, 0xbe, 0x3e, 0x30, 0x2d, 0x72, 0xe1, 0xeb, 0xb2, 0x81, 0x6c,
+	0x85, 0xff, 0x60, 0x5a, 0xba, 0xb1, 0x6d, 0x22, 0x65, 0xbc, 0xaf, 0xd8, 0x68, 0xe0, 0x8f, 0x20,
+	0x66, 0xff, 0x93, 0x3b, 0xbb, 0xdb, 0xfc, 0x97, 0x92, 0x4c, 0x5c, 0x88, 0xf5, 0x32, 0x1d, 0xb2,
+	0x7b, 0xff, 0x0c, 0x00, 0x30, 0xaa, 0xbc, 0x62, 0x13, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -540,6 +815,12 @@ type MsgClient interface {
 	UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
+	// RecoverClient defines a rpc handler method for MsgRecoverClient.
+	RecoverClient(ctx context.Context, in *MsgRecoverClient, opts ...grpc.CallOption) (*MsgRecoverClientResponse, error)
+	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
+	IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -588,6 +869,33 @@ func (c *msgClient) SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbeha
 	return out, nil
 }
 
+func (c *msgClient) RecoverClient(ctx context.Context, in *MsgRecoverClient, opts ...grpc.CallOption) (*MsgRecoverClientResponse, error) {
+	out := new(MsgRecoverClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/RecoverClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error) {
+	out := new(MsgIBCSoftwareUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/IBCSoftwareUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error) {
+	out := new(MsgPruneConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/UpdateClientParams", in, out, opts...)
@@ -607,6 +915,12 @@ type MsgServer interface {
 	UpgradeClient(context.Context, *MsgUpgradeClient) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
+	// RecoverClient defines a rpc handler method for MsgRecoverClient.
+	RecoverClient(context.Context, *MsgRecoverClient) (*MsgRecoverClientResponse, error)
+	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
+	IBCSoftwareUpgrade(context.Context, *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(context.Context, *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -627,6 +941,15 @@ func (*UnimplementedMsgServer) UpgradeClient(ctx context.Context, req *MsgUpgrad
 func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitMisbehaviour not implemented")
 }
+func (*UnimplementedMsgServer) RecoverClient(ctx context.Context, req *MsgRecoverClient) (*MsgRecoverClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverClient not implemented")
+}
+func (*UnimplementedMsgServer) IBCSoftwareUpgrade(ctx context.Context, req *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCSoftwareUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) PruneConsensusStates(ctx context.Context, req *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneConsensusStates not implemented")
+}
 func (*UnimplementedMsgServer) UpdateClientParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientParams not implemented")
 }
@@ -707,6 +1030,60 @@ func _Msg_SubmitMisbehaviour_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecoverClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/RecoverClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverClient(ctx, req.(*MsgRecoverClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IBCSoftwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIBCSoftwareUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IBCSoftwareUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/IBCSoftwareUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IBCSoftwareUpgrade(ctx, req.(*MsgIBCSoftwareUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneConsensusStates(ctx, req.(*MsgPruneConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -745,6 +1122,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitMisbehaviour",
 			Handler:    _Msg_SubmitMisbehaviour_Handler,
 		},
+		{
+			MethodName: "RecoverClient",
+			Handler:    _Msg_RecoverClient_Handler,
+		},
+		{
+			MethodName: "IBCSoftwareUpgrade",
+			Handler:    _Msg_IBCSoftwareUpgrade_Handler,
+		},
+		{
+			MethodName: "PruneConsensusStates",
+			Handler:    _Msg_PruneConsensusStates_Handler,
+		},
 		{
 			MethodName: "UpdateClientParams",
 			Handler:    _Msg_UpdateClientParams_Handler,
@@ -1073,7 +1462,7 @@ func (m *MsgSubmitMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecoverClient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1083,37 +1472,41 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecoverClient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecoverClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectClientId) > 0 {
+		i -= len(m.SubjectClientId)
+		copy(dAtA[i:], m.SubjectClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectClientId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecoverClientResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1123,12 +1516,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecoverClientResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecoverClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1136,23 +1529,226 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgIBCSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *MsgIBCSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIBCSoftwareUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCSoftwareUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCSoftwareUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.ClientState != nil {
 		l = m.ClientState.Size()
 		n += 1 + l + sovTx(uint64(l))
@@ -1279,37 +1875,832 @@ func (m *MsgSubmitMisbehaviourResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgRecoverClient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.SubjectClientId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIBCSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgIBCSoftwareUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneConsensusStatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		n += 1 + sovTx(uint64(m.TotalPruned))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientMessage == nil {
+				m.ClientMessage = &types.Any{}
+			}
+			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpgradeClient = append(m.ProofUpgradeClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgradeClient == nil {
+				m.ProofUpgradeClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeConsensusState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpgradeConsensusState = append(m.ProofUpgradeConsensusState[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgradeConsensusState == nil {
+				m.ProofUpgradeConsensusState = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1332,17 +2723,17 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitMisbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1352,31 +2743,27 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1403,10 +2790,10 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &types.Any{}
 			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1463,7 +2850,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,10 +2873,10 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1513,7 +2900,7 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,15 +2923,15 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1572,13 +2959,13 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.SubjectClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1588,27 +2975,23 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientMessage == nil {
-				m.ClientMessage = &types.Any{}
-			}
-			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1663,7 +3046,7 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1686,10 +3069,10 @@ func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1713,7 +3096,7 @@ func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgIBCSoftwareUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,47 +3119,15 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIBCSoftwareUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIBCSoftwareUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1803,16 +3154,13 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1839,82 +3187,14 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
+			if m.UpgradedClientState == nil {
+				m.UpgradedClientState = &types.Any{}
 			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpgradedClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeClient", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgradeClient = append(m.ProofUpgradeClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgradeClient == nil {
-				m.ProofUpgradeClient = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeConsensusState", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgradeConsensusState = append(m.ProofUpgradeConsensusState[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgradeConsensusState == nil {
-				m.ProofUpgradeConsensusState = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1967,7 +3247,7 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIBCSoftwareUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,10 +3270,10 @@ func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIBCSoftwareUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIBCSoftwareUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2017,7 +3297,7 @@ func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneConsensusStates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2040,10 +3320,10 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviour: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneConsensusStates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2079,42 +3359,6 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Misbehaviour == nil {
-				m.Misbehaviour = &types.Any{}
-			}
-			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2167,7 +3411,7 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2190,12 +3434,31 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
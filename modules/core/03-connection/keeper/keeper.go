@@ -95,14 +95,13 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
 // given height.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
-	clientState, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID())
-	if !found {
+	if _, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID()); !found {
 		return 0, errorsmod.Wrapf(
 			clienttypes.ErrClientNotFound, "clientID (%s)", connection.GetClientID(),
 		)
 	}
 
-	timestamp, err := clientState.GetTimestampAtHeight(ctx, k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height)
+	timestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connection.GetClientID(), height)
 	if err != nil {
 		return 0, err
 	}
@@ -110,6 +109,24 @@ func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.Connectio
 	return timestamp, nil
 }
 
+// GetClientDelayPeriods returns the largest time and block delay periods of the connections of the
+// given client. Zero delay periods are returned if the client has no connections.
+func (k Keeper) GetClientDelayPeriods(ctx sdk.Context, clientID string) (timeDelay uint64, blockDelay uint64) {
+	connectionIDs, _ := k.GetClientConnectionPaths(ctx, clientID)
+	for _, connectionID := range connectionIDs {
+		connection, found := k.GetConnection(ctx, connectionID)
+		if !found {
+			continue
+		}
+
+		if connection.DelayPeriod > timeDelay {
+			timeDelay = connection.DelayPeriod
+		}
+	}
+
+	return timeDelay, k.getBlockDelayForPeriod(ctx, timeDelay)
+}
+
 // GetClientConnectionPaths returns all the connection paths stored under a
 // particular client
 func (k Keeper) GetClientConnectionPaths(ctx sdk.Context, clientID string) ([]string, bool) {
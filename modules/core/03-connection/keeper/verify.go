@@ -12,6 +12,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v7/modules/core/33-multihop/types"
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
@@ -26,15 +27,11 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	targetClient, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, targetClient, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(connection.GetCounterparty().GetClientID()))
 	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
 	if err != nil {
@@ -46,8 +43,10 @@ func (k Keeper) VerifyClientState(
 		return err
 	}
 
-	if err := targetClient.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -68,15 +67,11 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	merklePath := commitmenttypes.NewMerklePath(host.FullConsensusStatePath(connection.GetCounterparty().GetClientID(), consensusHeight))
 	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
 	if err != nil {
@@ -88,8 +83,10 @@ func (k Keeper) VerifyClientConsensusState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -110,15 +107,11 @@ func (k Keeper) VerifyConnectionState(
 	counterpartyConnection exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID))
 	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
 	if err != nil {
@@ -135,8 +128,10 @@ func (k Keeper) VerifyConnectionState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -158,15 +153,11 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(portID, channelID))
 	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
 	if err != nil {
@@ -183,8 +174,10 @@ func (k Keeper) VerifyChannelState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -207,15 +200,11 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
@@ -226,8 +215,10 @@ func (k Keeper) VerifyPacketCommitment(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, commitmentBytes,
 	); err != nil {
@@ -250,15 +241,11 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
@@ -269,8 +256,10 @@ func (k Keeper) VerifyPacketAcknowledgement(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
 	); err != nil {
@@ -280,6 +269,47 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.GetClientID()
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, receipt,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -293,15 +323,11 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
@@ -312,8 +338,10 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 		return err
 	}
 
-	if err := clientState.VerifyNonMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyNonMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath,
 	); err != nil {
@@ -335,15 +363,11 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
@@ -354,8 +378,10 @@ func (k Keeper) VerifyNextSequenceRecv(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
 	); err != nil {
@@ -365,9 +391,268 @@ func (k Keeper) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyChannelUpgradeError verifies a proof of the provided upgrade error receipt.
+func (k Keeper) VerifyChannelUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt channeltypes.ErrorReceipt,
+) error {
+	clientID := connection.GetClientID()
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgrade verifies the proof that a particular proposed upgrade has been stored in the upgrade path.
+func (k Keeper) VerifyChannelUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade channeltypes.Upgrade,
+) error {
+	clientID := connection.GetClientID()
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&upgrade)
+	if err != nil {
+		return err
+	}
+
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, proofHeight,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify upgrade for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitments verifies a single batch proof of the commitments of multiple outgoing packets
+// sent on the specified port and specified channel. The commitments are keyed by packet sequence.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(commitments))
+	for sequence, commitmentBytes := range commitments {
+		items[host.PacketCommitmentPath(portID, channelID, sequence)] = commitmentBytes
+	}
+
+	if err := k.verifyBatchMembership(ctx, connection, height, proof, items); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitments verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies a single batch proof of the acknowledgements of multiple incoming
+// packets at the specified port and specified channel. The acknowledgements are keyed by packet sequence.
+func (k Keeper) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	acknowledgements map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(acknowledgements))
+	for sequence, acknowledgement := range acknowledgements {
+		items[host.PacketAcknowledgementPath(portID, channelID, sequence)] = channeltypes.CommitAcknowledgement(acknowledgement)
+	}
+
+	if err := k.verifyBatchMembership(ctx, connection, height, proof, items); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgements verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// verifyBatchMembership verifies a single batch proof of the provided ICS 24 paths and values using the client
+// of the provided connection end. The light client module of the client must support batch proof verification.
+func (k Keeper) verifyBatchMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	items map[string][]byte,
+) error {
+	clientID := connection.GetClientID()
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	k.clientKeeper.ConsumeProofGas(ctx, proof)
+
+	return clientModule.VerifyBatchMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, connection.GetCounterparty().GetPrefix(), items,
+	)
+}
+
+// VerifyMultihopMembership verifies a multi-hop proof of the value stored under the provided ICS 24 path
+// on the counterparty chain at the end of the connection hops. The proofs of the first intermediate chain
+// are verified by the client of the provided connection end at the specified height. The maximum delay
+// period of the connections along the connection hops is enforced.
+func (k Keeper) VerifyMultihopMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path string,
+	value []byte,
+) error {
+	proofs, verifyFirstHop, err := k.getMultihopVerifier(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := multihoptypes.VerifyMembership(
+		k.cdc, proofs, connectionHops, connection.GetCounterparty().GetPrefix(), verifyFirstHop, path, value,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop membership verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyMultihopNonMembership verifies a multi-hop proof of the absence of the provided ICS 24 path
+// on the counterparty chain at the end of the connection hops. The proofs of the first intermediate chain
+// are verified by the client of the provided connection end at the specified height. The maximum delay
+// period of the connections along the connection hops is enforced.
+func (k Keeper) VerifyMultihopNonMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path string,
+) error {
+	proofs, verifyFirstHop, err := k.getMultihopVerifier(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := multihoptypes.VerifyNonMembership(
+		k.cdc, proofs, connectionHops, connection.GetCounterparty().GetPrefix(), verifyFirstHop, path,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop non-membership verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// getMultihopVerifier unmarshals the multi-hop proofs and returns them along with a function verifying
+// the proofs of the first intermediate chain using the client of the provided connection end.
+func (k Keeper) getMultihopVerifier(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+) (multihoptypes.MultihopProofs, multihoptypes.VerifyFirstHopFn, error) {
+	var proofs multihoptypes.MultihopProofs
+	if err := k.cdc.Unmarshal(proof, &proofs); err != nil {
+		return multihoptypes.MultihopProofs{}, nil, errorsmod.Wrapf(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal multi-hop proofs: %v", err)
+	}
+
+	clientID := connection.GetClientID()
+	clientModule, err := k.getActiveLightClientModule(ctx, clientID)
+	if err != nil {
+		return multihoptypes.MultihopProofs{}, nil, err
+	}
+
+	timeDelay, err := proofs.GetMaxDelayPeriod(k.cdc, connection)
+	if err != nil {
+		return multihoptypes.MultihopProofs{}, nil, err
+	}
+
+	blockDelay := k.getBlockDelayForPeriod(ctx, timeDelay)
+
+	// the merkle proofs of every hop are charged before any of them is verified
+	k.clientKeeper.ConsumeProofGas(ctx, proofs.KeyProof.Proof)
+	for _, connectionProof := range proofs.ConnectionProofs {
+		k.clientKeeper.ConsumeProofGas(ctx, connectionProof.Proof)
+	}
+	for _, consensusProof := range proofs.ConsensusProofs {
+		k.clientKeeper.ConsumeProofGas(ctx, consensusProof.Proof)
+	}
+
+	verifyFirstHop := func(proof multihoptypes.MultihopProof) error {
+		return clientModule.VerifyMembership(
+			ctx, clientID, height,
+			timeDelay, blockDelay,
+			proof.Proof, proof.PrefixedKey, proof.Value,
+		)
+	}
+
+	return proofs, verifyFirstHop, nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
+	return k.getBlockDelayForPeriod(ctx, connection.GetDelayPeriod())
+}
+
+// getBlockDelayForPeriod calculates the block delay period from the provided time delay
+// and the maximum expected time per block.
+func (k Keeper) getBlockDelayForPeriod(ctx sdk.Context, timeDelay uint64) uint64 {
 	// expectedTimePerBlock should never be zero, however if it is then return a 0 blcok delay for safety
 	// as the expectedTimePerBlock parameter was not set.
 	expectedTimePerBlock := k.GetParams(ctx).MaxExpectedTimePerBlock
@@ -376,10 +661,29 @@ func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI)
 	}
 	// calculate minimum block delay by dividing time delay period
 	// by the expected time per block. Round up the block delay.
-	timeDelay := connection.GetDelayPeriod()
 	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
 
+// getActiveLightClientModule returns the light client module for the provided client identifier.
+// An error is returned if the client does not exist or is not active.
+func (k Keeper) getActiveLightClientModule(ctx sdk.Context, clientID string) (exported.LightClientModule, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	return clientModule, nil
+}
+
 // getClientStateAndVerificationStore returns the client state and associated KVStore for the provided client identifier.
 // If the client type is localhost then the core IBC KVStore is returned, otherwise the client prefixed store is returned.
 func (k Keeper) getClientStateAndVerificationStore(ctx sdk.Context, clientID string) (exported.ClientState, sdk.KVStore, error) {
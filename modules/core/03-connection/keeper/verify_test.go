@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -379,6 +381,140 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 // TestVerifyPacketAcknowledgement has chainA verify the acknowledgement on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitments() {
+	var (
+		path            *ibctesting.Path
+		packets         []channeltypes.Packet
+		heightDiff      uint64
+		delayTimePeriod uint64
+	)
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"client state not found- changed client ID", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointB.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - changed packet commitment state", func() {
+			packets[1].Data = []byte(ibctesting.InvalidID)
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0))
+			}
+
+			// reset variables
+			heightDiff = 0
+			delayTimePeriod = 0
+			tc.malleate()
+
+			connection := path.EndpointB.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+
+			keys := make([][]byte, len(packets))
+			commitments := make(map[uint64][]byte, len(packets))
+			for i, packet := range packets {
+				keys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				commitments[packet.GetSequence()] = channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+			}
+			proof, proofHeight := suite.chainA.QueryBatchProof(keys)
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitments(
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketCommitmentProofGas verifies that gas is consumed for the proof before it is verified.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentProofGas() {
+	const gasLimit = 1_000_000
+
+	testCases := []struct {
+		name          string
+		proofGasPerOp uint64
+		expOutOfGas   bool
+	}{
+		{"success: proof gas within gas limit", clienttypes.DefaultProofGasCostPerHashOp, false},
+		{"out of gas: proof gas exceeds gas limit", gasLimit, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+
+			params := clienttypes.DefaultParams()
+			params.ProofGasCostPerHashOp = tc.proofGasPerOp
+			suite.chainB.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+			ctx := suite.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+			verify := func() {
+				err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitment(
+					ctx, path.EndpointB.GetConnection(), proofHeight, proof,
+					packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
+				)
+			}
+
+			if tc.expOutOfGas {
+				suite.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "ibc proof hash operations"}, verify)
+			} else {
+				suite.Require().NotPanics(verify)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 	var (
 		path            *ibctesting.Path
@@ -477,12 +613,87 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgements() {
+	var (
+		path       *ibctesting.Path
+		packets    []channeltypes.Packet
+		acks       [][]byte
+		heightDiff uint64
+	)
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - changed acknowledgement", func() {
+			acks[0] = []byte(ibctesting.InvalidID)
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, acks = nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+				acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+			}
+
+			// reset variables
+			heightDiff = 0
+			tc.malleate()
+
+			keys := make([][]byte, len(packets))
+			acknowledgements := make(map[uint64][]byte, len(packets))
+			for i, packet := range packets {
+				keys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				acknowledgements[packet.GetSequence()] = acks[i]
+			}
+			proof, proofHeight := suite.chainB.QueryBatchProof(keys)
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketAcknowledgements(
+				suite.chainA.GetContext(), path.EndpointA.GetConnection(), malleateHeight(proofHeight, heightDiff), proof,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, acknowledgements,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyPacketReceiptAbsence has chainA verify the receipt
 // absence on channelB. The channels on chainA and chainB are fully opened and
 // a packet is sent from chainA to chainB and not received.
 func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	var (
 		path            *ibctesting.Path
+		connection      types.ConnectionEnd
 		packet          channeltypes.Packet
 		heightDiff      uint64
 		delayTimePeriod uint64
@@ -508,9 +719,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			timePerBlock = 1
 		}, false},
 		{"client state not found - changed client ID", func() {
-			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
-			path.EndpointA.SetConnection(connection)
 		}, false},
 		{"consensus state not found - increased proof height", func() {
 			heightDiff = 5
@@ -545,12 +754,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
 
 			// reset variables
+			connection = path.EndpointA.GetConnection()
 			heightDiff = 0
 			delayTimePeriod = 0
 			timePerBlock = 0
 			tc.malleate()
 
-			connection := path.EndpointA.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
 
 			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
@@ -15,4 +15,7 @@ type ClientKeeper interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 	IterateClientStates(ctx sdk.Context, prefix []byte, cb func(string, exported.ClientState) bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	Route(clientID string) (exported.LightClientModule, bool)
+	GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error)
+	ConsumeProofGas(ctx sdk.Context, proof []byte)
 }
@@ -0,0 +1,18 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+)
+
+// EndBlocker prunes the acknowledgements and receipts of upgraded channels, bounded by
+// the prune gas limit set in the channel params. Pruning is disabled if the limit is zero.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	gasLimit := k.GetParams(ctx).PruneGasLimit
+	if gasLimit == 0 {
+		return
+	}
+
+	k.PruneAcknowledgementsWithGasLimit(ctx, gasLimit)
+}
@@ -0,0 +1,79 @@
+package channel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	channel "github.com/cosmos/ibc-go/v7/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+type ChannelTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *ChannelTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestChannelTestSuite(t *testing.T) {
+	suite.Run(t, new(ChannelTestSuite))
+}
+
+func (suite *ChannelTestSuite) TestEndBlocker() {
+	testCases := []struct {
+		name          string
+		pruneGasLimit uint64
+		expPruned     bool
+	}{
+		{"pruning disabled", 0, false},
+		{"acknowledgements pruned", 10_000_000, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainB.GetContext())
+			params.PruneGasLimit = tc.pruneGasLimit
+			channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			channel.EndBlocker(suite.chainB.GetContext(), channelKeeper)
+
+			_, found := channelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+			suite.Require().Equal(!tc.expPruned, found)
+		})
+	}
+}
@@ -31,6 +31,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
 		GetCmdQueryNextSequenceSend(),
+		GetCmdQueryUpgradeError(),
+		GetCmdQueryUpgrade(),
+		GetCmdChannelParams(),
+		GetCmdQueryPruningSequences(),
 	)
 
 	return queryCmd
@@ -46,7 +50,9 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewPruneAcknowledgementsTxCmd(),
+	)
 
 	return txCmd
 }
@@ -491,3 +491,138 @@ func GetCmdQueryNextSequenceSend() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryUpgradeError defines the command to query for the error receipt associated with an upgrade
+func GetCmdQueryUpgradeError() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-error [port-id] [channel-id]",
+		Short: "Query the upgrade error",
+		Long:  "Query the upgrade error for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-error [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			errRes, err := utils.QueryUpgradeError(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(errRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(errRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgrade defines the command to query for the upgrade associated with a port and channel id
+func GetCmdQueryUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [port-id] [channel-id]",
+		Short: "Query the upgrade",
+		Long:  "Query the upgrade for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			upgradeRes, err := utils.QueryUpgrade(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(upgradeRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(upgradeRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChannelParams returns the command handler for ibc channel parameter querying.
+func GetCmdChannelParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc channel parameters",
+		Long:    "Query the current ibc channel parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s %s params", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelParams(cmd.Context(), &types.QueryChannelParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPruningSequences defines the command to query the pruning sequences of a channel.
+func GetCmdQueryPruningSequences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-sequences [port-id] [channel-id]",
+		Short: "Query the pruning sequences of a channel",
+		Long:  "Query the pruning sequence start and end of a channel. Acknowledgements and receipts with a sequence in [start, end) are left to be pruned.",
+		Example: fmt.Sprintf(
+			"%s query %s %s pruning-sequences [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPruningSequencesRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PruningSequences(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
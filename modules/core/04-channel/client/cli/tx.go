@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// NewPruneAcknowledgementsTxCmd defines the command to prune acknowledgements and receipts of an upgraded channel.
+func NewPruneAcknowledgementsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-acknowledgements [port-id] [channel-id] [limit]",
+		Short: "Prune acknowledgements and receipts of an upgraded channel",
+		Long:  "Prune at most limit acknowledgements and receipts of a channel which are no longer needed after the channel has been upgraded.",
+		Example: fmt.Sprintf(
+			"%s tx %s %s prune-acknowledgements transfer channel-0 100", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneAcknowledgements(args[0], args[1], limit, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -231,6 +231,90 @@ func queryNextSequenceSendABCI(clientCtx client.Context, portID, channelID strin
 	return types.NewQueryNextSequenceSendResponse(sequence, proofBz, proofHeight), nil
 }
 
+// QueryUpgradeError returns the upgrade error receipt.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryUpgradeError(
+	clientCtx client.Context, portID, channelID string, prove bool,
+) (*types.QueryUpgradeErrorResponse, error) {
+	if prove {
+		return queryUpgradeErrorABCI(clientCtx, portID, channelID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryUpgradeErrorRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+
+	return queryClient.UpgradeError(context.Background(), req)
+}
+
+func queryUpgradeErrorABCI(clientCtx client.Context, portID, channelID string) (*types.QueryUpgradeErrorResponse, error) {
+	key := host.ChannelUpgradeErrorKey(portID, channelID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgrade error receipt exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrUpgradeErrorNotFound, "portID (%s), channelID (%s)", portID, channelID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var errorReceipt types.ErrorReceipt
+	if err := cdc.Unmarshal(value, &errorReceipt); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryUpgradeErrorResponse(errorReceipt, proofBz, proofHeight), nil
+}
+
+// QueryUpgrade returns the upgrade.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryUpgrade(
+	clientCtx client.Context, portID, channelID string, prove bool,
+) (*types.QueryUpgradeResponse, error) {
+	if prove {
+		return queryUpgradeABCI(clientCtx, portID, channelID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryUpgradeRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+
+	return queryClient.Upgrade(context.Background(), req)
+}
+
+func queryUpgradeABCI(clientCtx client.Context, portID, channelID string) (*types.QueryUpgradeResponse, error) {
+	key := host.ChannelUpgradeKey(portID, channelID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgrade exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrUpgradeNotFound, "portID (%s), channelID (%s)", portID, channelID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var upgrade types.Upgrade
+	if err := cdc.Unmarshal(value, &upgrade); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryUpgradeResponse(upgrade, proofBz, proofHeight), nil
+}
+
 // QueryPacketCommitment returns a packet commitment.
 // If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
 // it uses the gRPC query client.
@@ -12,6 +12,7 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+		ch.UpgradeSequence = channel.UpgradeSequence
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
 	}
 	for _, ack := range gs.Acknowledgements {
@@ -32,19 +33,33 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, rs := range gs.RecvStartSequences {
+		k.SetRecvStartSequence(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+	}
+	for _, ps := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
+	for _, ps := range gs.PruningSequenceEnds {
+		k.SetPruningSequenceEnd(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      k.GetAllPacketAcks(ctx),
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              k.GetAllPacketReceipts(ctx),
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		Params:                k.GetParams(ctx),
+		RecvStartSequences:    k.GetAllRecvStartSequences(ctx),
+		PruningSequenceStarts: k.GetAllPruningSequenceStarts(ctx),
+		PruningSequenceEnds:   k.GetAllPruningSequenceEnds(ctx),
 	}
 }
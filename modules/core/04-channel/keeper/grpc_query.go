@@ -422,7 +422,7 @@ func (k Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -579,6 +579,78 @@ func (k Keeper) NextSequenceSend(c context.Context, req *types.QueryNextSequence
 	return types.NewQueryNextSequenceSendResponse(sequence, nil, selfHeight), nil
 }
 
+// UpgradeError implements the Query/UpgradeError gRPC method
+func (k Keeper) UpgradeError(c context.Context, req *types.QueryUpgradeErrorRequest) (*types.QueryUpgradeErrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	found := k.HasChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	receipt, found := k.GetUpgradeErrorReceipt(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeErrorNotFound, "port-id %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeErrorResponse(receipt, nil, selfHeight), nil
+}
+
+// Upgrade implements the Query/Upgrade gRPC method
+func (k Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*types.QueryUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	found := k.HasChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeNotFound, "port-id %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
+}
+
+// ChannelParams implements the Query/ChannelParams gRPC method.
+func (k Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryChannelParamsResponse{
+		Params: &params,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -590,3 +662,43 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// PruningSequences implements the Query/PruningSequences gRPC method
+func (k Keeper) PruningSequences(c context.Context, req *types.QueryPruningSequencesRequest) (*types.QueryPruningSequencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	start, found := k.GetPruningSequenceStart(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPruningSequenceStartNotFound, "port-id %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	end, found := k.GetPruningSequenceEnd(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPruningSequenceEndNotFound, "port-id %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryPruningSequencesResponse{
+		PruningSequenceStart: start,
+		PruningSequenceEnd:   end,
+	}, nil
+}
@@ -114,11 +114,6 @@ func (k Keeper) ChanOpenTry(
 	proofInit []byte,
 	proofHeight exported.Height,
 ) (string, *capabilitytypes.Capability, error) {
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", nil, errorsmod.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
-	}
-
 	// generate a new channel
 	channelID := k.GenerateChannelIdentifier(ctx)
 
@@ -155,7 +150,10 @@ func (k Keeper) ChanOpenTry(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(connectionHops, connectionEnd, proofInit)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
 	// (i.e self)
@@ -165,19 +163,14 @@ func (k Keeper) ChanOpenTry(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, connectionHops, connectionEnd, proofHeight, proofInit,
 		counterparty.PortId, counterparty.ChannelId, expectedChannel,
 	); err != nil {
 		return "", nil, err
 	}
 
-	var (
-		capKey *capabilitytypes.Capability
-		err    error
-	)
-
-	capKey, err = k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if err != nil {
 		return "", nil, errorsmod.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
 	}
@@ -249,7 +242,10 @@ func (k Keeper) ChanOpenAck(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, proofTry)
+	if err != nil {
+		return err
+	}
 
 	// counterparty of the counterparty channel end (i.e self)
 	expectedCounterparty := types.NewCounterparty(portID, channelID)
@@ -258,8 +254,8 @@ func (k Keeper) ChanOpenAck(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofTry,
+	return k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, proofTry,
 		channel.Counterparty.PortId, counterpartyChannelID,
 		expectedChannel)
 }
@@ -328,7 +324,10 @@ func (k Keeper) ChanOpenConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, proofAck)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -336,8 +335,8 @@ func (k Keeper) ChanOpenConfirm(
 		counterpartyHops, channel.Version,
 	)
 
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofAck,
+	return k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, proofAck,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel)
 }
@@ -431,6 +430,7 @@ func (k Keeper) ChanCloseConfirm(
 	chanCap *capabilitytypes.Capability,
 	proofInit []byte,
 	proofHeight exported.Height,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
 		return errorsmod.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)")
@@ -457,16 +457,20 @@ func (k Keeper) ChanCloseConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, proofInit)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
 		types.CLOSED, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, proofInit,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -309,6 +309,7 @@ func (suite *KeeperTestSuite) TestChanOpenAck() {
 		counterpartyChannelID string
 		channelCap            *capabilitytypes.Capability
 		heightDiff            uint64
+		malleateChannel       func()
 	)
 
 	testCases := []testCase{
@@ -361,10 +362,13 @@ func (suite *KeeperTestSuite) TestChanOpenAck() {
 
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 
-			// set the channel's connection hops to wrong connection ID
-			channel := path.EndpointA.GetChannel()
-			channel.ConnectionHops[0] = doesnotexist
-			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+			// set the channel's connection hops to wrong connection ID once no more blocks are committed,
+			// as the channel would otherwise break the channel connection hops invariant
+			malleateChannel = func() {
+				channel := path.EndpointA.GetChannel()
+				channel.ConnectionHops[0] = doesnotexist
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+			}
 		}, false},
 		{"connection is not OPEN", func() {
 			suite.coordinator.SetupClients(path)
@@ -441,6 +445,7 @@ func (suite *KeeperTestSuite) TestChanOpenAck() {
 			suite.SetupTest()          // reset
 			counterpartyChannelID = "" // must be explicitly changed in malleate
 			heightDiff = 0             // must be explicitly changed
+			malleateChannel = nil      // must be explicitly changed
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 
 			tc.malleate()
@@ -458,6 +463,10 @@ func (suite *KeeperTestSuite) TestChanOpenAck() {
 			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID)
 			proof, proofHeight := suite.chainB.QueryProof(channelKey)
 
+			if malleateChannel != nil {
+				malleateChannel()
+			}
+
 			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanOpenAck(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap, path.EndpointB.ChannelConfig.Version, counterpartyChannelID,
 				proof, malleateHeight(proofHeight, heightDiff),
@@ -718,9 +727,10 @@ func (suite *KeeperTestSuite) TestChanCloseInit() {
 // bypassed on chainA by setting the channel state in the ChannelKeeper.
 func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 	var (
-		path       *ibctesting.Path
-		channelCap *capabilitytypes.Capability
-		heightDiff uint64
+		path                        *ibctesting.Path
+		channelCap                  *capabilitytypes.Capability
+		heightDiff                  uint64
+		counterpartyUpgradeSequence uint64
 	)
 
 	testCases := []testCase{
@@ -731,6 +741,28 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 			err := path.EndpointA.SetChannelState(types.CLOSED)
 			suite.Require().NoError(err)
 		}, true},
+		{"success with upgrade sequence", func() {
+			suite.coordinator.Setup(path)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.CLOSED
+			channel.UpgradeSequence = 1
+			path.EndpointA.SetChannel(channel)
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			counterpartyUpgradeSequence = 1
+		}, true},
+		{"failure: invalid counterparty upgrade sequence", func() {
+			suite.coordinator.Setup(path)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			err := path.EndpointA.SetChannelState(types.CLOSED)
+			suite.Require().NoError(err)
+
+			counterpartyUpgradeSequence = 1
+		}, false},
 		{"channel doesn't exist", func() {
 			// any non-nil values work for connections
 			path.EndpointA.ChannelID = ibctesting.FirstChannelID
@@ -799,8 +831,9 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
-			suite.SetupTest() // reset
-			heightDiff = 0    // must explicitly be changed
+			suite.SetupTest()               // reset
+			heightDiff = 0                  // must explicitly be changed
+			counterpartyUpgradeSequence = 0 // must explicitly be changed
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 
 			tc.malleate()
@@ -810,7 +843,7 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 
 			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanCloseConfirm(
 				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID, channelCap,
-				proof, malleateHeight(proofHeight, heightDiff),
+				proof, malleateHeight(proofHeight, heightDiff), counterpartyUpgradeSequence,
 			)
 
 			if tc.expPass {
@@ -192,6 +192,12 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// setTimeoutReceipt sets the timeout receipt of a timed-out packet received on an ORDERED_ALLOW_TIMEOUT channel to the store
+func (k Keeper) setTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -216,6 +222,12 @@ func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
 }
 
+// deletePacketReceipt deletes a packet receipt from the store
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // SetPacketAcknowledgement sets the packet ack hash to the store
 func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -238,6 +250,66 @@ func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
+// deletePacketAcknowledgement deletes the packet ack hash from the store
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
+// GetRecvStartSequence gets a channel's recv start sequence from the store. Packets with a lower
+// sequence are considered received on UNORDERED channels.
+func (k Keeper) GetRecvStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.getSequence(ctx, host.RecvStartSequenceKey(portID, channelID))
+}
+
+// SetRecvStartSequence sets a channel's recv start sequence to the store
+func (k Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	k.setSequence(ctx, host.RecvStartSequenceKey(portID, channelID), sequence)
+}
+
+// GetPruningSequenceStart gets a channel's next sequence to prune from the store
+func (k Keeper) GetPruningSequenceStart(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.getSequence(ctx, host.PruningSequenceStartKey(portID, channelID))
+}
+
+// SetPruningSequenceStart sets a channel's next sequence to prune to the store
+func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	k.setSequence(ctx, host.PruningSequenceStartKey(portID, channelID), sequence)
+}
+
+// HasPruningSequenceStart returns true if the pruning sequence start is set for the channel
+func (k Keeper) HasPruningSequenceStart(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.PruningSequenceStartKey(portID, channelID))
+}
+
+// GetPruningSequenceEnd gets the sequence up to which (exclusive) a channel's acknowledgements
+// and receipts can be pruned from the store
+func (k Keeper) GetPruningSequenceEnd(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.getSequence(ctx, host.PruningSequenceEndKey(portID, channelID))
+}
+
+// SetPruningSequenceEnd sets the sequence up to which (exclusive) a channel's acknowledgements
+// and receipts can be pruned to the store
+func (k Keeper) SetPruningSequenceEnd(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	k.setSequence(ctx, host.PruningSequenceEndKey(portID, channelID), sequence)
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, sdk.Uint64ToBigEndian(sequence))
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -294,6 +366,31 @@ func (k Keeper) GetAllPacketAckSeqs(ctx sdk.Context) (seqs []types.PacketSequenc
 	return seqs
 }
 
+// GetAllRecvStartSequences returns all stored recv start sequences.
+func (k Keeper) GetAllRecvStartSequences(ctx sdk.Context) []types.PacketSequence {
+	return k.getAllSequences(ctx, host.KeyRecvStartSequence)
+}
+
+// GetAllPruningSequenceStarts returns all stored pruning sequence starts.
+func (k Keeper) GetAllPruningSequenceStarts(ctx sdk.Context) []types.PacketSequence {
+	return k.getAllSequences(ctx, host.KeyPruningSequenceStart)
+}
+
+// GetAllPruningSequenceEnds returns all stored pruning sequence ends.
+func (k Keeper) GetAllPruningSequenceEnds(ctx sdk.Context) []types.PacketSequence {
+	return k.getAllSequences(ctx, host.KeyPruningSequenceEnd)
+}
+
+func (k Keeper) getAllSequences(ctx sdk.Context, prefix string) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(prefix+"/"))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, sequence uint64) bool {
+		seqs = append(seqs, types.NewPacketSequence(portID, channelID, sequence))
+		return false
+	})
+	return seqs
+}
+
 // IteratePacketCommitment provides an iterator over all PacketCommitment objects. For each
 // packet commitment, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -479,6 +576,122 @@ func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string)
 	return porttypes.GetModuleOwner(modules), capability, nil
 }
 
+// GetUpgradeErrorReceipt returns the upgrade error receipt for the provided port and channel identifiers.
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeErrorKey(portID, channelID))
+	if bz == nil {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+
+	return errorReceipt, true
+}
+
+// setUpgradeErrorReceipt sets the provided error receipt in store using the port and channel identifiers.
+func (k Keeper) setUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ChannelUpgradeErrorKey(portID, channelID), bz)
+}
+
+// hasUpgrade returns true if a proposed upgrade exists in store
+func (k Keeper) hasUpgrade(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelUpgradeKey(portID, channelID))
+}
+
+// GetUpgrade returns the proposed upgrade for the provided port and channel identifiers.
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetUpgrade sets the proposed upgrade using the provided port and channel identifiers.
+func (k Keeper) SetUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelUpgradeKey(portID, channelID), bz)
+}
+
+// deleteUpgrade deletes the upgrade for the provided port and channel identifiers.
+func (k Keeper) deleteUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelUpgradeKey(portID, channelID))
+}
+
+// GetCounterpartyUpgrade gets the counterparty upgrade from the store.
+func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetCounterpartyUpgrade sets the counterparty upgrade in the store.
+func (k Keeper) SetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelCounterpartyUpgradeKey(portID, channelID), bz)
+}
+
+// deleteCounterpartyUpgrade deletes the counterparty upgrade in the store.
+func (k Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+}
+
+// deleteUpgradeInfo deletes all auxiliary upgrade information.
+func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, portID, channelID string) {
+	k.deleteUpgrade(ctx, portID, channelID)
+	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
+}
+
+// HasInflightPackets returns true if there are packet commitments stored at the specified
+// port and channel, and false otherwise.
+func (k Keeper) HasInflightPackets(ctx sdk.Context, portID, channelID string) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(host.PacketCommitmentPrefixPath(portID, channelID)))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	return iterator.Valid()
+}
+
+// GetParams returns the total set of the channel parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil { // only panic on unset params and not on empty params
+		panic("channel params are not set in store")
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the channel parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
+
 // common functionality for IteratePacketCommitment and IteratePacketAcknowledgement
 func (k Keeper) iterateHashes(ctx sdk.Context, iterator db.Iterator, cb func(portID, channelID string, sequence uint64, hash []byte) bool) {
 	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
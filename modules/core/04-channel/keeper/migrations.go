@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams migrates from consensus version 5 to 6.
+// This migration sets the default channel params in the ibc module's state
+// so that channel upgrades use a valid upgrade timeout.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params")
+	return nil
+}
@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// TestMigrateParams tests that the default channel params are set by the migration
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper)
+	err := migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+
+	params := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetParams(ctx)
+	suite.Require().Equal(types.DefaultParams(), params)
+}
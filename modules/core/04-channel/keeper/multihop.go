@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v7/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// isMultihop returns true if the provided connection hops describe a multi-hop channel.
+func isMultihop(connectionHops []string) bool {
+	return len(connectionHops) > 1
+}
+
+// getCounterpartyConnectionHops returns the expected connection hops of the counterparty channel end.
+// For multi-hop channels the counterparty connection hops are read from the connection proofs of the
+// provided multi-hop proof, which are verified along with the counterparty channel end.
+func (k Keeper) getCounterpartyConnectionHops(connectionHops []string, connectionEnd exported.ConnectionI, proof []byte) ([]string, error) {
+	if !isMultihop(connectionHops) {
+		return []string{connectionEnd.GetCounterparty().GetConnectionID()}, nil
+	}
+
+	proofs, err := k.unmarshalMultihopProofs(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return proofs.GetCounterpartyConnectionHops(k.cdc, connectionEnd)
+}
+
+// getProofHeightAndTimestamp returns the height and timestamp of the counterparty chain at which the provided
+// proof was generated. For multi-hop channels these are read from the consensus state of the counterparty chain
+// stored on the last intermediate chain, which is verified along with the proof.
+func (k Keeper) getProofHeightAndTimestamp(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+) (exported.Height, uint64, error) {
+	if !isMultihop(connectionHops) {
+		proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return proofHeight, proofTimestamp, nil
+	}
+
+	proofs, err := k.unmarshalMultihopProofs(proof)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	consensusState, height, err := proofs.GetCounterpartyConsensusState(k.cdc)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return height, consensusState.GetTimestamp(), nil
+}
+
+// verifyChannelState verifies a proof of the counterparty channel end, using a multi-hop proof
+// if the channel has more than one connection hop.
+func (k Keeper) verifyChannelState(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel types.Channel,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyChannelState(ctx, connectionEnd, proofHeight, proof, portID, channelID, channel)
+	}
+
+	bz, err := k.cdc.Marshal(&channel)
+	if err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.ChannelPath(portID, channelID), bz)
+}
+
+// verifyPacketCommitment verifies a proof of a packet commitment on the counterparty chain, using a
+// multi-hop proof if the channel has more than one connection hop.
+func (k Keeper) verifyPacketCommitment(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitment []byte,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketCommitment(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, commitment)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketCommitmentPath(portID, channelID, sequence), commitment)
+}
+
+// verifyPacketAcknowledgement verifies a proof of a packet acknowledgement on the counterparty chain,
+// using a multi-hop proof if the channel has more than one connection hop.
+func (k Keeper) verifyPacketAcknowledgement(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, acknowledgement)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketAcknowledgementPath(portID, channelID, sequence), types.CommitAcknowledgement(acknowledgement))
+}
+
+// verifyPacketReceipt verifies a proof of a packet receipt on the counterparty chain, using a
+// multi-hop proof if the channel has more than one connection hop.
+func (k Keeper) verifyPacketReceipt(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketReceipt(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, receipt)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketReceiptPath(portID, channelID, sequence), receipt)
+}
+
+// verifyPacketReceiptAbsence verifies a proof of the absence of a packet receipt on the counterparty chain,
+// using a multi-hop proof if the channel has more than one connection hop.
+func (k Keeper) verifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketReceiptAbsence(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence)
+	}
+
+	return k.connectionKeeper.VerifyMultihopNonMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketReceiptPath(portID, channelID, sequence))
+}
+
+// verifyNextSequenceRecv verifies a proof of the next receive sequence on the counterparty chain,
+// using a multi-hop proof if the channel has more than one connection hop.
+func (k Keeper) verifyNextSequenceRecv(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd exported.ConnectionI,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyNextSequenceRecv(ctx, connectionEnd, proofHeight, proof, portID, channelID, nextSequenceRecv)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.NextSequenceRecvPath(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv))
+}
+
+// unmarshalMultihopProofs unmarshals the provided multi-hop proofs.
+func (k Keeper) unmarshalMultihopProofs(proof []byte) (multihoptypes.MultihopProofs, error) {
+	var proofs multihoptypes.MultihopProofs
+	if err := k.cdc.Unmarshal(proof, &proofs); err != nil {
+		return multihoptypes.MultihopProofs{}, errorsmod.Wrapf(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal multi-hop proofs: %v", err)
+	}
+
+	return proofs, nil
+}
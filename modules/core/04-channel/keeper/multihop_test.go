@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+// setupMultihopPath creates a coordinator with 3 test chains and returns a multi-hop path
+// from the first to the last chain with OPEN connections on each hop.
+func (suite *KeeperTestSuite) setupMultihopPath() *ibctesting.MultihopPath {
+	coordinator := ibctesting.NewCoordinator(suite.T(), 3)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := coordinator.GetChain(ibctesting.GetChainID(3))
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	for _, hop := range path.Paths {
+		coordinator.SetupConnections(hop)
+	}
+
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockPort
+
+	return path
+}
+
+// TestMultihopChannelHandshake tests the opening and closing handshake of a channel over
+// two connection hops.
+func (suite *KeeperTestSuite) TestMultihopChannelHandshake() {
+	var (
+		path           *ibctesting.MultihopPath
+		connectionHops []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: ordered channel", func() {
+			path.SetChannelOrdered()
+		}, true},
+		{"channel ordering does not match counterparty", func() {
+			path.EndpointB.ChannelConfig.Order = types.ORDERED
+		}, false},
+		{"connection hops do not match the proven connections", func() {
+			connectionHops[1] = ibctesting.FirstConnectionID + "0"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			path = suite.setupMultihopPath()
+			connectionHops = path.EndpointB.ConnectionHops()
+
+			tc.malleate()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			if !tc.expPass {
+				chainC := path.EndpointB.Chain
+				channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				proof, proofHeight := path.EndpointB.QueryMultihopProof(channelKey)
+
+				counterparty := types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				_, _, err = chainC.App.GetIBCKeeper().ChannelKeeper.ChanOpenTry(
+					chainC.GetContext(), path.EndpointB.ChannelConfig.Order, connectionHops,
+					path.EndpointB.ChannelConfig.PortID, chainC.GetPortCapability(ibctesting.MockPort), counterparty, path.EndpointA.ChannelConfig.Version,
+					proof, proofHeight,
+				)
+				suite.Require().Error(err)
+				return
+			}
+
+			err = path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.ChanOpenAck()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanOpenConfirm()
+			suite.Require().NoError(err)
+
+			channelA := path.EndpointA.GetChannel()
+			channelB := path.EndpointB.GetChannel()
+			suite.Require().Equal(types.OPEN, channelA.State)
+			suite.Require().Equal(types.OPEN, channelB.State)
+			suite.Require().Equal(path.EndpointA.ConnectionHops(), channelA.ConnectionHops)
+			suite.Require().Equal(path.EndpointB.ConnectionHops(), channelB.ConnectionHops)
+			suite.Require().Equal(path.EndpointB.ChannelID, channelA.Counterparty.ChannelId)
+			suite.Require().Equal(path.EndpointA.ChannelID, channelB.Counterparty.ChannelId)
+
+			err = path.EndpointA.ChanCloseInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanCloseConfirm()
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(types.CLOSED, path.EndpointB.GetChannel().State)
+		})
+	}
+}
+
+// TestMultihopPacketFlow tests sending, receiving and acknowledging a packet over a
+// multi-hop channel.
+func (suite *KeeperTestSuite) TestMultihopPacketFlow() {
+	path := suite.setupMultihopPath()
+	path.EndpointA.Chain.Coordinator.CreateMultihopChannels(path)
+
+	chainA := path.EndpointA.Chain
+	chainC := path.EndpointB.Chain
+
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	ack, found := chainC.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(chainC.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(types.CommitAcknowledgement(ibcmock.MockAcknowledgement.Acknowledgement()), ack)
+
+	commitment := chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Nil(commitment)
+
+	// a proof of a different packet commitment must fail verification
+	packet.Sequence++
+	proof, proofHeight := path.EndpointB.QueryMultihopProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	channelCap := chainC.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+	err = chainC.App.GetIBCKeeper().ChannelKeeper.RecvPacket(chainC.GetContext(), channelCap, packet, proof, proofHeight)
+	suite.Require().Error(err)
+}
+
+// TestMultihopTimeoutPacket tests timing out a packet over a multi-hop channel using the
+// height of the counterparty chain.
+func (suite *KeeperTestSuite) TestMultihopTimeoutPacket() {
+	testCases := []struct {
+		msg     string
+		ordered bool
+	}{
+		{"unordered channel", false},
+		{"ordered channel", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			path := suite.setupMultihopPath()
+			if tc.ordered {
+				path.SetChannelOrdered()
+			}
+			path.EndpointA.Chain.Coordinator.CreateMultihopChannels(path)
+
+			chainA := path.EndpointA.Chain
+			chainC := path.EndpointB.Chain
+
+			timeoutHeight := clienttypes.GetSelfHeight(chainC.GetContext()).Increment().(clienttypes.Height)
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			// advance the counterparty chain past the timeout height
+			chainC.Coordinator.CommitNBlocks(chainC, 3)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+
+			commitment := chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Nil(commitment)
+
+			if tc.ordered {
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			}
+		})
+	}
+}
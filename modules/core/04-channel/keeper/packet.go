@@ -5,11 +5,15 @@ import (
 	"strconv"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
+
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v7/internal/collections"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -45,6 +49,25 @@ func (k Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	if maxPacketDataBytes := k.GetParams(ctx).MaxPacketDataBytesForPort(sourcePort); maxPacketDataBytes != 0 && uint64(len(data)) > maxPacketDataBytes {
+		// the rejection is reported through telemetry and logs, as events are discarded with the failed transaction
+		k.Logger(ctx).Info("packet rejected", "port-id", sourcePort, "channel-id", sourceChannel, "data-size", len(data), "max-packet-data-bytes", maxPacketDataBytes)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "packet", "rejected"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.LabelPortID, sourcePort),
+				telemetry.NewLabel(types.LabelChannelID, sourceChannel),
+			},
+		)
+
+		return 0, errorsmod.Wrapf(
+			types.ErrPacketDataTooLarge,
+			"packet data size (%d bytes) exceeds maximum of %d bytes for port ID (%s)", len(data), maxPacketDataBytes, sourcePort,
+		)
+	}
+
 	sequence, found := k.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, errorsmod.Wrapf(
@@ -76,25 +99,28 @@ func (k Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
-	// check if packet is timed out on the receiving chain
-	latestHeight := clientState.GetLatestHeight()
-	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
-		return 0, errorsmod.Wrapf(
-			types.ErrPacketTimeout,
-			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
-		)
-	}
+	// check if packet is timed out on the receiving chain, the client of the first connection hop
+	// does not track the receiving chain of multi-hop channels
+	if !isMultihop(channel.ConnectionHops) {
+		latestHeight := clientState.GetLatestHeight()
+		if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
+			return 0, errorsmod.Wrapf(
+				types.ErrPacketTimeout,
+				"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
+			)
+		}
 
-	latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
-	if err != nil {
-		return 0, err
-	}
+		latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+		if err != nil {
+			return 0, err
+		}
 
-	if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
-		return 0, errorsmod.Wrapf(
-			types.ErrPacketTimeout,
-			"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
-		)
+		if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
+			return 0, errorsmod.Wrapf(
+				types.ErrPacketTimeout,
+				"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
+			)
+		}
 	}
 
 	commitment := types.CommitPacket(k.cdc, packet)
@@ -124,17 +150,108 @@ func (k Keeper) RecvPacket(
 	packet exported.PacketI,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.recvPacket(ctx, chanCap, packet, func(channel types.Channel, connectionEnd exported.ConnectionI) error {
+		commitment := types.CommitPacket(k.cdc, packet)
+
+		// verify that the counterparty did commit to sending this packet
+		if err := k.verifyPacketCommitment(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			commitment,
+		); err != nil {
+			return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+		}
+
+		return nil
+	})
+}
+
+// RecvPackets is called by a module in order to receive & process multiple IBC packets sent on the
+// corresponding channel end on the counterparty chain. The packet commitments of all packets are verified
+// with a single batch proof, after which each packet is received as in RecvPacket. The packets must be
+// received on the same channel and have distinct sequences.
+//
+// The error of each packet is returned in the order of the packets and the state changes of a packet are
+// only written if it was received. An error is returned if the batch proof cannot be verified.
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, connectionEnd, err := k.getBatchChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := make(map[uint64][]byte, len(packets))
+	for _, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packets must be received on the same channel (%s/%s ≠ %s/%s)", packet.GetDestPort(), packet.GetDestChannel(), portID, channelID)
+		}
+
+		if _, found := commitments[packet.GetSequence()]; found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+
+		commitments[packet.GetSequence()] = types.CommitPacket(k.cdc, packet)
+	}
+
+	// the commitments are proven under the counterparty port and channel, the source of each packet is checked when it is received
+	if err := k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, commitments,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	packetErrs := make([]error, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		// the packet commitment has been verified by the batch proof
+		packetErrs[i] = k.recvPacket(cacheCtx, chanCap, packet, func(types.Channel, exported.ConnectionI) error { return nil })
+		if packetErrs[i] == nil || packetErrs[i] == types.ErrTimeoutReceiptWritten {
+			writeFn()
+		}
+	}
+
+	return packetErrs, nil
+}
+
+// recvPacket receives the packet after verifying it with the provided packet verifier. The packet verifier
+// is expected to verify that the counterparty committed to sending the packet.
+func (k Keeper) recvPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyPacket packetVerifier,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
-		return errorsmod.Wrapf(
-			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
-		)
+	if !collections.Contains(channel.State, []types.State{types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected channel state to be one of [%s, %s, %s], but got %s", types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
+	}
+
+	// in the case of the channel being in FLUSHING we need to ensure that the the counterparty last sequence send hasn't been passed
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if found {
+		counterpartyNextSequenceSend := counterpartyUpgrade.NextSequenceSend
+
+		// only error if the counterparty next sequence send is set (> 0)
+		if counterpartyNextSequenceSend != 0 && packet.GetSequence() >= counterpartyNextSequenceSend {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "failed to receive packet, cannot flush packet at sequence greater than or equal to counterparty next sequence send (%d) ≥ (%d).", packet.GetSequence(), counterpartyNextSequenceSend)
+		}
 	}
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
@@ -176,40 +293,39 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timeouted by comparing it with the latest height of the chain
+	// check if packet timeouted by comparing it with the latest height and timestamp of the chain
+	var timeoutErr error
 	selfHeight := clienttypes.GetSelfHeight(ctx)
 	timeoutHeight := packet.GetTimeoutHeight()
 	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
-		return errorsmod.Wrapf(
+		timeoutErr = errorsmod.Wrapf(
 			types.ErrPacketTimeout,
 			"block height >= packet timeout height (%s >= %s)", selfHeight, timeoutHeight,
 		)
-	}
-
-	// check if packet timeouted by comparing it with the latest timestamp of the chain
-	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
-		return errorsmod.Wrapf(
+	} else if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+		timeoutErr = errorsmod.Wrapf(
 			types.ErrPacketTimeout,
 			"block timestamp >= packet timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
 		)
 	}
 
-	commitment := types.CommitPacket(k.cdc, packet)
+	// timed-out packets are still received on ORDERED_ALLOW_TIMEOUT channels in order to write a timeout receipt
+	if timeoutErr != nil && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
+		return timeoutErr
+	}
 
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+	if err := verifyPacket(channel, connectionEnd); err != nil {
+		return err
 	}
 
 	switch channel.Ordering {
 	case types.UNORDERED:
-		// check if the packet receipt has been received already for unordered channels
+		// check if the packet receipt has been received already for unordered channels. Packets below the
+		// recv start sequence have been received before the last channel upgrade and their receipts may
+		// have been pruned.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
+		recvStartSequence, _ := k.GetRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if found || packet.GetSequence() < recvStartSequence {
 			emitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
@@ -223,7 +339,7 @@ func (k Keeper) RecvPacket(
 		// it's just a single store key set to an empty string to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -256,6 +372,24 @@ func (k Keeper) RecvPacket(
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
 
+		if timeoutErr != nil {
+			// The packet has timed out on an ORDERED_ALLOW_TIMEOUT channel. A timeout receipt is written
+			// so the sending chain can prove the timeout, and the packet must not be executed.
+			k.setTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"timeout receipt written",
+				"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			emitWriteTimeoutReceiptEvent(ctx, packet, channel)
+
+			return types.ErrTimeoutReceiptWritten
+		}
 	}
 
 	// log that a packet has been received & executed
@@ -296,11 +430,8 @@ func (k Keeper) WriteAcknowledgement(
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
-		return errorsmod.Wrapf(
-			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
-		)
+	if !collections.Contains(channel.State, []types.State{types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected one of [%s, %s, %s], got %s", types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
 	}
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
@@ -354,7 +485,7 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED and ORDERED_ALLOW_TIMEOUT channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -362,6 +493,86 @@ func (k Keeper) AcknowledgePacket(
 	acknowledgement []byte,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.acknowledgePacket(ctx, chanCap, packet, func(channel types.Channel, connectionEnd exported.ConnectionI) error {
+		return k.verifyPacketAcknowledgement(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+			packet.GetSequence(), acknowledgement,
+		)
+	})
+}
+
+// AcknowledgePackets is called by a module to process the acknowledgements of multiple packets previously
+// sent by the calling module on the same channel. The acknowledgements of all packets are verified with a
+// single batch proof, after which each packet is acknowledged as in AcknowledgePacket. The packets must
+// have distinct sequences and each packet must be provided along with its acknowledgement.
+//
+// The error of each packet is returned in the order of the packets and the state changes of a packet are
+// only written if it was acknowledged. An error is returned if the batch proof cannot be verified.
+func (k Keeper) AcknowledgePackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	acknowledgements [][]byte,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	if len(packets) != len(acknowledgements) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements must match the number of packets (%d ≠ %d)", len(acknowledgements), len(packets))
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, connectionEnd, err := k.getBatchChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	acks := make(map[uint64][]byte, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packets must be sent on the same channel (%s/%s ≠ %s/%s)", packet.GetSourcePort(), packet.GetSourceChannel(), portID, channelID)
+		}
+
+		if _, found := acks[packet.GetSequence()]; found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+
+		acks[packet.GetSequence()] = acknowledgements[i]
+	}
+
+	// the acknowledgements are proven under the counterparty port and channel, the destination of each packet is checked when it is acknowledged
+	if err := k.connectionKeeper.VerifyPacketAcknowledgements(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, acks,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet acknowledgements")
+	}
+
+	packetErrs := make([]error, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		// the packet acknowledgement has been verified by the batch proof
+		packetErrs[i] = k.acknowledgePacket(cacheCtx, chanCap, packet, func(types.Channel, exported.ConnectionI) error { return nil })
+		if packetErrs[i] == nil {
+			writeFn()
+		}
+	}
+
+	return packetErrs, nil
+}
+
+// acknowledgePacket acknowledges the packet after verifying it with the provided packet verifier. The packet
+// verifier is expected to verify that the counterparty wrote the acknowledgement of the packet.
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyPacket packetVerifier,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -371,11 +582,8 @@ func (k Keeper) AcknowledgePacket(
 		)
 	}
 
-	if channel.State != types.OPEN {
-		return errorsmod.Wrapf(
-			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
-		)
+	if !collections.Contains(channel.State, []types.State{types.OPEN, types.FLUSHING}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "packets cannot be acknowledged on channel with state (%s)", channel.State)
 	}
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
@@ -432,15 +640,12 @@ func (k Keeper) AcknowledgePacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
-		packet.GetSequence(), acknowledgement,
-	); err != nil {
+	if err := verifyPacket(channel, connectionEnd); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -456,7 +661,7 @@ func (k Keeper) AcknowledgePacket(
 			)
 		}
 
-		// All verification complete, in the case of ORDERED channels we must increment nextSequenceAck
+		// All verification complete, in the case of ordered channels we must increment nextSequenceAck
 		nextSequenceAck++
 
 		// incrementing NextSequenceAck and storing under this chain's channelEnd identifiers
@@ -481,5 +686,59 @@ func (k Keeper) AcknowledgePacket(
 	// emit an event marking that we have processed the acknowledgement
 	emitAcknowledgePacketEvent(ctx, packet, channel)
 
+	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
+	if channel.State == types.FLUSHING {
+		k.handleFlushState(ctx, packet, channel)
+	}
+
 	return nil
 }
+
+// handleFlushState is called when a packet is acknowledged or timed out and the channel is in
+// FLUSHING state. It checks if the upgrade has timed out and if so, aborts the upgrade. If all
+// packets have completed their lifecycle, it sets the channel state to FLUSHCOMPLETE and
+// emits a channel_flush_complete event.
+func (k Keeper) handleFlushState(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return
+	}
+
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		// packet flushing timeout has expired, abort the upgrade
+		// committing an error receipt to state, deleting upgrade information and restoring the channel.
+		k.Logger(ctx).Info("upgrade aborted", "port_id", packet.GetSourcePort(), "channel_id", packet.GetSourceChannel(), "upgrade_sequence", channel.UpgradeSequence)
+		k.MustAbortUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
+	} else if !k.HasInflightPackets(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		// set the channel state to flush complete if all packets have been acknowledged/flushed.
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+		emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	}
+}
+
+// packetVerifier verifies the counterparty state of a packet on the provided channel and connection end.
+type packetVerifier func(channel types.Channel, connectionEnd exported.ConnectionI) error
+
+// getBatchChannelAndConnection returns the channel on which a batch of packets is processed along with its
+// connection end. Batches of packets are only supported on single-hop channels.
+func (k Keeper) getBatchChannelAndConnection(ctx sdk.Context, portID, channelID string) (types.Channel, exported.ConnectionI, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, nil, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if isMultihop(channel.ConnectionHops) {
+		return types.Channel{}, nil, errorsmod.Wrap(types.ErrTooManyConnectionHops, "batches of packets are not supported on multi-hop channels")
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return types.Channel{}, nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	return channel, connectionEnd, nil
+}
@@ -4,8 +4,12 @@ import (
 	"errors"
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
+
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
@@ -89,6 +93,47 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 			packetData = []byte{}
 		}, false},
+		{"success: packet data size equal to max packet data bytes", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.DefaultParams()
+			params.MaxPacketDataBytes = uint64(len(packetData))
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"success: max packet data bytes of port overrides exceeded global limit", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.DefaultParams()
+			params.MaxPacketDataBytes = 1
+			params.PortMaxPacketDataBytes = []types.PortMaxPacketDataBytes{types.NewPortMaxPacketDataBytes(sourcePort, 0)}
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"packet data size exceeds max packet data bytes", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.DefaultParams()
+			params.MaxPacketDataBytes = uint64(len(packetData)) - 1
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"packet data size exceeds max packet data bytes of port", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.DefaultParams()
+			params.PortMaxPacketDataBytes = []types.PortMaxPacketDataBytes{types.NewPortMaxPacketDataBytes(sourcePort, uint64(len(packetData))-1)}
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -253,6 +298,32 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 	}
 }
 
+// TestSendPacketRejectedTelemetry tests that the rejected packet counter is incremented when the packet data
+// exceeds the maximum packet data size.
+func (suite *KeeperTestSuite) TestSendPacketRejectedTelemetry() {
+	sink := ibctesting.SetupInmemTelemetry(suite.T())
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	params := types.DefaultParams()
+	params.MaxPacketDataBytes = 1
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx := suite.chainA.GetContext()
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrPacketDataTooLarge)
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(types.LabelPortID, path.EndpointA.ChannelConfig.PortID),
+		telemetry.NewLabel(types.LabelChannelID, path.EndpointA.ChannelID),
+	}
+	suite.Require().Equal(float64(1), ibctesting.GetTelemetryCounter(sink, []string{"ibc", "packet", "rejected"}, labels))
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -831,8 +902,9 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 			)
 
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
-			// manually set packet commitment
+			// manually set packet commitment and next send sequence
 			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), types.CommitPacket(suite.chainA.App.AppCodec(), packet))
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence()+1)
 
 			// manually set packet acknowledgement and capability
 			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence(), types.CommitAcknowledgement(ack.Acknowledgement()))
@@ -904,3 +976,282 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+// TestRecvPackets tests receiving a batch of packets with a single proof
+func (suite *KeeperTestSuite) TestRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []exported.PacketI
+		channelCap *capabilitytypes.Capability
+		expErrs    []error
+		expError   *errorsmod.Error
+	)
+
+	sendPackets := func(n int) {
+		packets = nil
+		for i := 0; i < n; i++ {
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+		}
+		expErrs = make([]error, n)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED channel", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+		}, true},
+		{"success: ORDERED channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+		}, true},
+		{"success: single packet", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(1)
+		}, true},
+		{"success: packet already received is a no-op", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packets[1].GetSequence())
+			expErrs[1] = types.ErrNoOpMsg
+		}, true},
+		{"success: invalid channel capability fails each packet", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			channelCap = capabilitytypes.NewCapability(3)
+			expErrs[0] = types.ErrInvalidChannelCapability
+			expErrs[1] = types.ErrInvalidChannelCapability
+		}, true},
+		{"empty packets", func() {
+			suite.coordinator.Setup(path)
+			packets = nil
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"packets received on different channels", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			packets[1] = types.NewPacket(ibctesting.MockPacketData, packets[1].GetSequence(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, ibctesting.InvalidID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"duplicate packet sequence", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			packets[1] = packets[0]
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"channel not found", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(1)
+
+			packets[0] = types.NewPacket(ibctesting.MockPacketData, packets[0].GetSequence(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.InvalidID, ibctesting.InvalidID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"packet commitment mismatch", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			packets[1] = types.NewPacket([]byte("invalid data"), packets[1].GetSequence(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			expError = nil    // must explicitly set for failed cases
+			channelCap = nil
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			if channelCap == nil {
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			}
+
+			// get proof of all packet commitments from chainA, the packets may not have been committed
+			proof, proofHeight := []byte{}, clienttypes.ZeroHeight()
+			if len(packets) > 0 && path.EndpointA.ChannelID != "" {
+				keys := make([][]byte, len(packets))
+				for i, packet := range packets {
+					keys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				}
+				proof, proofHeight = suite.chainA.QueryBatchProof(keys)
+			}
+
+			packetErrs, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPackets(suite.chainB.GetContext(), channelCap, packets, proof, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(packetErrs, len(packets))
+
+				for i, packet := range packets {
+					if expErrs[i] != nil {
+						suite.Require().ErrorIs(packetErrs[i], expErrs[i])
+						continue
+					}
+
+					suite.Require().NoError(packetErrs[i])
+
+					_, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+					suite.Require().True(found)
+
+					if path.EndpointB.ChannelConfig.Order == types.ORDERED {
+						suite.Require().Equal(uint64(len(packets)+1), nextSeqRecv, "sequence not incremented in ordered channel")
+						suite.Require().False(receiptStored, "packet receipt stored on ORDERED channel")
+					} else {
+						suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
+						suite.Require().True(receiptStored, "packet receipt not stored after RecvPackets in UNORDERED channel")
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(packetErrs)
+
+				if expError != nil {
+					suite.Require().ErrorIs(err, expError)
+				}
+			}
+		})
+	}
+}
+
+// TestAcknowledgePackets tests acknowledging a batch of packets with a single proof
+func (suite *KeeperTestSuite) TestAcknowledgePackets() {
+	var (
+		path     *ibctesting.Path
+		packets  []exported.PacketI
+		acks     [][]byte
+		expErrs  []error
+		expError *errorsmod.Error
+	)
+
+	sendAndRecvPackets := func(n int) {
+		packets, acks = nil, nil
+		for i := 0; i < n; i++ {
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+			packets = append(packets, packet)
+			acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+		}
+		expErrs = make([]error, n)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED channel", func() {
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(3)
+		}, true},
+		{"success: ORDERED channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(3)
+		}, true},
+		{"success: packet already acknowledged is a no-op", func() {
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(2)
+
+			err := path.EndpointA.AcknowledgePacket(packets[0].(types.Packet), acks[0])
+			suite.Require().NoError(err)
+			expErrs[0] = types.ErrNoOpMsg
+		}, true},
+		{"empty packets", func() {
+			suite.coordinator.Setup(path)
+			packets, acks = nil, nil
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"acknowledgements length mismatch", func() {
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(2)
+
+			acks = acks[:1]
+			expError = types.ErrInvalidAcknowledgement
+		}, false},
+		{"duplicate packet sequence", func() {
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(2)
+
+			packets[1] = packets[0]
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"acknowledgement mismatch", func() {
+			suite.coordinator.Setup(path)
+			sendAndRecvPackets(2)
+
+			acks[1] = []byte("invalid acknowledgement")
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			expError = nil    // must explicitly set for failed cases
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			proof, proofHeight := []byte{}, clienttypes.ZeroHeight()
+			if len(packets) > 0 {
+				keys := make([][]byte, len(packets))
+				for i, packet := range packets {
+					keys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				}
+				proof, proofHeight = suite.chainB.QueryBatchProof(keys)
+			}
+
+			packetErrs, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePackets(suite.chainA.GetContext(), channelCap, packets, acks, proof, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(packetErrs, len(packets))
+
+				for i, packet := range packets {
+					if expErrs[i] != nil {
+						suite.Require().ErrorIs(packetErrs[i], expErrs[i])
+						continue
+					}
+
+					suite.Require().NoError(packetErrs[i])
+
+					commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().Nil(commitment, "packet commitment not deleted")
+				}
+
+				if path.EndpointA.ChannelConfig.Order == types.ORDERED {
+					nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+					suite.Require().True(found)
+					suite.Require().Equal(uint64(len(packets)+1), nextSeqAck, "sequence not incremented in ordered channel")
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(packetErrs)
+
+				if expError != nil {
+					suite.Require().ErrorIs(err, expError)
+				}
+			}
+		})
+	}
+}
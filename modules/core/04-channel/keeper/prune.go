@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// PruneAcknowledgements prunes at most limit acknowledgements and receipts of the given channel, starting
+// at the pruning sequence start and ending before the pruning sequence end. The pruning sequences are set
+// when a channel upgrade completes, at which point all packets with a lower sequence than the counterparty
+// next sequence send have been flushed on both ends and are rejected on receive. The number of pruned
+// sequences and the number of sequences left to prune are returned.
+func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string, limit uint64) (uint64, uint64, error) {
+	if !k.HasChannel(ctx, portID, channelID) {
+		return 0, 0, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	start, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(types.ErrPruningSequenceStartNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	end, found := k.GetPruningSequenceEnd(ctx, portID, channelID)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(types.ErrPruningSequenceEndNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if start >= end {
+		return 0, 0, nil
+	}
+
+	pruned := k.pruneSequences(ctx, portID, channelID, start, end, limit, func() bool { return false })
+
+	return pruned, end - start - pruned, nil
+}
+
+// PruneAcknowledgementsWithGasLimit prunes the acknowledgements and receipts of upgraded channels until the gas
+// consumed by pruning exceeds the gas limit. The gas consumed is not charged to the gas meter of the provided
+// context. Channels are visited in store order, and pruning resumes from the channel after the last visited channel
+// of the previous call, wrapping around once all channels have been visited. The total number of pruned sequences is
+// returned.
+func (k Keeper) PruneAcknowledgementsWithGasLimit(ctx sdk.Context, gasLimit uint64) uint64 {
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	pruneCtx := ctx.WithGasMeter(gasMeter)
+	outOfGas := func() bool { return gasMeter.GasConsumed() >= gasLimit }
+
+	portID, channelID, hasCursor := k.getAcknowledgementPruneCursor(pruneCtx)
+
+	var totalPruned uint64
+	for !outOfGas() {
+		nextPortID, nextChannelID, end, found := k.nextPruningSequenceEnd(pruneCtx, portID, channelID, hasCursor)
+		if !found {
+			// all channels have been visited, the next call starts from the first channel
+			hasCursor = false
+			break
+		}
+
+		if start, found := k.GetPruningSequenceStart(pruneCtx, nextPortID, nextChannelID); found && start < end {
+			totalPruned += k.pruneSequences(pruneCtx, nextPortID, nextChannelID, start, end, end-start, outOfGas)
+		}
+
+		// the cursor advances past the channel even if it has sequences left to prune, such that a channel
+		// with many sequences to prune does not starve the channels after it
+		portID, channelID, hasCursor = nextPortID, nextChannelID, true
+	}
+
+	if hasCursor {
+		k.setAcknowledgementPruneCursor(pruneCtx, portID, channelID)
+	} else {
+		k.deleteAcknowledgementPruneCursor(pruneCtx)
+	}
+
+	return totalPruned
+}
+
+// nextPruningSequenceEnd returns the port identifier, channel identifier and pruning sequence end of the first channel
+// with a pruning sequence end stored after the given channel, or of the first channel if hasCursor is false. The
+// iterator is closed before returning, such that callers may write to the store.
+func (k Keeper) nextPruningSequenceEnd(ctx sdk.Context, portID, channelID string, hasCursor bool) (string, string, uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := []byte(host.KeyPruningSequenceEnd + "/")
+
+	start := prefix
+	if hasCursor {
+		start = sdk.PrefixEndBytes(host.PruningSequenceEndKey(portID, channelID))
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return "", "", 0, false
+	}
+
+	nextPortID, nextChannelID, err := host.ParseChannelPath(string(iterator.Key()))
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	return nextPortID, nextChannelID, sdk.BigEndianToUint64(iterator.Value()), true
+}
+
+// getAcknowledgementPruneCursor returns the port and channel identifiers of the last channel visited by
+// PruneAcknowledgementsWithGasLimit. False is returned if no channel was visited.
+func (k Keeper) getAcknowledgementPruneCursor(ctx sdk.Context) (string, string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.KeyAcknowledgementPruneCursor))
+	if len(bz) == 0 {
+		return "", "", false
+	}
+
+	portID, channelID, err := host.ParseChannelPath(string(bz))
+	if err != nil {
+		return "", "", false
+	}
+
+	return portID, channelID, true
+}
+
+// setAcknowledgementPruneCursor stores the port and channel identifiers of the last channel visited by
+// PruneAcknowledgementsWithGasLimit.
+func (k Keeper) setAcknowledgementPruneCursor(ctx sdk.Context, portID, channelID string) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.KeyAcknowledgementPruneCursor), []byte(host.ChannelPath(portID, channelID)))
+}
+
+// deleteAcknowledgementPruneCursor deletes the acknowledgement prune cursor, such that the next call to
+// PruneAcknowledgementsWithGasLimit starts from the first channel.
+func (k Keeper) deleteAcknowledgementPruneCursor(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.KeyAcknowledgementPruneCursor))
+}
+
+// pruneSequences deletes the acknowledgements and receipts of at most limit sequences in [start, end) and
+// advances the pruning sequence start. Pruning stops early once stop returns true. The number of pruned
+// sequences is returned.
+func (k Keeper) pruneSequences(ctx sdk.Context, portID, channelID string, start, end, limit uint64, stop func() bool) uint64 {
+	if start >= end {
+		return 0
+	}
+
+	if end-start > limit {
+		end = start + limit
+	}
+
+	sequence := start
+	for ; sequence < end && !stop(); sequence++ {
+		k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+		// receipts are only written on UNORDERED channels and for timed-out packets on ORDERED_ALLOW_TIMEOUT channels
+		k.deletePacketReceipt(ctx, portID, channelID, sequence)
+	}
+
+	k.SetPruningSequenceStart(ctx, portID, channelID, sequence)
+
+	k.Logger(ctx).Debug("pruned acknowledgements and receipts", "port-id", portID, "channel-id", channelID, "start", start, "end", sequence)
+
+	return sequence - start
+}
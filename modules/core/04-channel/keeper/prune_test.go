@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+// setupUpgradedPath sets up a path on which numPackets packets are sent from chainA to chainB, received and
+// acknowledged, after which the channel is upgraded. The acknowledgements and receipts of the packets are
+// written on chainB and left to be pruned.
+func (suite *KeeperTestSuite) setupUpgradedPath(numPackets int) *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	for i := 0; i < numPackets; i++ {
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+		suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+		suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+	}
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestWriteUpgradeOpenChannelPruningSequences() {
+	path := suite.setupUpgradedPath(3)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	ctx := suite.chainB.GetContext()
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+	recvStartSequence, found := channelKeeper.GetRecvStartSequence(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), recvStartSequence)
+
+	start, found := channelKeeper.GetPruningSequenceStart(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), start)
+
+	end, found := channelKeeper.GetPruningSequenceEnd(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), end)
+
+	// chainA has not received any packets, so there is nothing to prune
+	channelKeeper = suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	ctx = suite.chainA.GetContext()
+	portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	end, found = channelKeeper.GetPruningSequenceEnd(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), end)
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path  *ibctesting.Path
+		limit uint64
+	)
+
+	testCases := []struct {
+		name               string
+		malleate           func()
+		expPruned          uint64
+		expRemaining       uint64
+		expPruningSeqStart uint64
+		expError           error
+	}{
+		{
+			"success: all sequences pruned",
+			func() {},
+			5, 0, 6, nil,
+		},
+		{
+			"success: sequences pruned up to limit",
+			func() {
+				limit = 2
+			},
+			2, 3, 3, nil,
+		},
+		{
+			"success: nothing left to prune",
+			func() {
+				_, _, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, limit)
+				suite.Require().NoError(err)
+			},
+			0, 0, 6, nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointB.ChannelID = ibctesting.InvalidID
+			},
+			0, 0, 0, types.ErrChannelNotFound,
+		},
+		{
+			"failure: pruning sequence end not found",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceStart(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+			},
+			0, 0, 0, types.ErrPruningSequenceEndNotFound,
+		},
+		{
+			"failure: channel has not been upgraded",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+			},
+			0, 0, 0, types.ErrPruningSequenceStartNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			limit = 10
+
+			path = suite.setupUpgradedPath(5)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			ctx := suite.chainB.GetContext()
+			portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+			pruned, remaining, err := channelKeeper.PruneAcknowledgements(ctx, portID, channelID, limit)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+				suite.Require().Equal(tc.expRemaining, remaining)
+
+				start, found := channelKeeper.GetPruningSequenceStart(ctx, portID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expPruningSeqStart, start)
+
+				for sequence := uint64(1); sequence <= 5; sequence++ {
+					_, ackFound := channelKeeper.GetPacketAcknowledgement(ctx, portID, channelID, sequence)
+					_, receiptFound := channelKeeper.GetPacketReceipt(ctx, portID, channelID, sequence)
+
+					expFound := sequence >= tc.expPruningSeqStart
+					suite.Require().Equal(expFound, ackFound, "sequence %d", sequence)
+					suite.Require().Equal(expFound, receiptFound, "sequence %d", sequence)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgementsWithGasLimit() {
+	testCases := []struct {
+		name      string
+		gasLimit  uint64
+		expPruned uint64
+	}{
+		{"gas limit allows pruning all sequences", 10_000_000, 5},
+		// the gas consumed to look up the channels to prune already exceeds the gas limit
+		{"gas limit is exceeded before pruning", 1, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := suite.setupUpgradedPath(5)
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			ctx := suite.chainB.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			pruned := channelKeeper.PruneAcknowledgementsWithGasLimit(ctx, tc.gasLimit)
+			suite.Require().Equal(tc.expPruned, pruned)
+			suite.Require().Equal(gasBefore, ctx.GasMeter().GasConsumed(), "pruning gas should not be charged to the context")
+
+			start, found := channelKeeper.GetPruningSequenceStart(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expPruned+1, start)
+		})
+	}
+}
+
+// TestRecvPacketBelowRecvStartSequence asserts that packets with a lower sequence than the recv start sequence
+// are treated as already received, as their receipts may have been pruned.
+func (suite *KeeperTestSuite) TestRecvPacketBelowRecvStartSequence() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	channelKeeper.SetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence+1)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+	err = channelKeeper.RecvPacket(suite.chainB.GetContext(), channelCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	_, found := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+	suite.Require().False(found)
+}
+
+// TestPruneAcknowledgementsWithGasLimitCursor tests that pruning resumes from the channel after the last visited
+// channel, such that channels later in store order are pruned even if the gas limit does not cover all sequences
+// of a single channel in one call.
+func (suite *KeeperTestSuite) TestPruneAcknowledgementsWithGasLimitCursor() {
+	const (
+		numChannels = 3
+		numPackets  = 3
+		maxCalls    = 2 * numChannels * numPackets
+	)
+
+	var paths []*ibctesting.Path
+	for i := 0; i < numChannels; i++ {
+		paths = append(paths, suite.setupUpgradedPath(numPackets))
+	}
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	firstPath, laterPaths := paths[0], paths[1:]
+
+	// allPruned returns true if all sequences of the channels after the first channel are pruned
+	allPruned := func(ctx sdk.Context) bool {
+		for _, path := range laterPaths {
+			start, found := channelKeeper.GetPruningSequenceStart(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+			if start != numPackets+1 {
+				return false
+			}
+		}
+		return true
+	}
+
+	// the gas limit does not cover pruning all sequences of a single channel
+	gasLimit := uint64(5_000)
+
+	calls := 0
+	for ; calls < maxCalls; calls++ {
+		ctx := suite.chainB.GetContext()
+		pruned := channelKeeper.PruneAcknowledgementsWithGasLimit(ctx, gasLimit)
+		suite.Require().Less(pruned, uint64(numPackets))
+
+		if allPruned(ctx) {
+			break
+		}
+
+		// the first channel is given sequences to prune again after every call, pruning must not restart from it
+		channelKeeper.SetPruningSequenceStart(ctx, firstPath.EndpointB.ChannelConfig.PortID, firstPath.EndpointB.ChannelID, 1)
+	}
+
+	suite.Require().Less(calls, maxCalls, "pruning did not progress to the channels after the first channel")
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v7/internal/collections"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
@@ -62,13 +63,13 @@ func (k Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	counterpartyHeight, proofTimestamp, err := k.getProofHeightAndTimestamp(ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof)
 	if err != nil {
 		return err
 	}
 
 	timeoutHeight := packet.GetTimeoutHeight()
-	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
+	if (timeoutHeight.IsZero() || counterpartyHeight.LT(timeoutHeight)) &&
 		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
 		return errorsmod.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
 	}
@@ -84,11 +85,8 @@ func (k Keeper) TimeoutPacket(
 		return types.ErrNoOpMsg
 	}
 
-	if channel.State != types.OPEN {
-		return errorsmod.Wrapf(
-			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
-		)
+	if !collections.Contains(channel.State, []types.State{types.OPEN, types.FLUSHING}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "packet timeout cannot be processed on channel with state (%s)", channel.State)
 	}
 
 	packetCommitment := types.CommitPacket(k.cdc, packet)
@@ -109,14 +107,25 @@ func (k Keeper) TimeoutPacket(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.verifyTimeoutInOrder(ctx, packet); err != nil {
+			return err
+		}
+
+		// check that the counterparty received the timed-out packet and wrote a timeout receipt
+		err = k.verifyPacketReceipt(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			types.TimeoutReceipt,
 		)
 	default:
 		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
@@ -130,8 +139,31 @@ func (k Keeper) TimeoutPacket(
 	return nil
 }
 
+// verifyTimeoutInOrder asserts that the packet sequence is the next sequence to be acknowledged,
+// as packets sent on ORDERED_ALLOW_TIMEOUT channels must be acknowledged or timed out in order.
+func (k Keeper) verifyTimeoutInOrder(ctx sdk.Context, packet exported.PacketI) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
+
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the channel remains
+// open and the next sequence ack is incremented.
 //
 // CONTRACT: this function must be called in the IBC handler
 func (k Keeper) TimeoutExecuted(
@@ -155,8 +187,29 @@ func (k Keeper) TimeoutExecuted(
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if channel.Ordering == types.ORDERED {
+		// NOTE: if the channel is ORDERED and a packet is timed out during an upgrade then
+		// the upgrade is aborted, an error receipt is written and the channel is closed.
+		if k.hasUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+			k.MustAbortUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), errorsmod.Wrap(types.ErrPacketTimeout, "ordered channel packet timed out during upgrade"))
+
+			channel, found = k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+			if !found {
+				return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
+			}
+		}
+
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	} else {
+		if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+			// the timed-out packet is treated as acknowledged, packets are acknowledged in order
+			k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+		}
+
+		if channel.State == types.FLUSHING {
+			// if an upgrade is in progress, handling packet flushing and update channel state appropriately
+			k.handleFlushState(ctx, packet, channel)
+		}
 	}
 
 	k.Logger(ctx).Info(
@@ -180,7 +233,9 @@ func (k Keeper) TimeoutExecuted(
 
 // TimeoutOnClose is called by a module in order to prove that the channel to
 // which an unreceived packet was addressed has been closed, so the packet will
-// never be received (even if the timeoutHeight has not yet been reached).
+// never be received (even if the timeoutHeight has not yet been reached). On
+// ORDERED_ALLOW_TIMEOUT channels, a packet for which the counterparty wrote a
+// timeout receipt before closing is proven with the timeout receipt.
 func (k Keeper) TimeoutOnClose(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -189,6 +244,7 @@ func (k Keeper) TimeoutOnClose(
 	proofClosed []byte,
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -240,23 +296,26 @@ func (k Keeper) TimeoutOnClose(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, proofClosed)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	expectedChannel := types.NewChannel(
 		types.CLOSED, channel.Ordering, counterparty, counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofClosed,
+	if err := k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, proofClosed,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
 		return err
 	}
 
-	var err error
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -265,15 +324,34 @@ func (k Keeper) TimeoutOnClose(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.verifyTimeoutInOrder(ctx, packet); err != nil {
+			return err
+		}
+
+		if nextSequenceRecv > packet.GetSequence() {
+			// check that the counterparty received the timed-out packet and wrote a timeout receipt before closing
+			err = k.verifyPacketReceipt(
+				ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+				types.TimeoutReceipt,
+			)
+		} else {
+			// check that the recv sequence is as claimed
+			err = k.verifyNextSequenceRecv(
+				ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		}
 	default:
 		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
 	}
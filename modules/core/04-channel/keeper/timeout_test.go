@@ -314,11 +314,12 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 // channel on chainB after the packet commitment has been created.
 func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 	var (
-		path        *ibctesting.Path
-		packet      types.Packet
-		chanCap     *capabilitytypes.Capability
-		nextSeqRecv uint64
-		ordered     bool
+		path                        *ibctesting.Path
+		packet                      types.Packet
+		chanCap                     *capabilitytypes.Capability
+		nextSeqRecv                 uint64
+		counterpartyUpgradeSequence uint64
+		ordered                     bool
 	)
 
 	testCases := []testCase{
@@ -358,6 +359,25 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"failure: invalid counterparty upgrade sequence", func() {
+			ordered = false
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			err = path.EndpointB.SetChannelState(types.CLOSED)
+			suite.Require().NoError(err)
+			// need to update chainA's client representing chainB to prove missing ack
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			counterpartyUpgradeSequence = 1
+		}, false},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -376,6 +396,10 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, false},
 		{"connection not found", func() {
+			// create chancap before the channel is set, as creating it commits a block
+			suite.chainA.CreateChannelCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
 			// pass channel check
 			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(
 				suite.chainA.GetContext(),
@@ -383,10 +407,6 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 				types.NewChannel(types.OPEN, types.ORDERED, types.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), []string{connIDA}, path.EndpointA.ChannelConfig.Version),
 			)
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
-
-			// create chancap
-			suite.chainA.CreateChannelCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
-			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, false},
 		{"packet hasn't been sent ORDERED", func() {
 			path.SetChannelOrdered()
@@ -490,8 +510,9 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
 			var proof []byte
 
-			suite.SetupTest() // reset
-			nextSeqRecv = 1   // must be explicitly changed
+			suite.SetupTest()               // reset
+			nextSeqRecv = 1                 // must be explicitly changed
+			counterpartyUpgradeSequence = 0 // must be explicitly changed
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 
 			tc.malleate()
@@ -508,7 +529,134 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 				proof, _ = suite.chainB.QueryProof(unorderedPacketKey)
 			}
 
-			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv)
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv, counterpartyUpgradeSequence)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestTimeoutPacketOrderedAllowTimeout tests that a packet which timed out on an ORDERED_ALLOW_TIMEOUT
+// channel results in a timeout receipt being written on the receiving chain, which is then used to
+// time out the packet in order on the sending chain without closing the channel.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+	suite.coordinator.Setup(path)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+	var packets []types.Packet
+	for i := 0; i < 2; i++ {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp))
+	}
+
+	// the second packet cannot be timed out before the first one
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.NextSequenceRecvKey(packets[1].GetDestPort(), packets[1].GetDestChannel())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packets[1], proof, proofHeight, 1)
+	suite.Require().ErrorIs(err, types.ErrPacketSequenceOutOfOrder)
+
+	for _, packet := range packets {
+		err = path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+
+		// receiving the timed out packet writes a timeout receipt
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+
+		nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+		suite.Require().True(found)
+		suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv)
+	}
+
+	for _, packet := range packets {
+		err = path.EndpointA.UpdateClient()
+		suite.Require().NoError(err)
+
+		err = path.EndpointA.TimeoutPacket(packet)
+		suite.Require().NoError(err)
+
+		commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().Nil(commitment)
+
+		nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+		suite.Require().True(found)
+		suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+	}
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+}
+
+// TestTimeoutOnCloseOrderedAllowTimeout tests that a packet for which a timeout receipt was written
+// before the counterparty channel end closed on an ORDERED_ALLOW_TIMEOUT channel is timed out on close
+// with a proof of the timeout receipt.
+func (suite *KeeperTestSuite) TestTimeoutOnCloseOrderedAllowTimeout() {
+	testCases := []struct {
+		msg         string
+		nextSeqRecv uint64
+		receiptKey  bool
+		expPass     bool
+	}{
+		{"success: timeout receipt proven", 2, true, true},
+		{"failure: next sequence recv below the received packet", 1, false, false},
+		{"failure: next sequence recv proof provided instead of the timeout receipt proof", 2, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+			path.EndpointB.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// receiving the timed out packet writes a timeout receipt before the channel is closed
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.SetChannelState(types.CLOSED)
+			suite.Require().NoError(err)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			proofClosed, proofHeight := suite.chainB.QueryProof(host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel()))
+
+			proofKey := host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			if tc.receiptKey {
+				proofKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			}
+			proof, _ := suite.chainB.QueryProof(proofKey)
+
+			chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, tc.nextSeqRecv, 0)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -0,0 +1,958 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/internal/collections"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ChanUpgradeInit is called by a module to initiate a channel upgrade handshake with
+// a module on another chain.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	upgradeFields types.UpgradeFields,
+) (types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
+	}
+
+	if isMultihop(channel.ConnectionHops) {
+		return types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "multi-hop channels cannot be upgraded")
+	}
+
+	if err := k.validateSelfUpgradeFields(ctx, upgradeFields, channel); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	return types.Upgrade{Fields: upgradeFields}, nil
+}
+
+// WriteUpgradeInitChannel writes a channel which has successfully passed the UpgradeInit handshake step.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitChannel(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade, upgradeVersion string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeInit step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel.UpgradeSequence++
+
+	upgrade.Fields.Version = upgradeVersion
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "state", channel.State, "upgrade-sequence", fmt.Sprintf("%d", channel.UpgradeSequence))
+
+	emitChannelUpgradeInitEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeTry is called by a module to accept the first step of a channel upgrade handshake initiated by
+// a module on another chain. If this function is successful, the proposed upgrade will be returned. If the upgrade fails, the upgrade sequence will still be incremented but an error will be returned.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedConnectionHops []string,
+	counterpartyUpgradeFields types.UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	proofCounterpartyChannel,
+	proofCounterpartyUpgrade []byte,
+	proofHeight clienttypes.Height,
+) (types.Channel, types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
+	}
+
+	if isMultihop(channel.ConnectionHops) {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "multi-hop channels cannot be upgraded")
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return types.Channel{}, types.Upgrade{}, err
+	}
+
+	// construct expected counterparty channel from information in state
+	// only the counterpartyUpgradeSequence is provided by the relayer
+	counterpartyConnectionHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.Channel{
+		State:           types.OPEN,
+		Ordering:        channel.Ordering,
+		Counterparty:    types.NewCounterparty(portID, channelID),
+		ConnectionHops:  counterpartyConnectionHops,
+		Version:         channel.Version,
+		UpgradeSequence: counterpartyUpgradeSequence, // provided by the relayer
+	}
+
+	// verify the counterparty channel state containing the upgrade sequence
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx,
+		connection,
+		proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx,
+		connection,
+		proofHeight, proofCounterpartyUpgrade,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		types.Upgrade{Fields: counterpartyUpgradeFields},
+	); err != nil {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	upgrade, isCrossingHello := k.GetUpgrade(ctx, portID, channelID)
+
+	// in the crossing hello case the upgrade sequence has already been incremented by this chain's
+	// own ChanUpgradeInit step, in all other cases this TRY step executes the INIT step implicitly
+	// which will increment the upgrade sequence by one.
+	expectedUpgradeSequence := channel.UpgradeSequence
+	if !isCrossingHello {
+		expectedUpgradeSequence++
+	}
+
+	if counterpartyUpgradeSequence < expectedUpgradeSequence {
+		// the counterparty upgrade is outdated. We want to force the counterparty to abort
+		// their upgrade and come back to sync with our own upgrade sequence.
+		return types.Channel{}, types.Upgrade{}, types.NewUpgradeError(expectedUpgradeSequence, errorsmod.Wrapf(
+			types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence < expected upgrade sequence (%d < %d)", counterpartyUpgradeSequence, expectedUpgradeSequence,
+		))
+	}
+
+	if !isCrossingHello {
+		upgradeFields := types.NewUpgradeFields(counterpartyUpgradeFields.Ordering, proposedConnectionHops, counterpartyUpgradeFields.Version)
+		if upgrade, err = k.ChanUpgradeInit(ctx, portID, channelID, upgradeFields); err != nil {
+			return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to initialize upgrade")
+		}
+
+		channel, upgrade = k.WriteUpgradeInitChannel(ctx, portID, channelID, upgrade, upgrade.Fields.Version)
+	}
+
+	// if the counterparty sequence is greater than the current sequence, we fast forward to the counterparty sequence
+	// so that both channel ends are using the same sequence for the current upgrade.
+	if counterpartyUpgradeSequence > channel.UpgradeSequence {
+		channel.UpgradeSequence = counterpartyUpgradeSequence
+		k.SetChannel(ctx, portID, channelID, channel)
+	}
+
+	if err := k.checkForUpgradeCompatibility(ctx, upgrade.Fields, counterpartyUpgradeFields); err != nil {
+		return types.Channel{}, types.Upgrade{}, types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	if err := k.startFlushing(ctx, portID, channelID, &upgrade); err != nil {
+		return types.Channel{}, types.Upgrade{}, err
+	}
+
+	channel, found = k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return channel, upgrade, nil
+}
+
+// WriteUpgradeTryChannel writes the channel end and upgrade to state after successfully passing the UpgradeTry handshake step.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTryChannel(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade, upgradeVersion string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTry step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade.Fields.Version = upgradeVersion
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.OPEN.String(), "new-state", channel.State.String())
+
+	emitChannelUpgradeTryEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeAck is called by a module to accept the ACKUPGRADE handshake step of the channel upgrade protocol.
+// This method should only be called by the IBC core msg server.
+// This method will verify that the counterparty has called the ChanUpgradeTry handler.
+// and that its own upgrade is compatible with the selected counterparty version.
+// NOTE: the channel may be in either the OPEN or FLUSHING state.
+// The channel may be in OPEN if we are in the happy path.
+//
+//	A -> Init (OPEN), B -> Try (FLUSHING), A -> Ack (begins in OPEN)
+//
+// The channel may be in FLUSHING if we are in a crossing hellos situation.
+//
+//	A -> Init (OPEN), B -> Init (OPEN) -> A -> Try (FLUSHING), B -> Try (FLUSHING), A -> Ack (begins in FLUSHING)
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !collections.Contains(channel.State, []types.State{types.OPEN, types.FLUSHING}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected one of [%s, %s], got %s", types.OPEN, types.FLUSHING, channel.State)
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.Channel{
+		State:           types.FLUSHING,
+		Ordering:        channel.Ordering,
+		ConnectionHops:  counterpartyHops,
+		Counterparty:    types.NewCounterparty(portID, channelID),
+		Version:         channel.Version,
+		UpgradeSequence: channel.UpgradeSequence,
+	}
+
+	// verify the counterparty channel state containing the upgrade sequence
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx,
+		connection,
+		proofHeight, proofChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx,
+		connection,
+		proofHeight, proofUpgrade,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// optimistically accept version that TRY chain proposes and pass this to callback for confirmation
+	// in the crossing hello case, we do not modify version that our TRY call returned and instead enforce
+	// that both TRY calls returned the same version
+	if channel.State == types.OPEN {
+		upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
+	}
+
+	// if upgrades are not compatible by ACK step, then we restore the channel
+	if err := k.checkForUpgradeCompatibility(ctx, upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
+		return types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	if channel.State == types.OPEN {
+		if err := k.startFlushing(ctx, portID, channelID, &upgrade); err != nil {
+			return err
+		}
+	}
+
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		return types.NewUpgradeError(channel.UpgradeSequence, errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckChannel writes a channel which has successfully passed the UpgradeAck handshake step as well as
+// setting the upgrade for that channel.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeAckChannel(ctx sdk.Context, portID, channelID string, counterpartyUpgrade types.Upgrade) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+	}
+
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find upgrade when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "state", channel.State.String())
+
+	emitChannelUpgradeAckEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeConfirm is called on the chain which is on FLUSHING after chanUpgradeAck is called on the counterparty.
+// This will inform the TRY chain of the timeout set on ACK by the counterparty. If the timeout has already exceeded, we will write an error receipt and restore.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+) error {
+	// if the counterparty sends a state other than FLUSHING or FLUSHCOMPLETE, return an error
+	if !collections.Contains(counterpartyChannelState, []types.State{types.FLUSHING, types.FLUSHCOMPLETE}) {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "expected one of [%s, %s], got %s", types.FLUSHING, types.FLUSHCOMPLETE, counterpartyChannelState)
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHING {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.FLUSHING, channel.State)
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.Channel{
+		State:           counterpartyChannelState,
+		Ordering:        channel.Ordering,
+		ConnectionHops:  counterpartyHops,
+		Counterparty:    types.NewCounterparty(portID, channelID),
+		Version:         channel.Version,
+		UpgradeSequence: channel.UpgradeSequence,
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx,
+		connection,
+		proofHeight, proofChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx,
+		connection,
+		proofHeight, proofUpgrade,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		return types.NewUpgradeError(channel.UpgradeSequence, errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmChannel writes a channel which has successfully passed the ChanUpgradeConfirm handshake step.
+// If the channel has no in-flight packets, its state is updated to indicate that flushing has completed. Otherwise, the counterparty upgrade is set
+// and the channel state is left unchanged.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmChannel(ctx sdk.Context, portID, channelID string, counterpartyUpgrade types.Upgrade) types.Channel {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		previousState := channel.State
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+
+		k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState, "new-state", channel.State)
+	}
+
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	emitChannelUpgradeConfirmEvent(ctx, portID, channelID, channel)
+
+	return channel
+}
+
+// ChanUpgradeOpen is called by a module to complete the channel upgrade handshake and move the channel back to an OPEN state.
+// This method should only be called after both channels have flushed any in-flight packets.
+// This method should only be called directly by the core IBC message server.
+func (k Keeper) ChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgradeSequence uint64,
+	proofCounterpartyChannel []byte,
+	proofHeight clienttypes.Height,
+) error {
+	if k.HasInflightPackets(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "cannot open channel with in-flight packets, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHCOMPLETE {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.FLUSHCOMPLETE, channel.State)
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	var counterpartyChannel types.Channel
+	switch counterpartyChannelState {
+	case types.OPEN:
+		upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		// If counterparty has reached OPEN, we must use the upgraded connection to verify the counterparty channel
+		upgradeConnection, err := k.getConnection(ctx, upgrade.Fields.ConnectionHops[0])
+		if err != nil {
+			return err
+		}
+
+		// The counterparty upgrade sequence must be greater than or equal to
+		// the channel upgrade sequence. It should normally be equivalent, but
+		// in the unlikely case a new upgrade is initiated after it reopens,
+		// then the upgrade sequence will be greater than our upgrade sequence.
+		if counterpartyUpgradeSequence < channel.UpgradeSequence {
+			return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty channel upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)", counterpartyUpgradeSequence, channel.UpgradeSequence)
+		}
+
+		counterpartyChannel = types.Channel{
+			State:           types.OPEN,
+			Ordering:        upgrade.Fields.Ordering,
+			ConnectionHops:  []string{upgradeConnection.GetCounterparty().GetConnectionID()},
+			Counterparty:    types.NewCounterparty(portID, channelID),
+			Version:         upgrade.Fields.Version,
+			UpgradeSequence: counterpartyUpgradeSequence,
+		}
+
+	case types.FLUSHCOMPLETE:
+		counterpartyChannel = types.Channel{
+			State:           types.FLUSHCOMPLETE,
+			Ordering:        channel.Ordering,
+			ConnectionHops:  []string{connection.GetCounterparty().GetConnectionID()},
+			Counterparty:    types.NewCounterparty(portID, channelID),
+			Version:         channel.Version,
+			UpgradeSequence: channel.UpgradeSequence,
+		}
+
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "counterparty channel state must be one of [%s, %s], got %s", types.OPEN, types.FLUSHCOMPLETE, counterpartyChannelState)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx,
+		connection,
+		proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify counterparty channel, expected counterparty channel state: %s", counterpartyChannel.String())
+	}
+
+	return nil
+}
+
+// WriteUpgradeOpenChannel writes the agreed upon upgrade fields to the channel, and sets the channel state back to OPEN. This can be called in one of two cases:
+// - In the UpgradeConfirm step of the handshake if both sides have already flushed any in-flight packets.
+// - In the UpgradeOpen step of the handshake.
+func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID string) types.Channel {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-open")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find upgrade when updating channel state, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find counterparty upgrade when updating channel state, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	// next seq recv and ack must be set when upgrading from an UNORDERED to an ORDERED or ORDERED_ALLOW_TIMEOUT channel.
+	// all in-flight packets have been flushed, so the counterparty next sequence send becomes our
+	// next sequence receive and our own next sequence send becomes our next sequence acknowledgement.
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering != types.UNORDERED {
+		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
+	}
+
+	// all packets sent by the counterparty before the upgrade have been received or timed out, and all packets
+	// we sent before the upgrade have been acknowledged or timed out. Packets with a lower sequence than the
+	// counterparty next sequence send are considered received from now on, which makes it safe to prune their
+	// acknowledgements and receipts.
+	k.SetRecvStartSequence(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+	k.SetPruningSequenceEnd(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+
+	// the first upgrade of a channel starts pruning at the first sequence, subsequent upgrades preserve the pruning progress.
+	if !k.HasPruningSequenceStart(ctx, portID, channelID) {
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
+	}
+
+	// Switch channel fields to upgrade fields.
+	channel.Ordering = upgrade.Fields.Ordering
+	channel.Version = upgrade.Fields.Version
+	channel.ConnectionHops = upgrade.Fields.ConnectionHops
+	channel.State = types.OPEN
+
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.FLUSHCOMPLETE.String(), "new-state", types.OPEN.String())
+
+	emitChannelUpgradeOpenEvent(ctx, portID, channelID, channel)
+
+	return channel
+}
+
+// ChanUpgradeCancel is called by the msg server to prove that an error receipt was written on the counterparty
+// which constitutes a valid situation where the upgrade should be cancelled. An error is returned if sufficient evidence
+// for cancelling the upgrade has not been provided. If isAuthority is true, the error receipt proof is not verified.
+func (k Keeper) ChanUpgradeCancel(ctx sdk.Context, portID, channelID string, errorReceipt types.ErrorReceipt, errorReceiptProof []byte, proofHeight clienttypes.Height, isAuthority bool) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// an upgrade must exist in order to cancel it
+	if !k.hasUpgrade(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the authority may cancel the upgrade at any time without providing an error receipt
+	if isAuthority {
+		return nil
+	}
+
+	// if the msgSender is not the authority, an error receipt proof must be provided.
+	if len(errorReceiptProof) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof unless the sender is the authority")
+	}
+
+	// the error receipt should be for the current upgrade sequence in the FLUSHCOMPLETE state as the counterparty
+	// may have already completed the upgrade and started a new one, in which case an error receipt for
+	// a later sequence must not be used to cancel this upgrade.
+	if channel.State == types.FLUSHCOMPLETE && errorReceipt.Sequence != channel.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be equal to current upgrade sequence (%d) when the channel is in FLUSHCOMPLETE", errorReceipt.Sequence, channel.UpgradeSequence)
+	}
+
+	// an error receipt proof must be provided for a sequence greater than or equal to the current
+	// upgrade sequence, otherwise it belongs to an earlier upgrade attempt.
+	if errorReceipt.Sequence < channel.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to current upgrade sequence (%d)", errorReceipt.Sequence, channel.UpgradeSequence)
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgradeError(
+		ctx,
+		connection,
+		proofHeight,
+		errorReceiptProof,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		errorReceipt,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty error receipt")
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelChannel writes a channel which has canceled the upgrade process.Auxiliary upgrade state is
+// also deleted.
+func (k Keeper) WriteUpgradeCancelChannel(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-cancel")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	previousState := channel.State
+
+	channel = k.restoreChannel(ctx, portID, channelID, sequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState, "new-state", types.OPEN.String())
+
+	emitChannelUpgradeCancelEvent(ctx, portID, channelID, channel)
+}
+
+// ChanUpgradeTimeout times out an outstanding upgrade.
+// This should be used by the initialising chain when the counterparty chain has not responded to an upgrade proposal within the specified timeout period.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyChannel types.Channel,
+	proofCounterpartyChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !collections.Contains(channel.State, []types.State{types.FLUSHING, types.FLUSHCOMPLETE}) {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected one of [%s, %s], got %s", types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connection, err := k.getConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	// proof must be from a height after timeout has elapsed. Either timeoutHeight or timeoutTimestamp must be defined.
+	// if timeoutHeight is defined and proof is from before timeout height, abort transaction
+	timeout := upgrade.Timeout
+	proofHeightIsInvalid := timeout.Height.IsZero() || proofHeight.LT(timeout.Height)
+	proofTimestampIsInvalid := timeout.Timestamp == 0 || proofTimestamp < timeout.Timestamp
+	if proofHeightIsInvalid && proofTimestampIsInvalid {
+		return errorsmod.Wrap(types.ErrInvalidUpgradeTimeout, "timeout has not yet passed on counterparty chain")
+	}
+
+	// counterparty channel must be proved to still be in OPEN state or FLUSHING state.
+	if !collections.Contains(counterpartyChannel.State, []types.State{types.OPEN, types.FLUSHING}) {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "expected one of [%s, %s], got %s", types.OPEN, types.FLUSHING, counterpartyChannel.State)
+	}
+
+	if counterpartyChannel.State == types.OPEN {
+		upgradeConnection, err := k.getConnection(ctx, upgrade.Fields.ConnectionHops[0])
+		if err != nil {
+			return err
+		}
+		counterpartyHops := []string{upgradeConnection.GetCounterparty().GetConnectionID()}
+
+		upgradeAlreadyComplete := upgrade.Fields.Version == counterpartyChannel.Version && upgrade.Fields.Ordering == counterpartyChannel.Ordering && upgrade.Fields.ConnectionHops[0] == counterpartyHops[0]
+		if upgradeAlreadyComplete {
+			// counterparty has already successfully upgraded so we cannot timeout
+			return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "counterparty channel is already upgraded")
+		}
+	}
+
+	if counterpartyChannel.UpgradeSequence < channel.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence,
+			"counterparty channel upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)",
+			counterpartyChannel.UpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	// verify the counterparty channel state
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx,
+		connection,
+		proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutChannel restores the channel state of an initialising chain in the event that the counterparty chain has passed the timeout set in ChanUpgradeInit to the state before the upgrade was proposed.
+// Auxiliary upgrade state is also deleted.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTimeoutChannel(ctx sdk.Context, portID, channelID string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-timeout")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTimeout step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when cancelling channel upgrade, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel = k.restoreChannel(ctx, portID, channelID, channel.UpgradeSequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrUpgradeTimeout))
+
+	k.Logger(ctx).Info("channel state restored", "port-id", portID, "channel-id", channelID)
+
+	emitChannelUpgradeTimeoutEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// startFlushing will set the upgrade last packet send and continue blocking the upgrade from continuing until all
+// in-flight packets have been flushed.
+func (k Keeper) startFlushing(ctx sdk.Context, portID, channelID string, upgrade *types.Upgrade) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the channel state must be in OPEN to start flushing
+	if channel.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
+	}
+
+	channel.State = types.FLUSHING
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade.NextSequenceSend = nextSequenceSend
+	upgrade.Timeout = k.getAbsoluteUpgradeTimeout(ctx)
+	k.SetUpgrade(ctx, portID, channelID, *upgrade)
+
+	return nil
+}
+
+// getAbsoluteUpgradeTimeout returns the absolute timeout for the given upgrade.
+func (k Keeper) getAbsoluteUpgradeTimeout(ctx sdk.Context) types.Timeout {
+	upgradeTimeout := k.GetParams(ctx).UpgradeTimeout
+	return types.NewTimeout(clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+upgradeTimeout.Timestamp)
+}
+
+// checkForUpgradeCompatibility checks performs stateful validation of self upgrade fields relative to counterparty upgrade.
+func (k Keeper) checkForUpgradeCompatibility(ctx sdk.Context, upgradeFields, counterpartyUpgradeFields types.UpgradeFields) error {
+	// assert that both sides propose the same channel ordering
+	if upgradeFields.Ordering != counterpartyUpgradeFields.Ordering {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade ordering (%s) to match counterparty upgrade ordering (%s)", upgradeFields.Ordering, counterpartyUpgradeFields.Ordering)
+	}
+
+	if upgradeFields.Version != counterpartyUpgradeFields.Version {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade version (%s) to match counterparty upgrade version (%s)", upgradeFields.Version, counterpartyUpgradeFields.Version)
+	}
+
+	connection, err := k.getConnection(ctx, upgradeFields.ConnectionHops[0])
+	if err != nil {
+		// NOTE: this error is expected to be unreachable as the proposed upgrade connectionID should have been
+		// validated in the upgrade INIT and TRY handlers
+		return errorsmod.Wrap(err, "failed to retrieve connection for proposed upgrade")
+	}
+
+	if counterpartyUpgradeFields.ConnectionHops[0] != connection.GetCounterparty().GetConnectionID() {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "counterparty upgrade connection end is not a counterparty of self proposed connection end (%s != %s)", counterpartyUpgradeFields.ConnectionHops[0], connection.GetCounterparty().GetConnectionID())
+	}
+
+	return nil
+}
+
+// validateSelfUpgradeFields validates the proposed upgrade fields against the existing channel.
+// It returns an error if the following constraints are not met:
+// - there exists at least one valid proposed change to the existing channel fields
+// - the proposed connection hops do not exist
+// - the proposed version is non-empty (checked in UpgradeFields.ValidateBasic())
+// - the proposed connection hops are not open
+func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types.UpgradeFields, currentChannel types.Channel) error {
+	currentFields := extractUpgradeFields(currentChannel)
+
+	if reflect.DeepEqual(proposedUpgrade, currentFields) {
+		return errorsmod.Wrapf(types.ErrInvalidUpgrade, "existing channel end is identical to proposed upgrade channel end: got %s", proposedUpgrade)
+	}
+
+	connection, err := k.getConnection(ctx, proposedUpgrade.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if len(connection.Versions) != 1 {
+		return errorsmod.Wrapf(connectiontypes.ErrInvalidVersion, "single version must be negotiated on connection before opening channel, got: %v", connection.Versions)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(connection.Versions[0], proposedUpgrade.Ordering.String()) {
+		return errorsmod.Wrapf(connectiontypes.ErrInvalidVersion, "connection version %s does not support channel ordering: %s", connection.Versions[0], proposedUpgrade.Ordering.String())
+	}
+
+	return nil
+}
+
+// getConnection returns the connection for the provided connection identifier. An error is returned
+// if the connection cannot be found or is not in the OPEN state.
+func (k Keeper) getConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connection.GetState()).String(),
+		)
+	}
+
+	return connection, nil
+}
+
+// extractUpgradeFields returns the upgrade fields from the provided channel.
+func extractUpgradeFields(channel types.Channel) types.UpgradeFields {
+	return types.UpgradeFields{
+		Ordering:       channel.Ordering,
+		ConnectionHops: channel.ConnectionHops,
+		Version:        channel.Version,
+	}
+}
+
+// MustAbortUpgrade will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+// This function is expected to always succeed, a panic will occur if an error occurs.
+func (k Keeper) MustAbortUpgrade(ctx sdk.Context, portID, channelID string, err error) {
+	if err := k.abortUpgrade(ctx, portID, channelID, err); err != nil {
+		panic(err)
+	}
+}
+
+// abortUpgrade will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is delete and an error receipt is written.
+func (k Keeper) abortUpgrade(ctx sdk.Context, portID, channelID string, err error) error {
+	if err == nil {
+		return errorsmod.Wrap(types.ErrInvalidUpgradeError, "cannot abort upgrade handshake with nil error")
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// in the case of application callbacks, the error may not be an upgrade error.
+	// in this case we need to construct one in order to write the error receipt.
+	upgradeError, ok := err.(*types.UpgradeError)
+	if !ok {
+		upgradeError = types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	// the channel upgrade sequence has already been updated in ChannelUpgradeTry, so we can pass
+	// its updated value.
+	k.restoreChannel(ctx, portID, channelID, upgradeError.GetErrorReceipt().Sequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, upgradeError)
+
+	return nil
+}
+
+// restoreChannel will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+func (k Keeper) restoreChannel(ctx sdk.Context, portID, channelID string, upgradeSequence uint64, channel types.Channel) types.Channel {
+	channel.State = types.OPEN
+	channel.UpgradeSequence = upgradeSequence
+
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+
+	return channel
+}
+
+// WriteErrorReceipt will write an error receipt from the provided UpgradeError.
+func (k Keeper) WriteErrorReceipt(ctx sdk.Context, portID, channelID string, upgradeError *types.UpgradeError) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	errorReceiptToWrite := upgradeError.GetErrorReceipt()
+
+	existingErrorReceipt, found := k.GetUpgradeErrorReceipt(ctx, portID, channelID)
+	if found && existingErrorReceipt.Sequence >= errorReceiptToWrite.Sequence {
+		panic(errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than existing error receipt sequence (%d)", errorReceiptToWrite.Sequence, existingErrorReceipt.Sequence))
+	}
+
+	k.setUpgradeErrorReceipt(ctx, portID, channelID, errorReceiptToWrite)
+	emitErrorReceiptEvent(ctx, portID, channelID, channel, upgradeError)
+}
@@ -0,0 +1,543 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestChanUpgradeInit() {
+	var (
+		path          *ibctesting.Path
+		upgradeFields types.UpgradeFields
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with later upgrade sequence",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.UpgradeSequence = 4
+				path.EndpointA.SetChannel(channel)
+			},
+			nil,
+		},
+		{
+			"identical upgrade channel end",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				upgradeFields = types.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, channel.Version)
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = "invalid-channel"
+				path.EndpointA.ChannelConfig.PortID = "invalid-port"
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel state is not in OPEN state",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.CLOSED))
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"proposed channel connection not found",
+			func() {
+				upgradeFields.ConnectionHops = []string{"connection-100"}
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
+		{
+			"invalid proposed channel connection state",
+			func() {
+				connectionEnd := path.EndpointA.GetConnection()
+				connectionEnd.State = connectiontypes.UNINITIALIZED
+
+				suite.chainA.GetSimApp().GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), "connection-100", connectionEnd)
+				upgradeFields.ConnectionHops = []string{"connection-100"}
+			},
+			connectiontypes.ErrInvalidConnectionState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			upgradeFields = path.EndpointA.GetProposedUpgrade().Fields
+
+			tc.malleate()
+
+			upgrade, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeFields,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.WriteUpgradeInitChannel(
+					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade, upgrade.Fields.Version,
+				)
+
+				suite.Require().Equal(channel.UpgradeSequence+1, path.EndpointA.GetChannel().UpgradeSequence)
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+				storedUpgrade, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(upgradeFields, storedUpgrade.Fields)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChanUpgradeTry() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointB.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel state is not in OPEN state",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(types.CLOSED))
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"connection not found",
+			func() {
+				channel := path.EndpointB.GetChannel()
+				channel.ConnectionHops = []string{"connection-100"}
+				path.EndpointB.SetChannel(channel)
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			counterpartyUpgrade, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			proofChannel, proofUpgrade, proofHeight := path.EndpointB.QueryChannelUpgradeProof()
+
+			_, upgrade, err := suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.ChanUpgradeTry(
+				suite.chainB.GetContext(),
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				path.EndpointB.GetProposedUpgrade().Fields.ConnectionHops,
+				counterpartyUpgrade.Fields,
+				path.EndpointA.GetChannel().UpgradeSequence,
+				proofChannel,
+				proofUpgrade,
+				proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(mock.UpgradeVersion, upgrade.Fields.Version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestChannelUpgradeHandshake tests a full channel upgrade handshake, from INIT to OPEN on both chains.
+func (suite *KeeperTestSuite) TestChannelUpgradeHandshake() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().Equal(types.FLUSHING, path.EndpointB.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+
+	// no in-flight packets exist so the channel on chainB moves directly to OPEN
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(mock.UpgradeVersion, channel.Version)
+		suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+		channelKeeper := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper
+		_, found := channelKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		suite.Require().False(found, "upgrade should be deleted once the channel is open")
+
+		_, found = channelKeeper.GetCounterpartyUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		suite.Require().False(found, "counterparty upgrade should be deleted once the channel is open")
+	}
+
+	// packets can be sent on the upgraded channel
+	sequence, err := path.EndpointA.SendPacket(clienttypes.NewHeight(1, 1000), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+}
+
+func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel state is not in FLUSHING or FLUSHCOMPLETE state",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.OPEN))
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"timeout has not passed",
+			func() {
+				upgrade, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				upgrade.Timeout = types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+			},
+			types.ErrInvalidUpgradeTimeout,
+		},
+		{
+			"counterparty channel state is not OPEN or FLUSHING",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(types.FLUSHCOMPLETE))
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			types.ErrInvalidCounterparty,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+			// expire the upgrade timeout on chainA
+			upgrade, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			upgrade.Timeout = types.NewTimeout(clienttypes.ZeroHeight(), 1)
+			suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			tc.malleate()
+
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proofChannel, proofHeight := suite.chainB.QueryProof(channelKey)
+
+			err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.ChanUpgradeTimeout(
+				suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.GetChannel(),
+				proofChannel,
+				proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				channel, _ := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.WriteUpgradeTimeoutChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(mock.Version, channel.Version)
+
+				errorReceipt, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channel.UpgradeSequence, errorReceipt.Sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChanUpgradeCancel() {
+	var (
+		path              *ibctesting.Path
+		errorReceipt      types.ErrorReceipt
+		errorReceiptProof []byte
+		proofHeight       clienttypes.Height
+		isAuthority       bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: authority cancels without proof",
+			func() {
+				isAuthority = true
+				errorReceiptProof = nil
+			},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ChannelUpgradeKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"empty error receipt proof",
+			func() {
+				errorReceiptProof = nil
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"error receipt sequence less than channel upgrade sequence",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.UpgradeSequence = errorReceipt.Sequence + 1
+				path.EndpointA.SetChannel(channel)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt sequence must match channel upgrade sequence in FLUSHCOMPLETE",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = types.FLUSHCOMPLETE
+				path.EndpointA.SetChannel(channel)
+
+				errorReceipt.Sequence++
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"invalid error receipt proof",
+			func() {
+				errorReceipt.Message = "invalid message"
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			isAuthority = false
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+			// cancel the upgrade on chainB so that an error receipt is written
+			suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.WriteUpgradeCancelChannel(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointB.GetChannel().UpgradeSequence,
+			)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var found bool
+			errorReceipt, found = suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+
+			errorReceiptProof, proofHeight = suite.chainB.QueryProof(host.ChannelUpgradeErrorKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.ChanUpgradeCancel(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, errorReceipt, errorReceiptProof, proofHeight, isAuthority,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteUpgradeCancelChannel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+	channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+	channelKeeper.WriteUpgradeCancelChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(mock.Version, channel.Version)
+	suite.Require().Equal(uint64(5), channel.UpgradeSequence)
+
+	_, found := channelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+
+	errorReceipt, found := channelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(5), errorReceipt.Sequence)
+}
+
+func (suite *KeeperTestSuite) TestWriteErrorReceipt() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+	upgradeError := types.NewUpgradeError(10, types.ErrInvalidUpgrade)
+
+	channelKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeError)
+
+	errorReceipt, found := channelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(upgradeError.GetErrorReceipt(), errorReceipt)
+
+	// writing an error receipt with a sequence that is not greater than the existing one panics
+	suite.Require().Panics(func() {
+		channelKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeError)
+	})
+
+	suite.Require().Panics(func() {
+		channelKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, types.NewUpgradeError(11, types.ErrInvalidUpgrade))
+	}, fmt.Sprintf("channel %s not found", ibctesting.InvalidID))
+}
+
+func (suite *KeeperTestSuite) TestMustAbortUpgrade() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointA.SetChannelState(types.FLUSHING))
+
+	channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+	channelKeeper.MustAbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.ErrInvalidUpgrade)
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+
+	_, found := channelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+
+	errorReceipt, found := channelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channel.UpgradeSequence, errorReceipt.Sequence)
+
+	suite.Require().Panics(func() {
+		channelKeeper.MustAbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nil)
+	})
+}
@@ -56,17 +56,16 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED) {
+	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED || ch.Ordering == ORDERED_ALLOW_TIMEOUT) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return errorsmod.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
+	if len(ch.ConnectionHops) == 0 {
+		return errorsmod.Wrap(ErrInvalidChannel, "connection hops cannot be empty")
 	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return errorsmod.Wrap(err, "invalid connection hop ID")
+	for _, connectionID := range ch.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return errorsmod.Wrap(err, "invalid connection hop ID")
+		}
 	}
 	return ch.Counterparty.ValidateBasic()
 }
@@ -105,13 +104,14 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
-		State:          ch.State,
-		Ordering:       ch.Ordering,
-		Counterparty:   ch.Counterparty,
-		ConnectionHops: ch.ConnectionHops,
-		Version:        ch.Version,
-		PortId:         portID,
-		ChannelId:      channelID,
+		State:           ch.State,
+		Ordering:        ch.Ordering,
+		Counterparty:    ch.Counterparty,
+		ConnectionHops:  ch.ConnectionHops,
+		Version:         ch.Version,
+		PortId:          portID,
+		ChannelId:       channelID,
+		UpgradeSequence: ch.UpgradeSequence,
 	}
 }
 
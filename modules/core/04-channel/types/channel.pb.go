@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a channel is in one of the following states:
-// CLOSED, INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// CLOSED, INIT, TRYOPEN, OPEN, FLUSHING, FLUSHCOMPLETE or UNINITIALIZED.
 type State int32
 
 const (
@@ -41,6 +41,10 @@ const (
 	// A channel has been closed and can no longer be used to send or receive
 	// packets.
 	CLOSED State = 4
+	// A channel has just accepted the upgrade handshake attempt and is flushing in-flight packets.
+	FLUSHING State = 5
+	// A channel has just completed flushing any in-flight packets.
+	FLUSHCOMPLETE State = 6
 )
 
 var State_name = map[int32]string{
@@ -49,6 +53,8 @@ var State_name = map[int32]string{
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
 	4: "STATE_CLOSED",
+	5: "STATE_FLUSHING",
+	6: "STATE_FLUSHCOMPLETE",
 }
 
 var State_value = map[string]int32{
@@ -57,6 +63,8 @@ var State_value = map[string]int32{
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
 	"STATE_CLOSED":                    4,
+	"STATE_FLUSHING":                  5,
+	"STATE_FLUSHCOMPLETE":             6,
 }
 
 func (x State) String() string {
@@ -78,18 +86,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered exactly in the order which they were sent, but
+	// packets may time out without closing the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -115,6 +128,9 @@ type Channel struct {
 	ConnectionHops []string `protobuf:"bytes,4,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty"`
 	// opaque channel version, which is agreed upon during the handshake
 	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// upgrade sequence indicates the latest upgrade attempt performed by this channel
+	// the value of 0 indicates the channel has never been upgraded
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -168,6 +184,9 @@ type IdentifiedChannel struct {
 	PortId string `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// channel identifier
 	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// upgrade sequence indicates the latest upgrade attempt performed by this channel
+	// the value of 0 indicates the channel has never been upgraded
+	UpgradeSequence uint64 `protobuf:"varint,8,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *IdentifiedChannel) Reset()         { *m = IdentifiedChannel{} }
@@ -543,6 +562,136 @@ func (m *Timeout) GetTimestamp() uint64 {
 	return 0
 }
 
+// Params defines the set of IBC channel parameters.
+type Params struct {
+	// the relative timeout after which channel upgrades will time out.
+	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the maximum amount of gas consumed in each end blocker to prune the acknowledgements and receipts
+	// of upgraded channels. Pruning in the end blocker is disabled if set to zero.
+	PruneGasLimit uint64 `protobuf:"varint,2,opt,name=prune_gas_limit,json=pruneGasLimit,proto3" json:"prune_gas_limit,omitempty"`
+	// the maximum size in bytes of the data of packets sent on any channel. The limit is disabled if set to zero.
+	MaxPacketDataBytes uint64 `protobuf:"varint,3,opt,name=max_packet_data_bytes,json=maxPacketDataBytes,proto3" json:"max_packet_data_bytes,omitempty"`
+	// the maximum packet data sizes of individual ports, overriding max_packet_data_bytes for packets sent on
+	// channels of the port.
+	PortMaxPacketDataBytes []PortMaxPacketDataBytes `protobuf:"bytes,4,rep,name=port_max_packet_data_bytes,json=portMaxPacketDataBytes,proto3" json:"port_max_packet_data_bytes"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetUpgradeTimeout() Timeout {
+	if m != nil {
+		return m.UpgradeTimeout
+	}
+	return Timeout{}
+}
+
+func (m *Params) GetPruneGasLimit() uint64 {
+	if m != nil {
+		return m.PruneGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketDataBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketDataBytes
+	}
+	return 0
+}
+
+func (m *Params) GetPortMaxPacketDataBytes() []PortMaxPacketDataBytes {
+	if m != nil {
+		return m.PortMaxPacketDataBytes
+	}
+	return nil
+}
+
+// PortMaxPacketDataBytes defines the maximum size in bytes of the data of packets sent on channels of a port.
+type PortMaxPacketDataBytes struct {
+	// the port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the maximum size in bytes of the packet data. The limit is disabled if set to zero.
+	MaxPacketDataBytes uint64 `protobuf:"varint,2,opt,name=max_packet_data_bytes,json=maxPacketDataBytes,proto3" json:"max_packet_data_bytes,omitempty"`
+}
+
+func (m *PortMaxPacketDataBytes) Reset()         { *m = PortMaxPacketDataBytes{} }
+func (m *PortMaxPacketDataBytes) String() string { return proto.CompactTextString(m) }
+func (*PortMaxPacketDataBytes) ProtoMessage()    {}
+func (*PortMaxPacketDataBytes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *PortMaxPacketDataBytes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortMaxPacketDataBytes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortMaxPacketDataBytes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortMaxPacketDataBytes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortMaxPacketDataBytes.Merge(m, src)
+}
+func (m *PortMaxPacketDataBytes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortMaxPacketDataBytes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortMaxPacketDataBytes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortMaxPacketDataBytes proto.InternalMessageInfo
+
+func (m *PortMaxPacketDataBytes) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PortMaxPacketDataBytes) GetMaxPacketDataBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketDataBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -554,65 +703,80 @@ func init() {
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*PortMaxPacketDataBytes)(nil), "ibc.core.channel.v1.PortMaxPacketDataBytes")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xe3, 0x54,
-	0x14, 0xb6, 0x53, 0xe7, 0xef, 0xa4, 0x69, 0xd3, 0x3b, 0x50, 0x2c, 0x6b, 0x70, 0x3c, 0x15, 0x88,
-	0x30, 0x68, 0xe2, 0xe9, 0x80, 0xf8, 0xdb, 0xb5, 0x89, 0x21, 0x16, 0x55, 0x12, 0x39, 0xe9, 0x82,
-	0xd9, 0x44, 0x8e, 0x7d, 0x49, 0xac, 0x49, 0x7c, 0x8d, 0x7d, 0x93, 0xd1, 0x88, 0x17, 0x18, 0xb2,
-	0xe2, 0x05, 0x22, 0x21, 0xf1, 0x10, 0xbc, 0xc2, 0x48, 0x6c, 0x66, 0x39, 0x2b, 0x84, 0xda, 0x17,
-	0x41, 0xbe, 0xf7, 0xba, 0x49, 0x46, 0x55, 0x85, 0x90, 0x58, 0xb1, 0xca, 0x3d, 0xdf, 0xf9, 0xce,
-	0x77, 0x8e, 0xbf, 0xe3, 0xf8, 0xc2, 0x83, 0x60, 0xec, 0x99, 0x1e, 0x89, 0xb1, 0xe9, 0x4d, 0xdd,
-	0x30, 0xc4, 0x33, 0x73, 0x79, 0x9a, 0x1d, 0x9b, 0x51, 0x4c, 0x28, 0x41, 0xf7, 0x82, 0xb1, 0xd7,
-	0x4c, 0x29, 0xcd, 0x0c, 0x5f, 0x9e, 0x6a, 0xef, 0x4c, 0xc8, 0x84, 0xb0, 0xbc, 0x99, 0x9e, 0x38,
-	0x55, 0xab, 0x6f, 0xd4, 0x66, 0x01, 0x0e, 0x29, 0x13, 0x63, 0x27, 0x4e, 0x38, 0xf9, 0x39, 0x07,
-	0xc5, 0x16, 0x57, 0x41, 0x8f, 0x21, 0x9f, 0x50, 0x97, 0x62, 0x55, 0x36, 0xe4, 0xc6, 0xc1, 0x13,
-	0xad, 0x79, 0x4b, 0x9f, 0xe6, 0x20, 0x65, 0x38, 0x9c, 0x88, 0x3e, 0x87, 0x12, 0x89, 0x7d, 0x1c,
-	0x07, 0xe1, 0x44, 0xcd, 0xdd, 0x51, 0xd4, 0x4b, 0x49, 0xce, 0x0d, 0x17, 0x7d, 0x07, 0xfb, 0x1e,
-	0x59, 0x84, 0x14, 0xc7, 0x91, 0x1b, 0xd3, 0x17, 0xea, 0x9e, 0x21, 0x37, 0x2a, 0x4f, 0x1e, 0xdc,
-	0x5a, 0xdb, 0xda, 0x22, 0x9e, 0x2b, 0xaf, 0xfe, 0xac, 0x4b, 0xce, 0x4e, 0x31, 0xfa, 0x08, 0x0e,
-	0x3d, 0x12, 0x86, 0xd8, 0xa3, 0x01, 0x09, 0x47, 0x53, 0x12, 0x25, 0xaa, 0x62, 0xec, 0x35, 0xca,
-	0xce, 0xc1, 0x06, 0xee, 0x90, 0x28, 0x41, 0x2a, 0x14, 0x97, 0x38, 0x4e, 0x02, 0x12, 0xaa, 0x79,
-	0x43, 0x6e, 0x94, 0x9d, 0x2c, 0xfc, 0x5a, 0x79, 0xf9, 0x6b, 0x5d, 0x3a, 0xf9, 0x23, 0x07, 0x47,
-	0xb6, 0x8f, 0x43, 0x1a, 0xfc, 0x10, 0x60, 0xff, 0x7f, 0xef, 0x0a, 0x7a, 0x0f, 0x8a, 0x11, 0x89,
-	0xe9, 0x28, 0xf0, 0xd5, 0x02, 0xcb, 0x14, 0xd2, 0xd0, 0xf6, 0xd1, 0xfb, 0x00, 0x62, 0x94, 0x34,
-	0x57, 0x64, 0xb9, 0xb2, 0x40, 0x6c, 0x5f, 0xb8, 0x79, 0x01, 0xfb, 0xdb, 0x43, 0x6e, 0xab, 0xc9,
-	0x77, 0xa8, 0xe5, 0x6e, 0x57, 0x7b, 0x93, 0x83, 0x42, 0xdf, 0xf5, 0x9e, 0x61, 0x8a, 0x34, 0x28,
-	0x25, 0xf8, 0xc7, 0x05, 0x0e, 0x3d, 0xbe, 0x13, 0xc5, 0xb9, 0x89, 0x51, 0x1d, 0x2a, 0x09, 0x59,
-	0xc4, 0x1e, 0x1e, 0xa5, 0xe2, 0x42, 0x0c, 0x38, 0xd4, 0x27, 0x31, 0x45, 0x1f, 0xc2, 0x81, 0x20,
-	0x88, 0x0e, 0xcc, 0xe5, 0xb2, 0x53, 0xe5, 0x68, 0xb6, 0xf4, 0x8f, 0xa1, 0xe6, 0xe3, 0x84, 0x06,
-	0xa1, 0xcb, 0xec, 0x63, 0x62, 0x0a, 0x23, 0x1e, 0x6e, 0xe1, 0x4c, 0xd1, 0x84, 0x7b, 0xdb, 0xd4,
-	0x4c, 0x96, 0x7b, 0x89, 0xb6, 0x52, 0x99, 0x36, 0x02, 0xc5, 0x77, 0xa9, 0xcb, 0x3c, 0xdd, 0x77,
-	0xd8, 0x19, 0x7d, 0x0b, 0x07, 0x34, 0x98, 0x63, 0xb2, 0xa0, 0xa3, 0x29, 0x0e, 0x26, 0x53, 0xca,
-	0x5c, 0xad, 0xec, 0xbc, 0x38, 0xfc, 0x6f, 0xbb, 0x3c, 0x6d, 0x76, 0x18, 0x43, 0x6c, 0xbd, 0x2a,
-	0xea, 0x38, 0x88, 0x3e, 0x81, 0xa3, 0x4c, 0x28, 0xfd, 0x4d, 0xa8, 0x3b, 0x8f, 0xd4, 0x12, 0x73,
-	0xa9, 0x26, 0x12, 0xc3, 0x0c, 0x17, 0xd6, 0xfe, 0x04, 0x15, 0xee, 0x2c, 0x7b, 0x89, 0xff, 0xed,
-	0x9e, 0x76, 0xd6, 0xb2, 0xf7, 0xd6, 0x5a, 0xb2, 0x47, 0x56, 0x36, 0x8f, 0x2c, 0x9a, 0xfb, 0x50,
-	0xe2, 0xcd, 0x6d, 0xff, 0xbf, 0xe8, 0x2c, 0xba, 0xf4, 0xe0, 0xf0, 0xcc, 0x7b, 0x16, 0x92, 0xe7,
-	0x33, 0xec, 0x4f, 0xf0, 0x1c, 0x87, 0x14, 0xa9, 0x50, 0x88, 0x71, 0xb2, 0x98, 0x51, 0xf5, 0xdd,
-	0x74, 0xa8, 0x8e, 0xe4, 0x88, 0x18, 0x1d, 0x43, 0x1e, 0xc7, 0x31, 0x89, 0xd5, 0xe3, 0xb4, 0x51,
-	0x47, 0x72, 0x78, 0x78, 0x0e, 0x50, 0x8a, 0x71, 0x12, 0x91, 0x30, 0xc1, 0x27, 0x2e, 0x14, 0x87,
-	0xdc, 0x4d, 0xf4, 0x25, 0x14, 0xc4, 0xca, 0xe4, 0x7f, 0xb8, 0x32, 0xc1, 0x47, 0xf7, 0xa1, 0xbc,
-	0xd9, 0x51, 0x8e, 0x0d, 0xbe, 0x01, 0x1e, 0xfe, 0x2e, 0x43, 0x7e, 0x20, 0xbe, 0x27, 0xf5, 0xc1,
-	0xf0, 0x6c, 0x68, 0x8d, 0x2e, 0xbb, 0x76, 0xd7, 0x1e, 0xda, 0x67, 0x17, 0xf6, 0x53, 0xab, 0x3d,
-	0xba, 0xec, 0x0e, 0xfa, 0x56, 0xcb, 0xfe, 0xc6, 0xb6, 0xda, 0x35, 0x49, 0x3b, 0x5a, 0xad, 0x8d,
-	0xea, 0x0e, 0x01, 0xa9, 0x00, 0xbc, 0x2e, 0x05, 0x6b, 0xb2, 0x56, 0x5a, 0xad, 0x0d, 0x25, 0x3d,
-	0x23, 0x1d, 0xaa, 0x3c, 0x33, 0x74, 0xbe, 0xef, 0xf5, 0xad, 0x6e, 0x2d, 0xa7, 0x55, 0x56, 0x6b,
-	0xa3, 0x28, 0xc2, 0x4d, 0x25, 0x4b, 0xee, 0xf1, 0x4a, 0x96, 0xb9, 0x0f, 0xfb, 0x3c, 0xd3, 0xba,
-	0xe8, 0x0d, 0xac, 0x76, 0x4d, 0xd1, 0x60, 0xb5, 0x36, 0x0a, 0x3c, 0xd2, 0x94, 0x97, 0xbf, 0xe9,
-	0xd2, 0xc3, 0xe7, 0x90, 0x67, 0x9f, 0x36, 0xf4, 0x01, 0x1c, 0xf7, 0x9c, 0xb6, 0xe5, 0x8c, 0xba,
-	0xbd, 0xae, 0xf5, 0xd6, 0xbc, 0x4c, 0x32, 0xc5, 0xd1, 0x09, 0x1c, 0x72, 0xd6, 0x65, 0x97, 0xfd,
-	0x5a, 0xed, 0x9a, 0xac, 0x55, 0x57, 0x6b, 0xa3, 0x7c, 0x03, 0xa4, 0x03, 0x73, 0x4e, 0xc6, 0x10,
-	0x03, 0x8b, 0x90, 0x37, 0x3e, 0x1f, 0xbc, 0xba, 0xd2, 0xe5, 0xd7, 0x57, 0xba, 0xfc, 0xd7, 0x95,
-	0x2e, 0xff, 0x72, 0xad, 0x4b, 0xaf, 0xaf, 0x75, 0xe9, 0xcd, 0xb5, 0x2e, 0x3d, 0xfd, 0x6a, 0x12,
-	0xd0, 0xe9, 0x62, 0xdc, 0xf4, 0xc8, 0xdc, 0xf4, 0x48, 0x32, 0x27, 0x89, 0x19, 0x8c, 0xbd, 0x47,
-	0x13, 0x62, 0x2e, 0xbf, 0x30, 0xe7, 0xc4, 0x5f, 0xcc, 0x70, 0xc2, 0xef, 0xc9, 0xc7, 0x9f, 0x3d,
-	0xca, 0x2e, 0x5e, 0xfa, 0x22, 0xc2, 0xc9, 0xb8, 0xc0, 0x2e, 0xca, 0x4f, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x80, 0x43, 0x1c, 0x54, 0x99, 0x07, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x65, 0x3d, 0xaf, 0xac, 0x87, 0xc7, 0xb5, 0xab, 0xb2, 0xae, 0xcc, 0x08, 0x7d, 0x38,
+	0x0e, 0x22, 0xc5, 0x6e, 0xd1, 0x47, 0x76, 0xb6, 0xc5, 0xd8, 0x44, 0x64, 0xc9, 0xa0, 0x64, 0x14,
+	0xcd, 0x86, 0xa0, 0xc8, 0xa9, 0x4c, 0x44, 0xe2, 0xb0, 0xe4, 0xc8, 0x8d, 0xd1, 0x75, 0x81, 0x40,
+	0xab, 0xfe, 0x80, 0xd0, 0x02, 0xfd, 0x84, 0xf6, 0x23, 0xb2, 0xcc, 0x32, 0xab, 0xa2, 0xb0, 0xff,
+	0xa1, 0xeb, 0x82, 0x33, 0x43, 0x4b, 0x72, 0x65, 0xa3, 0x28, 0xd0, 0x5d, 0x56, 0x9a, 0x7b, 0xce,
+	0xb9, 0xef, 0x11, 0x49, 0xb8, 0xe7, 0xf4, 0xac, 0xba, 0x45, 0x7c, 0x5c, 0xb7, 0xce, 0x4c, 0xd7,
+	0xc5, 0x83, 0xfa, 0xf9, 0x4e, 0x74, 0xac, 0x79, 0x3e, 0xa1, 0x04, 0xad, 0x3a, 0x3d, 0xab, 0x16,
+	0x4a, 0x6a, 0x11, 0x7e, 0xbe, 0x23, 0xbf, 0xd3, 0x27, 0x7d, 0xc2, 0xf8, 0x7a, 0x78, 0xe2, 0x52,
+	0x79, 0x73, 0x1a, 0x6d, 0xe0, 0x60, 0x97, 0xb2, 0x60, 0xec, 0xc4, 0x05, 0xd5, 0xdf, 0xe3, 0x90,
+	0x3e, 0xe0, 0x51, 0xd0, 0x23, 0x48, 0x06, 0xd4, 0xa4, 0xb8, 0x2c, 0x29, 0xd2, 0x56, 0x61, 0x57,
+	0xae, 0x2d, 0xc8, 0x53, 0xeb, 0x84, 0x0a, 0x9d, 0x0b, 0xd1, 0xe7, 0x90, 0x21, 0xbe, 0x8d, 0x7d,
+	0xc7, 0xed, 0x97, 0xe3, 0x77, 0x38, 0xb5, 0x43, 0x91, 0x7e, 0xad, 0x45, 0x4f, 0x61, 0xd9, 0x22,
+	0x23, 0x97, 0x62, 0xdf, 0x33, 0x7d, 0x7a, 0x51, 0x5e, 0x52, 0xa4, 0xad, 0xdc, 0xee, 0xbd, 0x85,
+	0xbe, 0x07, 0x33, 0xc2, 0xfd, 0xc4, 0xab, 0x3f, 0x36, 0x63, 0xfa, 0x9c, 0x33, 0xfa, 0x04, 0x8a,
+	0x16, 0x71, 0x5d, 0x6c, 0x51, 0x87, 0xb8, 0xc6, 0x19, 0xf1, 0x82, 0x72, 0x42, 0x59, 0xda, 0xca,
+	0xea, 0x85, 0x29, 0x7c, 0x44, 0xbc, 0x00, 0x95, 0x21, 0x7d, 0x8e, 0xfd, 0xc0, 0x21, 0x6e, 0x39,
+	0xa9, 0x48, 0x5b, 0x59, 0x3d, 0x32, 0xd1, 0x7d, 0x28, 0x8d, 0xbc, 0xbe, 0x6f, 0xda, 0xd8, 0x08,
+	0xf0, 0x77, 0x23, 0xec, 0x5a, 0xb8, 0x9c, 0x52, 0xa4, 0xad, 0x84, 0x5e, 0x14, 0x78, 0x47, 0xc0,
+	0x8f, 0x13, 0x2f, 0x7f, 0xd9, 0x8c, 0x55, 0xff, 0x8a, 0xc3, 0x8a, 0x66, 0x63, 0x97, 0x3a, 0xdf,
+	0x3a, 0xd8, 0x7e, 0x3b, 0xc0, 0x77, 0x21, 0xed, 0x11, 0x9f, 0x1a, 0x8e, 0xcd, 0xe6, 0x96, 0xd5,
+	0x53, 0xa1, 0xa9, 0xd9, 0xe8, 0x03, 0x00, 0x51, 0x4a, 0xc8, 0xa5, 0x19, 0x97, 0x15, 0x88, 0x66,
+	0x2f, 0x1c, 0x7c, 0xe6, 0xae, 0xc1, 0x37, 0x61, 0x79, 0xb6, 0x9f, 0xd9, 0xc4, 0xd2, 0x1d, 0x89,
+	0xe3, 0x37, 0x12, 0x8b, 0x68, 0x6f, 0xe2, 0x90, 0x3a, 0x31, 0xad, 0xe7, 0x98, 0x22, 0x19, 0x32,
+	0xd7, 0x15, 0x48, 0xac, 0x82, 0x6b, 0x1b, 0x6d, 0x42, 0x2e, 0x20, 0x23, 0xdf, 0xc2, 0x46, 0x18,
+	0x5c, 0x04, 0x03, 0x0e, 0x9d, 0x10, 0x9f, 0xa2, 0x8f, 0xa0, 0x20, 0x04, 0x22, 0x03, 0x5b, 0x48,
+	0x56, 0xcf, 0x73, 0x34, 0xba, 0x1f, 0xf7, 0xa1, 0x64, 0xe3, 0x80, 0x3a, 0xae, 0xc9, 0x26, 0xcd,
+	0x82, 0x25, 0x98, 0xb0, 0x38, 0x83, 0xb3, 0x88, 0x75, 0x58, 0x9d, 0x95, 0x46, 0x61, 0xf9, 0xd8,
+	0xd1, 0x0c, 0x15, 0xc5, 0x46, 0x90, 0xb0, 0x4d, 0x6a, 0xb2, 0xf1, 0x2f, 0xeb, 0xec, 0x8c, 0x0e,
+	0xa1, 0x40, 0x9d, 0x21, 0x26, 0x23, 0x6a, 0x9c, 0x61, 0xa7, 0x7f, 0x46, 0xd9, 0x02, 0x72, 0x73,
+	0x77, 0x8c, 0x3f, 0x0c, 0xce, 0x77, 0x6a, 0x47, 0x4c, 0x21, 0x2e, 0x48, 0x5e, 0xf8, 0x71, 0x10,
+	0x3d, 0x80, 0x95, 0x28, 0x50, 0xf8, 0x1b, 0x50, 0x73, 0xe8, 0x89, 0x3d, 0x95, 0x04, 0xd1, 0x8d,
+	0x70, 0x31, 0xda, 0x1f, 0x20, 0xc7, 0x27, 0xcb, 0xee, 0xfb, 0x7f, 0xdd, 0xd3, 0xdc, 0x5a, 0x96,
+	0x6e, 0xac, 0x25, 0x6a, 0x39, 0x31, 0x6d, 0x59, 0x24, 0xb7, 0x21, 0xc3, 0x93, 0x6b, 0xf6, 0xff,
+	0x91, 0x59, 0x64, 0x69, 0x43, 0x71, 0xcf, 0x7a, 0xee, 0x92, 0xef, 0x07, 0xd8, 0xee, 0xe3, 0x21,
+	0x76, 0x29, 0x2a, 0x43, 0xca, 0xc7, 0xc1, 0x68, 0x40, 0xcb, 0x6b, 0x61, 0x51, 0x47, 0x31, 0x5d,
+	0xd8, 0x68, 0x1d, 0x92, 0xd8, 0xf7, 0x89, 0x5f, 0x5e, 0x0f, 0x13, 0x1d, 0xc5, 0x74, 0x6e, 0xee,
+	0x03, 0x64, 0x7c, 0x1c, 0x78, 0xc4, 0x0d, 0x70, 0xd5, 0x84, 0x74, 0x97, 0x4f, 0x13, 0x7d, 0x09,
+	0x29, 0xb1, 0x32, 0xe9, 0x5f, 0xae, 0x4c, 0xe8, 0xd1, 0x06, 0x64, 0xa7, 0x3b, 0x8a, 0xb3, 0xc2,
+	0xa7, 0x40, 0xf5, 0x67, 0x76, 0xe3, 0x7d, 0x73, 0x18, 0xa0, 0xa7, 0x10, 0xfd, 0xc7, 0x0c, 0xb1,
+	0x43, 0x91, 0x6b, 0x63, 0xe1, 0x63, 0x44, 0x54, 0x26, 0xb2, 0x15, 0x84, 0x6b, 0x54, 0xef, 0xc7,
+	0x50, 0xf4, 0xfc, 0x91, 0x8b, 0x8d, 0xbe, 0x19, 0x18, 0x03, 0x67, 0xe8, 0x50, 0x91, 0x3b, 0xcf,
+	0xe0, 0x43, 0x33, 0x68, 0x86, 0x20, 0xda, 0x81, 0xb5, 0xa1, 0xf9, 0xc2, 0xf0, 0xd8, 0x76, 0x8c,
+	0x70, 0x65, 0x46, 0xef, 0x82, 0xe2, 0x40, 0x8c, 0x18, 0x0d, 0xcd, 0x17, 0x7c, 0x73, 0x0d, 0x93,
+	0x9a, 0xfb, 0x21, 0x83, 0x86, 0x20, 0xb3, 0x05, 0x2e, 0xf6, 0x0b, 0x9f, 0x54, 0xb9, 0xdd, 0x07,
+	0x0b, 0x4b, 0x0e, 0xff, 0x49, 0xc7, 0xff, 0x08, 0x28, 0x3a, 0x58, 0xf7, 0x16, 0xb2, 0x55, 0x1b,
+	0xd6, 0x17, 0xfb, 0xdd, 0x7e, 0x93, 0x6e, 0x6d, 0x2a, 0x7e, 0x5b, 0x53, 0xdb, 0x3f, 0xc6, 0x21,
+	0xd9, 0x11, 0xaf, 0x80, 0xcd, 0x4e, 0x77, 0xaf, 0xab, 0x1a, 0xa7, 0x2d, 0xad, 0xa5, 0x75, 0xb5,
+	0xbd, 0xa6, 0xf6, 0x4c, 0x6d, 0x18, 0xa7, 0xad, 0xce, 0x89, 0x7a, 0xa0, 0x3d, 0xd1, 0xd4, 0x46,
+	0x29, 0x26, 0xaf, 0x8c, 0x27, 0x4a, 0x7e, 0x4e, 0x80, 0xca, 0x00, 0xdc, 0x2f, 0x04, 0x4b, 0x92,
+	0x9c, 0x19, 0x4f, 0x94, 0x44, 0x78, 0x46, 0x15, 0xc8, 0x73, 0xa6, 0xab, 0x7f, 0xd3, 0x3e, 0x51,
+	0x5b, 0xa5, 0xb8, 0x9c, 0x1b, 0x4f, 0x94, 0xb4, 0x30, 0xa7, 0x9e, 0x8c, 0x5c, 0xe2, 0x9e, 0x8c,
+	0xd9, 0x80, 0x65, 0xce, 0x1c, 0x34, 0xdb, 0x1d, 0xb5, 0x51, 0x4a, 0xc8, 0x30, 0x9e, 0x28, 0x29,
+	0x6e, 0x21, 0x05, 0x0a, 0x9c, 0x7d, 0xd2, 0x3c, 0xed, 0x1c, 0x69, 0xad, 0xc3, 0x52, 0x52, 0x5e,
+	0x1e, 0x4f, 0x94, 0x4c, 0x64, 0xa3, 0x6d, 0x58, 0x9d, 0x51, 0x1c, 0xb4, 0x8f, 0x4f, 0x9a, 0x6a,
+	0x57, 0x2d, 0xa5, 0x78, 0xfd, 0x73, 0xa0, 0x9c, 0x78, 0xf9, 0x6b, 0x25, 0xb6, 0xfd, 0x9b, 0x04,
+	0x49, 0xf6, 0x72, 0x43, 0x1f, 0xc2, 0x7a, 0x5b, 0x6f, 0xa8, 0xba, 0xd1, 0x6a, 0xb7, 0xd4, 0x1b,
+	0xed, 0xb3, 0x0a, 0x43, 0x1c, 0x55, 0xa1, 0xc8, 0x55, 0xa7, 0x2d, 0xf6, 0xab, 0x36, 0x4a, 0x92,
+	0x9c, 0x1f, 0x4f, 0x94, 0xec, 0x35, 0x10, 0xf6, 0xcf, 0x35, 0x91, 0x42, 0xf4, 0x1f, 0xf1, 0x8f,
+	0xe1, 0xfd, 0x39, 0xde, 0xd8, 0x6b, 0x36, 0xdb, 0x5f, 0x1b, 0x5d, 0xed, 0x58, 0x6d, 0x9f, 0x76,
+	0x4b, 0x4b, 0xf2, 0x7b, 0xe3, 0x89, 0xb2, 0xb6, 0x90, 0xe4, 0x55, 0xef, 0x77, 0x5e, 0x5d, 0x56,
+	0xa4, 0xd7, 0x97, 0x15, 0xe9, 0xcf, 0xcb, 0x8a, 0xf4, 0xd3, 0x55, 0x25, 0xf6, 0xfa, 0xaa, 0x12,
+	0x7b, 0x73, 0x55, 0x89, 0x3d, 0xfb, 0xaa, 0xef, 0xd0, 0xb3, 0x51, 0xaf, 0x66, 0x91, 0x61, 0xdd,
+	0x22, 0xc1, 0x90, 0x04, 0x75, 0xa7, 0x67, 0x3d, 0xec, 0x93, 0xfa, 0xf9, 0x17, 0xf5, 0x21, 0xb1,
+	0x47, 0x03, 0x1c, 0xf0, 0x0f, 0xb2, 0x47, 0x9f, 0x3d, 0x8c, 0xbe, 0xf0, 0xe8, 0x85, 0x87, 0x83,
+	0x5e, 0x8a, 0x7d, 0x91, 0x7d, 0xfa, 0xf7, 0x00, 0x78, 0x82, 0x69, 0xdf, 0x02, 0x0a, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -635,6 +799,11 @@ func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -694,6 +863,11 @@ func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -1057,6 +1231,98 @@ func (m *Timeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortMaxPacketDataBytes) > 0 {
+		for iNdEx := len(m.PortMaxPacketDataBytes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortMaxPacketDataBytes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxPacketDataBytes != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketDataBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PruneGasLimit != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.PruneGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PortMaxPacketDataBytes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortMaxPacketDataBytes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortMaxPacketDataBytes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPacketDataBytes != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketDataBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1092,6 +1358,9 @@ func (m *Channel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -1127,6 +1396,9 @@ func (m *IdentifiedChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -1276,6 +1548,45 @@ func (m *Timeout) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UpgradeTimeout.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.PruneGasLimit != 0 {
+		n += 1 + sovChannel(uint64(m.PruneGasLimit))
+	}
+	if m.MaxPacketDataBytes != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketDataBytes))
+	}
+	if len(m.PortMaxPacketDataBytes) > 0 {
+		for _, e := range m.PortMaxPacketDataBytes {
+			l = e.Size()
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PortMaxPacketDataBytes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.MaxPacketDataBytes != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketDataBytes))
+	}
+	return n
+}
+
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1446,6 +1757,25 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1695,6 +2025,25 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2630,6 +2979,262 @@ func (m *Timeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneGasLimit", wireType)
+			}
+			m.PruneGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataBytes", wireType)
+			}
+			m.MaxPacketDataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortMaxPacketDataBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortMaxPacketDataBytes = append(m.PortMaxPacketDataBytes, PortMaxPacketDataBytes{})
+			if err := m.PortMaxPacketDataBytes[len(m.PortMaxPacketDataBytes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortMaxPacketDataBytes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortMaxPacketDataBytes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortMaxPacketDataBytes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataBytes", wireType)
+			}
+			m.MaxPacketDataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
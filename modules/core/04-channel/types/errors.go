@@ -40,4 +40,28 @@ var (
 	ErrInvalidChannelVersion = errorsmod.Register(SubModuleName, 24, "invalid channel version")
 	ErrPacketNotSent         = errorsmod.Register(SubModuleName, 25, "packet has not been sent")
 	ErrInvalidTimeout        = errorsmod.Register(SubModuleName, 26, "invalid packet timeout")
+
+	// channel upgrade errors
+	ErrInvalidUpgrade                  = errorsmod.Register(SubModuleName, 27, "invalid upgrade")
+	ErrInvalidUpgradeSequence          = errorsmod.Register(SubModuleName, 28, "invalid upgrade sequence")
+	ErrUpgradeNotFound                 = errorsmod.Register(SubModuleName, 29, "upgrade not found")
+	ErrIncompatibleCounterpartyUpgrade = errorsmod.Register(SubModuleName, 30, "incompatible counterparty upgrade")
+	ErrInvalidUpgradeError             = errorsmod.Register(SubModuleName, 31, "invalid upgrade error")
+	ErrUpgradeTimeout                  = errorsmod.Register(SubModuleName, 32, "upgrade timed-out")
+	ErrInvalidUpgradeTimeout           = errorsmod.Register(SubModuleName, 33, "upgrade timeout is invalid")
+	ErrUpgradeErrorNotFound            = errorsmod.Register(SubModuleName, 34, "upgrade error receipt not found")
+	ErrUpgradeTimeoutFailed            = errorsmod.Register(SubModuleName, 35, "upgrade timeout failed")
+	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 36, "timeout elapsed")
+	ErrTimeoutNotReached               = errorsmod.Register(SubModuleName, 37, "timeout not reached")
+
+	// ErrTimeoutReceiptWritten indicates that a timed-out packet was received on an ORDERED_ALLOW_TIMEOUT
+	// channel and a timeout receipt was written instead of executing the packet
+	ErrTimeoutReceiptWritten = errorsmod.Register(SubModuleName, 38, "packet timed out, timeout receipt written")
+
+	// acknowledgement pruning errors
+	ErrPruningSequenceStartNotFound = errorsmod.Register(SubModuleName, 39, "pruning sequence start not found")
+	ErrPruningSequenceEndNotFound   = errorsmod.Register(SubModuleName, 40, "pruning sequence end not found")
+
+	ErrInvalidChannelParams = errorsmod.Register(SubModuleName, 41, "invalid channel params")
+	ErrPacketDataTooLarge   = errorsmod.Register(SubModuleName, 42, "packet data exceeds maximum size")
 )
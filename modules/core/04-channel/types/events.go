@@ -15,12 +15,22 @@ const (
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
 
+	AttributeKeyChannelState            = "channel_state"
+	AttributeKeyUpgradeSequence         = "upgrade_sequence"
+	AttributeKeyUpgradeVersion          = "upgrade_version"
+	AttributeKeyUpgradeConnectionHops   = "upgrade_connection_hops"
+	AttributeKeyUpgradeOrdering         = "upgrade_ordering"
+	AttributeKeyUpgradeTimeoutHeight    = "upgrade_timeout_height"
+	AttributeKeyUpgradeTimeoutTimestamp = "upgrade_timeout_timestamp"
+	AttributeKeyUpgradeErrorReceipt     = "upgrade_error_receipt"
+
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
 	EventTypeWriteAck             = "write_acknowledgement"
 	EventTypeAcknowledgePacket    = "acknowledge_packet"
 	EventTypeTimeoutPacket        = "timeout_packet"
 	EventTypeTimeoutPacketOnClose = "timeout_on_close_packet"
+	EventTypeWriteTimeoutReceipt  = "write_timeout_receipt"
 
 	// Deprecated: in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -50,5 +60,15 @@ var (
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 	EventTypeChannelClosed       = "channel_close"
 
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeOpen    = "channel_upgrade_open"
+	EventTypeChannelUpgradeTimeout = "channel_upgrade_timeout"
+	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
+	EventTypeChannelUpgradeError   = "channel_upgrade_error"
+	EventTypeChannelFlushComplete  = "channel_flush_complete"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
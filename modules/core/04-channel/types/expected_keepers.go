@@ -53,6 +53,16 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -71,6 +81,59 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyChannelUpgrade(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade Upgrade,
+	) error
+	VerifyChannelUpgradeError(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt ErrorReceipt,
+	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		acknowledgements map[uint64][]byte,
+	) error
+	VerifyMultihopMembership(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path string,
+		value []byte,
+	) error
+	VerifyMultihopNonMembership(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path string,
+	) error
 }
 
 // PortKeeper expected account IBC port keeper
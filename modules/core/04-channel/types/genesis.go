@@ -44,7 +44,7 @@ func (ps PacketSequence) Validate() error {
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
-	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
+	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64, params Params,
 ) GenesisState {
 	return GenesisState{
 		Channels:            channels,
@@ -54,20 +54,25 @@ func NewGenesisState(
 		RecvSequences:       recvSeqs,
 		AckSequences:        ackSeqs,
 		NextChannelSequence: nextChannelSequence,
+		Params:              params,
 	}
 }
 
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		Params:                DefaultParams(),
+		RecvStartSequences:    []PacketSequence{},
+		PruningSequenceStarts: []PacketSequence{},
+		PruningSequenceEnds:   []PacketSequence{},
 	}
 }
 
@@ -139,6 +144,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, rs := range gs.RecvStartSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid recv start sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
+	for i, ps := range gs.PruningSequenceStarts {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence start %v index %d: %w", ps, i, err)
+		}
+	}
+
+	for i, ps := range gs.PruningSequenceEnds {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence end %v index %d: %w", ps, i, err)
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -34,6 +34,13 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
+	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the sequences below which packets are considered received on upgraded channels
+	RecvStartSequences []PacketSequence `protobuf:"bytes,10,rep,name=recv_start_sequences,json=recvStartSequences,proto3" json:"recv_start_sequences"`
+	// the next sequences to prune on upgraded channels
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,11,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts"`
+	// the sequences up to which acknowledgements and receipts can be pruned on upgraded channels
+	PruningSequenceEnds []PacketSequence `protobuf:"bytes,12,rep,name=pruning_sequence_ends,json=pruningSequenceEnds,proto3" json:"pruning_sequence_ends"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +132,34 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRecvStartSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvStartSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceEnds() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceEnds
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,35 +230,41 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd3, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x07, 0xf0, 0x66, 0x2d, 0x5d, 0xe7, 0xbd, 0x08, 0x3c, 0x10, 0xa1, 0x12, 0x59, 0x19, 0x12,
-	0xea, 0x65, 0x31, 0x1b, 0x48, 0x88, 0x6b, 0x38, 0x40, 0x2f, 0x68, 0xca, 0x6e, 0x48, 0xa8, 0x4a,
-	0xec, 0x87, 0xcc, 0x4a, 0x63, 0x87, 0xd8, 0x0d, 0xf0, 0x2d, 0xf8, 0x58, 0x3b, 0xee, 0xc8, 0x69,
-	0x42, 0xed, 0x87, 0x40, 0xe2, 0x84, 0xe2, 0xbc, 0xac, 0xa8, 0xd5, 0xa4, 0xdc, 0x6a, 0x3f, 0xcf,
-	0xff, 0xf7, 0xef, 0x21, 0x46, 0xcf, 0x78, 0x48, 0x09, 0x95, 0x19, 0x10, 0x7a, 0x19, 0x08, 0x01,
-	0x33, 0x92, 0x9f, 0x92, 0x08, 0x04, 0x28, 0xae, 0xdc, 0x34, 0x93, 0x5a, 0xe2, 0x43, 0x1e, 0x52,
-	0xb7, 0x58, 0x71, 0xab, 0x15, 0x37, 0x3f, 0x1d, 0x3e, 0x8c, 0x64, 0x24, 0xcd, 0x9c, 0x14, 0xbf,
-	0xca, 0xd5, 0xe1, 0x46, 0xad, 0x4e, 0x99, 0x95, 0xe3, 0x3f, 0x3d, 0xb4, 0xf7, 0xbe, 0xf4, 0x2f,
-	0x74, 0xa0, 0x01, 0x7f, 0x46, 0x83, 0x6a, 0x43, 0xd9, 0xd6, 0xa8, 0x3b, 0xde, 0x3d, 0x7b, 0xe1,
-	0x6e, 0x68, 0x74, 0x27, 0x0c, 0x84, 0xe6, 0x5f, 0x38, 0xb0, 0x77, 0xe5, 0xa5, 0xf7, 0xe4, 0xea,
-	0xe6, 0xa8, 0xf3, 0xf7, 0xe6, 0xe8, 0xc1, 0xda, 0xc8, 0x6f, 0x48, 0xec, 0xa3, 0xfb, 0x01, 0x8d,
-	0x85, 0xfc, 0x36, 0x03, 0x16, 0x41, 0x02, 0x42, 0x2b, 0x7b, 0xcb, 0xd4, 0x8c, 0x36, 0xd6, 0x9c,
-	0x07, 0x34, 0x06, 0x6d, 0xfe, 0x9a, 0xd7, 0x2b, 0x0a, 0xfc, 0xb5, 0x3c, 0xfe, 0x80, 0x76, 0xa9,
-	0x4c, 0x12, 0xae, 0x4b, 0xae, 0xdb, 0x8a, 0x5b, 0x8d, 0x62, 0x0f, 0x0d, 0x32, 0xa0, 0xc0, 0x53,
-	0xad, 0xec, 0x5e, 0x2b, 0xa6, 0xc9, 0xe1, 0x73, 0x74, 0xa0, 0x40, 0xb0, 0xa9, 0x82, 0xaf, 0x73,
-	0x10, 0x14, 0x94, 0x7d, 0xcf, 0x48, 0xcf, 0xef, 0x92, 0xaa, 0xdd, 0x0a, 0xdb, 0x2f, 0x80, 0xfa,
-	0xce, 0x88, 0x19, 0xd0, 0x7c, 0x45, 0xec, 0xb7, 0x16, 0x0b, 0xe0, 0x56, 0xfc, 0x88, 0xf6, 0x03,
-	0x1a, 0xaf, 0x80, 0xdb, 0x6d, 0xc1, 0xbd, 0x80, 0xc6, 0xb7, 0xde, 0x19, 0x7a, 0x24, 0xe0, 0xbb,
-	0x9e, 0x56, 0xa9, 0x06, 0xb6, 0x07, 0x23, 0x6b, 0xdc, 0xf3, 0x0f, 0x8b, 0x61, 0xf5, 0x2d, 0xd4,
-	0xa1, 0x63, 0x86, 0x0e, 0xfe, 0x97, 0xf1, 0x63, 0xb4, 0x9d, 0xca, 0x4c, 0x4f, 0x39, 0xb3, 0xad,
-	0x91, 0x35, 0xde, 0xf1, 0xfb, 0xc5, 0x71, 0xc2, 0xf0, 0x53, 0x84, 0x6a, 0x99, 0x33, 0x7b, 0xcb,
-	0xcc, 0x76, 0xaa, 0x9b, 0x09, 0xc3, 0x43, 0x34, 0x68, 0x0a, 0xbb, 0xa6, 0xb0, 0x39, 0x7b, 0x17,
-	0x57, 0x0b, 0xc7, 0xba, 0x5e, 0x38, 0xd6, 0xef, 0x85, 0x63, 0xfd, 0x5c, 0x3a, 0x9d, 0xeb, 0xa5,
-	0xd3, 0xf9, 0xb5, 0x74, 0x3a, 0x9f, 0xde, 0x46, 0x5c, 0x5f, 0xce, 0x43, 0x97, 0xca, 0x84, 0x50,
-	0xa9, 0x12, 0xa9, 0x08, 0x0f, 0xe9, 0x49, 0x24, 0x49, 0xfe, 0x86, 0x24, 0x92, 0xcd, 0x67, 0xa0,
-	0xca, 0xc7, 0xf3, 0xf2, 0xf5, 0x49, 0xfd, 0x7e, 0xf4, 0x8f, 0x14, 0x54, 0xd8, 0x37, 0x6f, 0xe7,
-	0xd5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x3b, 0xcc, 0xcc, 0xae, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x26, 0xff, 0x7c, 0x4c, 0xd2, 0xea, 0xcf, 0xa4, 0x51, 0x4d, 0x10, 0x6e, 0x28,
+	0x12, 0xca, 0xa6, 0x36, 0x0d, 0x48, 0xa8, 0xdb, 0x20, 0x04, 0xd9, 0xa0, 0x2a, 0xdd, 0x81, 0xaa,
+	0xc8, 0x9e, 0xb9, 0xb8, 0xa3, 0xc4, 0x33, 0xc6, 0x33, 0x09, 0xf0, 0x16, 0x3c, 0x56, 0x97, 0x65,
+	0xc7, 0xaa, 0x42, 0xc9, 0x5b, 0xb0, 0x42, 0x1e, 0x7f, 0x24, 0x90, 0x80, 0xe4, 0x5d, 0xe6, 0xde,
+	0x73, 0x7e, 0x27, 0x33, 0xd7, 0xba, 0xe8, 0x11, 0xf3, 0x88, 0x43, 0x44, 0x04, 0x0e, 0xb9, 0x76,
+	0x39, 0x87, 0x99, 0xb3, 0x38, 0x73, 0x7c, 0xe0, 0x20, 0x99, 0xb4, 0xc3, 0x48, 0x28, 0x81, 0xdb,
+	0xcc, 0x23, 0x76, 0x2c, 0xb1, 0x53, 0x89, 0xbd, 0x38, 0xeb, 0x1e, 0xfa, 0xc2, 0x17, 0xba, 0xef,
+	0xc4, 0xbf, 0x12, 0x69, 0x77, 0x27, 0x2d, 0x73, 0x69, 0xc9, 0xc9, 0xb7, 0x1a, 0x6a, 0xbd, 0x4e,
+	0xf8, 0x97, 0xca, 0x55, 0x80, 0xaf, 0x50, 0x3d, 0x55, 0x48, 0xd3, 0xe8, 0x95, 0xfb, 0xcd, 0xc1,
+	0x13, 0x7b, 0x47, 0xa2, 0x3d, 0xa2, 0xc0, 0x15, 0xfb, 0xc0, 0x80, 0xbe, 0x4c, 0x8a, 0xc3, 0xfb,
+	0x37, 0x77, 0xc7, 0xa5, 0x9f, 0x77, 0xc7, 0xf7, 0xb6, 0x5a, 0xe3, 0x1c, 0x89, 0xc7, 0xe8, 0x7f,
+	0x97, 0x4c, 0xb9, 0xf8, 0x34, 0x03, 0xea, 0x43, 0x00, 0x5c, 0x49, 0x73, 0x4f, 0xc7, 0xf4, 0x76,
+	0xc6, 0x5c, 0xb8, 0x64, 0x0a, 0x4a, 0xff, 0xb5, 0x61, 0x25, 0x0e, 0x18, 0x6f, 0xf9, 0xf1, 0x1b,
+	0xd4, 0x24, 0x22, 0x08, 0x98, 0x4a, 0x70, 0xe5, 0x42, 0xb8, 0x4d, 0x2b, 0x1e, 0xa2, 0x7a, 0x04,
+	0x04, 0x58, 0xa8, 0xa4, 0x59, 0x29, 0x84, 0xc9, 0x7d, 0xf8, 0x02, 0x1d, 0x48, 0xe0, 0x74, 0x22,
+	0xe1, 0xe3, 0x1c, 0x38, 0x01, 0x69, 0xfe, 0xa7, 0x49, 0x8f, 0xff, 0x45, 0x4a, 0xb5, 0x29, 0x6c,
+	0x3f, 0x06, 0x64, 0x35, 0x4d, 0x8c, 0x80, 0x2c, 0x36, 0x88, 0xd5, 0xc2, 0xc4, 0x18, 0xb0, 0x26,
+	0xbe, 0x45, 0xfb, 0x2e, 0x99, 0x6e, 0x00, 0x6b, 0x45, 0x81, 0x2d, 0x97, 0x4c, 0xd7, 0xbc, 0x01,
+	0xea, 0x70, 0xf8, 0xac, 0x26, 0xa9, 0x2b, 0x07, 0x9b, 0xf5, 0x9e, 0xd1, 0xaf, 0x8c, 0xdb, 0x71,
+	0x33, 0xfd, 0x16, 0x32, 0x13, 0x3e, 0x47, 0xd5, 0xd0, 0x8d, 0xdc, 0x40, 0x9a, 0x8d, 0x9e, 0xd1,
+	0x6f, 0x0e, 0x1e, 0xfc, 0x25, 0x3c, 0x96, 0xa4, 0xa1, 0xa9, 0x01, 0xbf, 0x47, 0x87, 0xc9, 0x83,
+	0x28, 0x37, 0x52, 0x1b, 0xb7, 0x40, 0x45, 0x6f, 0x81, 0xf5, 0xb3, 0xc4, 0x94, 0xf5, 0x5d, 0x5c,
+	0x74, 0x14, 0x46, 0x73, 0xce, 0xb8, 0x9f, 0x93, 0x93, 0x20, 0x69, 0x36, 0x8b, 0xf2, 0x3b, 0x29,
+	0x29, 0x2b, 0xeb, 0x28, 0x89, 0xaf, 0x50, 0x67, 0x2b, 0x02, 0x38, 0x95, 0x66, 0xab, 0x68, 0x40,
+	0xfb, 0x8f, 0x80, 0x57, 0x9c, 0xca, 0x13, 0x8a, 0x0e, 0x7e, 0x17, 0xe3, 0x23, 0x54, 0x0b, 0x45,
+	0xa4, 0x26, 0x8c, 0x9a, 0x46, 0xcf, 0xe8, 0x37, 0xc6, 0xd5, 0xf8, 0x38, 0xa2, 0xf8, 0x21, 0x42,
+	0xd9, 0xcc, 0x18, 0x35, 0xf7, 0x74, 0xaf, 0x91, 0x56, 0x46, 0x14, 0x77, 0x51, 0x3d, 0x1f, 0x65,
+	0x59, 0x8f, 0x32, 0x3f, 0x0f, 0x2f, 0x6f, 0x96, 0x96, 0x71, 0xbb, 0xb4, 0x8c, 0x1f, 0x4b, 0xcb,
+	0xf8, 0xba, 0xb2, 0x4a, 0xb7, 0x2b, 0xab, 0xf4, 0x7d, 0x65, 0x95, 0xde, 0x9d, 0xfb, 0x4c, 0x5d,
+	0xcf, 0x3d, 0x9b, 0x88, 0xc0, 0x21, 0x42, 0x06, 0x42, 0x3a, 0xcc, 0x23, 0xa7, 0xbe, 0x70, 0x16,
+	0x2f, 0x9c, 0x40, 0xd0, 0xf9, 0x0c, 0x64, 0xb2, 0x96, 0x9e, 0x3e, 0x3f, 0xcd, 0x36, 0x93, 0xfa,
+	0x12, 0x82, 0xf4, 0xaa, 0x7a, 0x2b, 0x3d, 0xfb, 0x35, 0x00, 0x25, 0x86, 0x42, 0xb8, 0x08, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +287,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PruningSequenceEnds) > 0 {
+		for iNdEx := len(m.PruningSequenceEnds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceEnds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for iNdEx := len(m.RecvStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -456,6 +549,26 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecvStartSequences) > 0 {
+		for _, e := range m.RecvStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceEnds) > 0 {
+		for _, e := range m.PruningSequenceEnds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -771,6 +884,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvStartSequences = append(m.RecvStartSequences, PacketSequence{})
+			if err := m.RecvStartSequences[len(m.RecvStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceEnds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceEnds = append(m.PruningSequenceEnds, PacketSequence{})
+			if err := m.PruningSequenceEnds[len(m.PruningSequenceEnds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -67,6 +67,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				2,
+				types.DefaultParams(),
 			),
 			expPass: true,
 		},
@@ -137,6 +138,33 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid recv start seq",
+			genState: types.GenesisState{
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pruning seq start",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pruning seq end",
+			genState: types.GenesisState{
+				PruningSequenceEnds: []types.PacketSequence{
+					types.NewPacketSequence("(testPort1)", testChannel1, 1),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -171,6 +199,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -208,6 +237,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -28,6 +28,13 @@ const (
 
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
+
+	// ParamsKey is the store key for the IBC channel parameters
+	ParamsKey = "channelParams"
+
+	// KeyAcknowledgementPruneCursor is the store key for the path of the last channel visited when pruning
+	// acknowledgements and receipts at the end of a block
+	KeyAcknowledgementPruneCursor = "acknowledgementPruneCursor"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -0,0 +1,7 @@
+package types
+
+// Prometheus metric labels.
+const (
+	LabelPortID    = "port_id"
+	LabelChannelID = "channel_id"
+)
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v7/internal/collections"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
@@ -23,6 +24,18 @@ var (
 	_ sdk.Msg = (*MsgRecvPacket)(nil)
 	_ sdk.Msg = (*MsgAcknowledgement)(nil)
 	_ sdk.Msg = (*MsgTimeout)(nil)
+	_ sdk.Msg = (*MsgTimeoutOnClose)(nil)
+	_ sdk.Msg = (*MsgRecvPackets)(nil)
+	_ sdk.Msg = (*MsgAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeInit)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeTry)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeAck)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeConfirm)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeOpen)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
+	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -253,14 +266,15 @@ func (msg MsgChannelCloseInit) GetSigners() []sdk.AccAddress {
 // NewMsgChannelCloseConfirm creates a new MsgChannelCloseConfirm instance
 func NewMsgChannelCloseConfirm(
 	portID, channelID string, proofInit []byte, proofHeight clienttypes.Height,
-	signer string,
+	signer string, counterpartyUpgradeSequence uint64,
 ) *MsgChannelCloseConfirm {
 	return &MsgChannelCloseConfirm{
-		PortId:      portID,
-		ChannelId:   channelID,
-		ProofInit:   proofInit,
-		ProofHeight: proofHeight,
-		Signer:      signer,
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		ProofInit:                   proofInit,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
 	}
 }
 
@@ -375,14 +389,16 @@ func NewMsgTimeoutOnClose(
 	packet Packet, nextSequenceRecv uint64,
 	proofUnreceived, proofClose []byte,
 	proofHeight clienttypes.Height, signer string,
+	counterpartyUpgradeSequence uint64,
 ) *MsgTimeoutOnClose {
 	return &MsgTimeoutOnClose{
-		Packet:           packet,
-		NextSequenceRecv: nextSequenceRecv,
-		ProofUnreceived:  proofUnreceived,
-		ProofClose:       proofClose,
-		ProofHeight:      proofHeight,
-		Signer:           signer,
+		Packet:                      packet,
+		NextSequenceRecv:            nextSequenceRecv,
+		ProofUnreceived:             proofUnreceived,
+		ProofClose:                  proofClose,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
 	}
 }
 
@@ -452,3 +468,527 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// NewMsgRecvPackets constructs a new MsgRecvPackets
+func NewMsgRecvPackets(
+	packets []Packet, proofCommitment []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:         packets,
+		ProofCommitment: proofCommitment,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.ProofCommitment) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitment proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, func(packet Packet) (string, string) {
+		return packet.GetDestPort(), packet.GetDestChannel()
+	})
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPackets) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks [][]byte, proofAcked []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofAcked:       proofAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.ProofAcked) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty acknowledgement proof")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements must match the number of packets (%d ≠ %d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	for i, ack := range msg.Acknowledgements {
+		if len(ack) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgement, "ack bytes cannot be empty at index %d", i)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, func(packet Packet) (string, string) {
+		return packet.GetSourcePort(), packet.GetSourceChannel()
+	})
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validatePacketBatch validates each packet of a batch and ensures that the packets are processed on
+// the same channel, as returned by the provided function, and have distinct sequences.
+func validatePacketBatch(packets []Packet, getChannel func(packet Packet) (string, string)) error {
+	if len(packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := getChannel(packets[0])
+	sequences := make(map[uint64]struct{}, len(packets))
+	for i, packet := range packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+
+		if packetPortID, packetChannelID := getChannel(packet); packetPortID != portID || packetChannelID != channelID {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packets must be processed on the same channel (%s/%s ≠ %s/%s)", packetPortID, packetChannelID, portID, channelID)
+		}
+
+		if _, found := sequences[packet.GetSequence()]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+		sequences[packet.GetSequence()] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
+func NewMsgChannelUpgradeInit(
+	portID, channelID string,
+	upgradeFields UpgradeFields,
+	signer string,
+) *MsgChannelUpgradeInit {
+	return &MsgChannelUpgradeInit{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fields:    upgradeFields,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeInit) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Fields.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeInit) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeTry constructs a new MsgChannelUpgradeTry
+func NewMsgChannelUpgradeTry(
+	portID, channelID string,
+	proposedConnectionHops []string,
+	counterpartyUpgradeFields UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	proofChannel, proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTry {
+	return &MsgChannelUpgradeTry{
+		PortId:                        portID,
+		ChannelId:                     channelID,
+		ProposedUpgradeConnectionHops: proposedConnectionHops,
+		CounterpartyUpgradeFields:     counterpartyUpgradeFields,
+		CounterpartyUpgradeSequence:   counterpartyUpgradeSequence,
+		ProofChannel:                  proofChannel,
+		ProofUpgrade:                  proofUpgrade,
+		ProofHeight:                   proofHeight,
+		Signer:                        signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProposedUpgradeConnectionHops) == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgrade, "proposed connection hops cannot be empty")
+	}
+	if err := msg.CounterpartyUpgradeFields.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "error validating counterparty upgrade fields")
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "counterparty sequence cannot be 0")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTry) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeAck constructs a new MsgChannelUpgradeAck
+func NewMsgChannelUpgradeAck(
+	portID, channelID string,
+	counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeAck {
+	return &MsgChannelUpgradeAck{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyUpgrade: counterpartyUpgrade,
+		ProofChannel:        proofChannel,
+		ProofUpgrade:        proofUpgrade,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofChannel) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.CounterpartyUpgrade.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeAck) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeConfirm constructs a new MsgChannelUpgradeConfirm
+func NewMsgChannelUpgradeConfirm(
+	portID, channelID string,
+	counterpartyChannelState State,
+	counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeConfirm {
+	return &MsgChannelUpgradeConfirm{
+		PortId:                   portID,
+		ChannelId:                channelID,
+		CounterpartyChannelState: counterpartyChannelState,
+		CounterpartyUpgrade:      counterpartyUpgrade,
+		ProofChannel:             proofChannel,
+		ProofUpgrade:             proofUpgrade,
+		ProofHeight:              proofHeight,
+		Signer:                   signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if !collections.Contains(msg.CounterpartyChannelState, []State{FLUSHING, FLUSHCOMPLETE}) {
+		return errorsmod.Wrapf(ErrInvalidChannelState, "expected channel state to be one of: %s or %s, got: %s", FLUSHING, FLUSHCOMPLETE, msg.CounterpartyChannelState)
+	}
+	if len(msg.ProofChannel) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.CounterpartyUpgrade.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeOpen constructs a new MsgChannelUpgradeOpen
+func NewMsgChannelUpgradeOpen(
+	portID, channelID string,
+	counterpartyChannelState State,
+	counterpartyUpgradeSequence uint64,
+	proofChannel []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeOpen {
+	return &MsgChannelUpgradeOpen{
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		CounterpartyChannelState:    counterpartyChannelState,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofChannel:                proofChannel,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofChannel) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if !collections.Contains(msg.CounterpartyChannelState, []State{FLUSHCOMPLETE, OPEN}) {
+		return errorsmod.Wrapf(ErrInvalidChannelState, "expected channel state to be one of: [%s, %s], got: %s", FLUSHCOMPLETE, OPEN, msg.CounterpartyChannelState)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeTimeout constructs a new MsgChannelUpgradeTimeout
+func NewMsgChannelUpgradeTimeout(
+	portID, channelID string,
+	counterpartyChannel Channel,
+	proofChannel []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTimeout {
+	return &MsgChannelUpgradeTimeout{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofChannel) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if !collections.Contains(msg.CounterpartyChannel.State, []State{FLUSHING, OPEN}) {
+		return errorsmod.Wrapf(ErrInvalidChannelState, "expected counterparty channel state to be one of: [%s, %s], got: %s", FLUSHING, OPEN, msg.CounterpartyChannel.State)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgChannelUpgradeCancel constructs a new MsgChannelUpgradeCancel
+func NewMsgChannelUpgradeCancel(
+	portID, channelID string,
+	errorReceipt ErrorReceipt,
+	proofErrorReceipt []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeCancel {
+	return &MsgChannelUpgradeCancel{
+		PortId:            portID,
+		ChannelId:         channelID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: proofErrorReceipt,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. The error receipt proof may be omitted when
+// the signer is the authority, which is checked by the msg server.
+func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgPruneAcknowledgements constructs a new MsgPruneAcknowledgements
+func NewMsgPruneAcknowledgements(portID, channelID string, limit uint64, signer string) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		PortId:    portID,
+		ChannelId: channelID,
+		Limit:     limit,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.Limit == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "number of acknowledgements to prune must be greater than 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUpdateChannelParams creates a new instance of MsgUpdateParams.
+func NewMsgUpdateChannelParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateParams.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.Params.Validate()
+}
@@ -18,6 +18,8 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/mock"
 	"github.com/cosmos/ibc-go/v7/testing/simapp"
 )
 
@@ -64,6 +66,7 @@ var (
 
 	connHops             = []string{"testconnection"}
 	invalidConnHops      = []string{"testconnection", "testconnection"}
+	multihopConnHops     = []string{"testconnection", "testconnection2"}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -120,8 +123,9 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too short port id", types.NewMsgChannelOpenInit(invalidShortPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
-		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
+		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(4), connHops, cpportid, addr), false},
+		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, multihopConnHops, cpportid, addr), true},
+		{"empty connection hops", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, []string{}, cpportid, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, addr), false},
@@ -159,7 +163,8 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		{"port id contains non-alpha", types.NewMsgChannelOpenTry(invalidPort, version, types.ORDERED, connHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"", types.NewMsgChannelOpenTry(portid, version, types.ORDERED, connHops, cpportid, cpchanid, "", suite.proof, height, addr), true},
 		{"invalid channel order", types.NewMsgChannelOpenTry(portid, version, types.Order(4), connHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
+		{"connection hops more than 1 ", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, multihopConnHops, cpportid, cpchanid, version, suite.proof, height, addr), true},
+		{"empty connection hops", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, []string{}, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, cpchanid, version, suite.proof, height, addr), false},
@@ -286,14 +291,14 @@ func (suite *TypesTestSuite) TestMsgChannelCloseConfirmValidateBasic() {
 		msg     *types.MsgChannelCloseConfirm
 		expPass bool
 	}{
-		{"", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, height, addr), true},
-		{"too short port id", types.NewMsgChannelCloseConfirm(invalidShortPort, chanid, suite.proof, height, addr), false},
-		{"too long port id", types.NewMsgChannelCloseConfirm(invalidLongPort, chanid, suite.proof, height, addr), false},
-		{"port id contains non-alpha", types.NewMsgChannelCloseConfirm(invalidPort, chanid, suite.proof, height, addr), false},
-		{"too short channel id", types.NewMsgChannelCloseConfirm(portid, invalidShortChannel, suite.proof, height, addr), false},
-		{"too long channel id", types.NewMsgChannelCloseConfirm(portid, invalidLongChannel, suite.proof, height, addr), false},
-		{"channel id contains non-alpha", types.NewMsgChannelCloseConfirm(portid, invalidChannel, suite.proof, height, addr), false},
-		{"empty proof", types.NewMsgChannelCloseConfirm(portid, chanid, emptyProof, height, addr), false},
+		{"", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, height, addr, 0), true},
+		{"too short port id", types.NewMsgChannelCloseConfirm(invalidShortPort, chanid, suite.proof, height, addr, 0), false},
+		{"too long port id", types.NewMsgChannelCloseConfirm(invalidLongPort, chanid, suite.proof, height, addr, 0), false},
+		{"port id contains non-alpha", types.NewMsgChannelCloseConfirm(invalidPort, chanid, suite.proof, height, addr, 0), false},
+		{"too short channel id", types.NewMsgChannelCloseConfirm(portid, invalidShortChannel, suite.proof, height, addr, 0), false},
+		{"too long channel id", types.NewMsgChannelCloseConfirm(portid, invalidLongChannel, suite.proof, height, addr, 0), false},
+		{"channel id contains non-alpha", types.NewMsgChannelCloseConfirm(portid, invalidChannel, suite.proof, height, addr, 0), false},
+		{"empty proof", types.NewMsgChannelCloseConfirm(portid, chanid, emptyProof, height, addr, 0), false},
 	}
 
 	for _, tc := range testCases {
@@ -346,6 +351,39 @@ func (suite *TypesTestSuite) TestMsgRecvPacketGetSigners() {
 	suite.Equal(expected, fmt.Sprintf("%v", res))
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, "channel-100", timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRecvPackets
+		expPass bool
+	}{
+		{"success", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, addr), true},
+		{"missing signer address", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, emptyAddr), false},
+		{"proof contain empty proof", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, emptyProof, height, addr), false},
+		{"empty packets", types.NewMsgRecvPackets(nil, suite.proof, height, addr), false},
+		{"invalid packet", types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr), false},
+		{"duplicate packet sequence", types.NewMsgRecvPackets([]types.Packet{packet, packet}, suite.proof, height, addr), false},
+		{"packets received on different channels", types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, suite.proof, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 	testCases := []struct {
 		name    string
@@ -380,12 +418,12 @@ func (suite *TypesTestSuite) TestMsgTimeoutOnCloseValidateBasic() {
 		msg     sdk.Msg
 		expPass bool
 	}{
-		{"success", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, addr), true},
-		{"seq 0", types.NewMsgTimeoutOnClose(packet, 0, suite.proof, suite.proof, height, addr), false},
-		{"signer address is empty", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, emptyAddr), false},
-		{"empty proof", types.NewMsgTimeoutOnClose(packet, 1, emptyProof, suite.proof, height, addr), false},
-		{"empty proof close", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, emptyProof, height, addr), false},
-		{"invalid packet", types.NewMsgTimeoutOnClose(invalidPacket, 1, suite.proof, suite.proof, height, addr), false},
+		{"success", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, addr, 0), true},
+		{"seq 0", types.NewMsgTimeoutOnClose(packet, 0, suite.proof, suite.proof, height, addr, 0), false},
+		{"signer address is empty", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, emptyAddr, 0), false},
+		{"empty proof", types.NewMsgTimeoutOnClose(packet, 1, emptyProof, suite.proof, height, addr, 0), false},
+		{"empty proof close", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, emptyProof, height, addr, 0), false},
+		{"invalid packet", types.NewMsgTimeoutOnClose(invalidPacket, 1, suite.proof, suite.proof, height, addr, 0), false},
 	}
 
 	for _, tc := range testCases {
@@ -430,3 +468,688 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, "channel-100", cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	acks := [][]byte{packet.GetData(), packet2.GetData()}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, addr), true},
+		{"empty ack", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, [][]byte{packet.GetData(), nil}, suite.proof, height, addr), false},
+		{"acknowledgements length mismatch", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks[:1], suite.proof, height, addr), false},
+		{"missing signer address", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, emptyAddr), false},
+		{"cannot submit an empty proof", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, emptyProof, height, addr), false},
+		{"empty packets", types.NewMsgAcknowledgements(nil, nil, suite.proof, height, addr), false},
+		{"invalid packet", types.NewMsgAcknowledgements([]types.Packet{packet, invalidPacket}, acks, suite.proof, height, addr), false},
+		{"duplicate packet sequence", types.NewMsgAcknowledgements([]types.Packet{packet, packet}, acks, suite.proof, height, addr), false},
+		{"packets sent on different channels", types.NewMsgAcknowledgements([]types.Packet{packet, otherChannelPacket}, acks, suite.proof, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
+	var msg *types.MsgChannelUpgradeInit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"empty proposed upgrade channel version",
+			func() {
+				msg.Fields.Version = "  "
+			},
+			false,
+		},
+		{
+			"invalid connection hops",
+			func() {
+				msg.Fields.ConnectionHops = invalidConnHops
+			},
+			false,
+		},
+		{
+			"invalid ordering",
+			func() {
+				msg.Fields.Ordering = types.NONE
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeInit(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+				addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	var msg *types.MsgChannelUpgradeTry
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"counterparty sequence cannot be zero",
+			func() {
+				msg.CounterpartyUpgradeSequence = 0
+			},
+			false,
+		},
+		{
+			"invalid counterparty upgrade fields ordering",
+			func() {
+				msg.CounterpartyUpgradeFields.Ordering = types.NONE
+			},
+			false,
+		},
+		{
+			"cannot submit an empty channel proof",
+			func() {
+				msg.ProofChannel = emptyProof
+			},
+			false,
+		},
+		{
+			"cannot submit an empty upgrade proof",
+			func() {
+				msg.ProofUpgrade = emptyProof
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeTry(
+				ibctesting.MockPort,
+				ibctesting.FirstChannelID,
+				[]string{ibctesting.FirstConnectionID},
+				types.NewUpgradeFields(types.ORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+				1,
+				suite.proof,
+				suite.proof,
+				height,
+				addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeAckValidateBasic() {
+	var msg *types.MsgChannelUpgradeAck
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"cannot submit an empty channel proof",
+			func() {
+				msg.ProofChannel = emptyProof
+			},
+			false,
+		},
+		{
+			"cannot submit an empty upgrade proof",
+			func() {
+				msg.ProofUpgrade = emptyProof
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+		{
+			"invalid counterparty upgrade timeout",
+			func() {
+				msg.CounterpartyUpgrade.Timeout = types.NewTimeout(clienttypes.ZeroHeight(), 0)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			upgrade := types.NewUpgrade(
+				types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+				types.NewTimeout(clienttypes.NewHeight(1, 100), 0),
+				0,
+			)
+
+			msg = types.NewMsgChannelUpgradeAck(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				*upgrade, suite.proof, suite.proof,
+				height, addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeConfirmValidateBasic() {
+	var msg *types.MsgChannelUpgradeConfirm
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: counterparty state set to FLUSHCOMPLETE",
+			func() {
+				msg.CounterpartyChannelState = types.FLUSHCOMPLETE
+			},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"invalid counterparty channel state",
+			func() {
+				msg.CounterpartyChannelState = types.CLOSED
+			},
+			false,
+		},
+		{
+			"cannot submit an empty channel proof",
+			func() {
+				msg.ProofChannel = emptyProof
+			},
+			false,
+		},
+		{
+			"cannot submit an empty upgrade proof",
+			func() {
+				msg.ProofUpgrade = emptyProof
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			counterpartyUpgrade := types.NewUpgrade(
+				types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+				types.NewTimeout(clienttypes.NewHeight(0, 10000), timeoutTimestamp),
+				0,
+			)
+
+			msg = types.NewMsgChannelUpgradeConfirm(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				types.FLUSHING, *counterpartyUpgrade, suite.proof, suite.proof,
+				height, addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeOpenValidateBasic() {
+	var msg *types.MsgChannelUpgradeOpen
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: counterparty state set to OPEN",
+			func() {
+				msg.CounterpartyChannelState = types.OPEN
+			},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"invalid counterparty channel state",
+			func() {
+				msg.CounterpartyChannelState = types.FLUSHING
+			},
+			false,
+		},
+		{
+			"cannot submit an empty channel proof",
+			func() {
+				msg.ProofChannel = emptyProof
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeOpen(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				types.FLUSHCOMPLETE, 1, suite.proof,
+				height, addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTimeoutValidateBasic() {
+	var msg *types.MsgChannelUpgradeTimeout
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"cannot submit an empty channel proof",
+			func() {
+				msg.ProofChannel = emptyProof
+			},
+			false,
+		},
+		{
+			"invalid counterparty channel state",
+			func() {
+				msg.CounterpartyChannel.State = types.CLOSED
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeTimeout(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				types.Channel{State: types.OPEN},
+				suite.proof,
+				height, addr,
+			)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	var msg *types.MsgChannelUpgradeCancel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty proof",
+			func() {
+				msg.ProofErrorReceipt = emptyProof
+			},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeCancel(ibctesting.MockPort, ibctesting.FirstChannelID, types.ErrorReceipt{Sequence: 1}, suite.proof, height, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	var msg *types.MsgPruneAcknowledgements
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			false,
+		},
+		{
+			"zero limit",
+			func() {
+				msg.Limit = 0
+			},
+			false,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPruneAcknowledgements(ibctesting.MockPort, ibctesting.FirstChannelID, 10, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateChannelParams(addr, types.DefaultParams()), true},
+		{"invalid authority address", types.NewMsgUpdateChannelParams("invalid", types.DefaultParams()), false},
+		{"invalid params: non zero height", types.NewMsgUpdateChannelParams(addr, types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0), 0, 0, nil)), false},
+		{"invalid params: zero timestamp", types.NewMsgUpdateChannelParams(addr, types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0), 0, 0, nil)), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving chain of an ORDERED_ALLOW_TIMEOUT
+// channel when a timed-out packet is received. The sending chain proves its existence in order to
+// time out the packet without closing the channel.
+var TimeoutReceipt = []byte{byte(2)}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// DefaultTimeout defines a default parameter for the channel upgrade protocol.
+// It allows relayers a window in which they can flush all in-flight packets on a channel before completing the upgrade handshake.
+// This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
+var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
+
+// DefaultPruneGasLimit defines the default gas limit of acknowledgement and receipt pruning in the end blocker.
+// Pruning in the end blocker is disabled by default, acknowledgements and receipts may be pruned with MsgPruneAcknowledgements.
+const DefaultPruneGasLimit = uint64(0)
+
+// DefaultMaxPacketDataBytes defines the default maximum size in bytes of packet data.
+// The packet data size is not limited by default.
+const DefaultMaxPacketDataBytes = uint64(0)
+
+// NewParams creates a new parameter configuration for the channel submodule
+func NewParams(
+	upgradeTimeout Timeout, pruneGasLimit, maxPacketDataBytes uint64, portMaxPacketDataBytes []PortMaxPacketDataBytes,
+) Params {
+	return Params{
+		UpgradeTimeout:         upgradeTimeout,
+		PruneGasLimit:          pruneGasLimit,
+		MaxPacketDataBytes:     maxPacketDataBytes,
+		PortMaxPacketDataBytes: portMaxPacketDataBytes,
+	}
+}
+
+// NewPortMaxPacketDataBytes creates a new PortMaxPacketDataBytes instance.
+func NewPortMaxPacketDataBytes(portID string, maxPacketDataBytes uint64) PortMaxPacketDataBytes {
+	return PortMaxPacketDataBytes{
+		PortId:             portID,
+		MaxPacketDataBytes: maxPacketDataBytes,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the channel submodule
+func DefaultParams() Params {
+	return NewParams(DefaultTimeout, DefaultPruneGasLimit, DefaultMaxPacketDataBytes, nil)
+}
+
+// MaxPacketDataBytesForPort returns the maximum size in bytes of the data of packets sent on channels of the
+// provided port. The limit of the port takes precedence over the global limit. Zero is returned if the
+// packet data size is not limited.
+func (p Params) MaxPacketDataBytesForPort(portID string) uint64 {
+	for _, portLimit := range p.PortMaxPacketDataBytes {
+		if portLimit.PortId == portID {
+			return portLimit.MaxPacketDataBytes
+		}
+	}
+
+	return p.MaxPacketDataBytes
+}
+
+// Validate the params.
+func (p Params) Validate() error {
+	if !p.UpgradeTimeout.Height.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout height must be zero. got : %v", p.UpgradeTimeout.Height)
+	}
+	if p.UpgradeTimeout.Timestamp == 0 {
+		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
+	}
+
+	seenPorts := make(map[string]bool)
+	for _, portLimit := range p.PortMaxPacketDataBytes {
+		if err := host.PortIdentifierValidator(portLimit.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid port max packet data bytes")
+		}
+
+		if seenPorts[portLimit.PortId] {
+			return errorsmod.Wrapf(ErrInvalidChannelParams, "duplicate max packet data bytes for port %s", portLimit.PortId)
+		}
+
+		seenPorts[portLimit.PortId] = true
+	}
+
+	return nil
+}
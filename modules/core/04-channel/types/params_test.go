@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"valid params", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 1), 0, 0, nil), true},
+		{"valid params with max packet data bytes", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 1), 0, 1024, []types.PortMaxPacketDataBytes{types.NewPortMaxPacketDataBytes(ibctesting.TransferPort, 512), types.NewPortMaxPacketDataBytes(ibctesting.MockPort, 0)}), true},
+		{"invalid params: non zero height", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1), 1), 0, 0, nil), false},
+		{"invalid params: zero timestamp", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0), 0, 0, nil), false},
+		{"invalid params: invalid port identifier", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 1), 0, 0, []types.PortMaxPacketDataBytes{types.NewPortMaxPacketDataBytes("invalid/port", 512)}), false},
+		{"invalid params: duplicate port", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 1), 0, 0, []types.PortMaxPacketDataBytes{types.NewPortMaxPacketDataBytes(ibctesting.TransferPort, 512), types.NewPortMaxPacketDataBytes(ibctesting.TransferPort, 1024)}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMaxPacketDataBytesForPort(t *testing.T) {
+	params := types.NewParams(types.DefaultTimeout, 0, 1024, []types.PortMaxPacketDataBytes{
+		types.NewPortMaxPacketDataBytes(ibctesting.TransferPort, 512),
+		types.NewPortMaxPacketDataBytes(ibctesting.MockPort, 0),
+	})
+
+	require.Equal(t, uint64(512), params.MaxPacketDataBytesForPort(ibctesting.TransferPort))
+	require.Equal(t, uint64(0), params.MaxPacketDataBytesForPort(ibctesting.MockPort))
+	require.Equal(t, uint64(1024), params.MaxPacketDataBytesForPort(ibctesting.MockFeePort))
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+func TestTimeoutIsValid(t *testing.T) {
+	require.True(t, types.NewTimeout(clienttypes.NewHeight(0, 1), 0).IsValid())
+	require.True(t, types.NewTimeout(clienttypes.ZeroHeight(), 1).IsValid())
+	require.True(t, types.NewTimeout(clienttypes.NewHeight(0, 1), 1).IsValid())
+	require.False(t, types.NewTimeout(clienttypes.ZeroHeight(), 0).IsValid())
+}
+
+func TestTimeoutElapsed(t *testing.T) {
+	testCases := []struct {
+		name       string
+		timeout    types.Timeout
+		height     clienttypes.Height
+		timestamp  uint64
+		expElapsed bool
+	}{
+		{"height elapsed", types.NewTimeout(clienttypes.NewHeight(0, 10), 0), clienttypes.NewHeight(0, 10), 0, true},
+		{"height not elapsed", types.NewTimeout(clienttypes.NewHeight(0, 10), 0), clienttypes.NewHeight(0, 9), 100, false},
+		{"timestamp elapsed", types.NewTimeout(clienttypes.ZeroHeight(), 100), clienttypes.NewHeight(0, 1), 100, true},
+		{"timestamp not elapsed", types.NewTimeout(clienttypes.ZeroHeight(), 100), clienttypes.NewHeight(0, 1), 99, false},
+		{"height not elapsed, timestamp elapsed", types.NewTimeout(clienttypes.NewHeight(0, 10), 100), clienttypes.NewHeight(0, 1), 200, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expElapsed, tc.timeout.Elapsed(tc.height, tc.timestamp), tc.name)
+	}
+}
+
+func TestTimeoutErrors(t *testing.T) {
+	timeout := types.NewTimeout(clienttypes.NewHeight(0, 10), 100)
+
+	err := timeout.ErrTimeoutElapsed(clienttypes.NewHeight(0, 10), 0)
+	require.ErrorIs(t, err, types.ErrTimeoutElapsed)
+	require.Contains(t, err.Error(), "timeout height")
+
+	err = timeout.ErrTimeoutElapsed(clienttypes.NewHeight(0, 1), 100)
+	require.ErrorIs(t, err, types.ErrTimeoutElapsed)
+	require.Contains(t, err.Error(), "timeout timestamp")
+
+	err = timeout.ErrTimeoutNotReached(clienttypes.NewHeight(0, 1), 0)
+	require.ErrorIs(t, err, types.ErrTimeoutNotReached)
+	require.Contains(t, err.Error(), "timeout height")
+
+	err = timeout.ErrTimeoutNotReached(clienttypes.NewHeight(0, 10), 0)
+	require.ErrorIs(t, err, types.ErrTimeoutNotReached)
+	require.Contains(t, err.Error(), "timeout timestamp")
+}
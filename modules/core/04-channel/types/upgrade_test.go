@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+func TestUpgradeValidateBasic(t *testing.T) {
+	validFields := types.NewUpgradeFields(types.ORDERED, connHops, version)
+
+	testCases := []struct {
+		name    string
+		upgrade *types.Upgrade
+		expPass bool
+	}{
+		{"valid upgrade", types.NewUpgrade(validFields, types.NewTimeout(clienttypes.NewHeight(0, 100), 0), 0), true},
+		{"valid upgrade with timestamp timeout", types.NewUpgrade(validFields, types.NewTimeout(clienttypes.ZeroHeight(), 100), 0), true},
+		{"invalid ordering", types.NewUpgrade(types.NewUpgradeFields(types.NONE, connHops, version), types.NewTimeout(clienttypes.NewHeight(0, 100), 0), 0), false},
+		{"more than one connection hop", types.NewUpgrade(types.NewUpgradeFields(types.ORDERED, invalidConnHops, version), types.NewTimeout(clienttypes.NewHeight(0, 100), 0), 0), false},
+		{"invalid connection hop identifier", types.NewUpgrade(types.NewUpgradeFields(types.ORDERED, []string{"(invalid)"}, version), types.NewTimeout(clienttypes.NewHeight(0, 100), 0), 0), false},
+		{"empty version", types.NewUpgrade(types.NewUpgradeFields(types.ORDERED, connHops, "  "), types.NewTimeout(clienttypes.NewHeight(0, 100), 0), 0), false},
+		{"invalid timeout", types.NewUpgrade(validFields, types.NewTimeout(clienttypes.ZeroHeight(), 0), 0), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.upgrade.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestUpgradeError(t *testing.T) {
+	upgradeErr := types.NewUpgradeError(1, types.ErrInvalidChannel)
+	require.True(t, types.IsUpgradeError(upgradeErr))
+	require.True(t, errors.Is(upgradeErr, types.ErrInvalidChannel))
+	require.Equal(t, types.ErrInvalidChannel.Error(), upgradeErr.Error())
+
+	// wrapped upgrade errors are still detected and unwrapped to the base error
+	wrappedErr := errorsmod.Wrap(types.NewUpgradeError(1, errorsmod.Wrap(types.ErrInvalidChannel, "wrapped")), "outer")
+	require.True(t, types.IsUpgradeError(wrappedErr))
+	require.True(t, errors.Is(wrappedErr, types.ErrInvalidChannel))
+
+	require.False(t, types.IsUpgradeError(ibcerrors.ErrInvalidType))
+	require.False(t, types.IsUpgradeError(nil))
+}
+
+func TestGetErrorReceipt(t *testing.T) {
+	upgradeErr := types.NewUpgradeError(10, errorsmod.Wrap(types.ErrInvalidChannel, "non-deterministic message"))
+
+	receipt := upgradeErr.GetErrorReceipt()
+	require.Equal(t, uint64(10), receipt.Sequence)
+	require.Equal(t, fmt.Sprintf("ABCI code: %d: restored channel to pre-upgrade state", types.ErrInvalidChannel.ABCICode()), receipt.Message)
+	require.NotContains(t, receipt.Message, "non-deterministic message")
+}
@@ -106,6 +106,57 @@ type IBCModule interface {
 	) error
 }
 
+// UpgradableModule defines the callbacks required to perform a channel upgrade.
+// Note: applications must ensure that state related to packet processing remains unmodified until the OnChanUpgradeOpen callback is executed.
+// This guarantees that in-flight packets are correctly flushed using the existing channel parameters.
+type UpgradableModule interface {
+	// OnChanUpgradeInit enables additional custom logic to be executed when the channel upgrade is initialized.
+	// It must validate the proposed version, order, and connection hops.
+	// NOTE: in the case of crossing hellos, this callback may be executed on both chains.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID, channelID string,
+		proposedOrder channeltypes.Order,
+		proposedConnectionHops []string,
+		proposedVersion string,
+	) (string, error)
+
+	// OnChanUpgradeTry enables additional custom logic to be executed in the ChannelUpgradeTry step of the
+	// channel upgrade handshake. It must validate the proposed version (provided by the counterparty), order,
+	// and connection hops.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID, channelID string,
+		proposedOrder channeltypes.Order,
+		proposedConnectionHops []string,
+		counterpartyVersion string,
+	) (string, error)
+
+	// OnChanUpgradeAck enables additional custom logic to be executed in the ChannelUpgradeAck step of the
+	// channel upgrade handshake. It must validate the version proposed by the counterparty.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID,
+		counterpartyVersion string,
+	) error
+
+	// OnChanUpgradeOpen enables additional custom logic to be executed when the channel upgrade has successfully completed, and the channel
+	// has returned to the OPEN state. Any logic associated with changing of the channel fields should be performed
+	// in this callback.
+	OnChanUpgradeOpen(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		proposedOrder channeltypes.Order,
+		proposedConnectionHops []string,
+		proposedVersion string,
+	)
+}
+
 // ICS4Wrapper implements the ICS4 interfaces that IBC applications use to send packets and acknowledgements.
 type ICS4Wrapper interface {
 	SendPacket(
@@ -132,6 +183,13 @@ type ICS4Wrapper interface {
 	) (string, bool)
 }
 
+// PacketDataUnmarshaler defines an optional interface which allows a middleware to
+// request the packet data to be unmarshaled by the base application.
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData unmarshals the packet data into a concrete type
+	UnmarshalPacketData([]byte) (interface{}, error)
+}
+
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
 // and ICS4Wrapper to wrap communication from underlying application to core IBC.
 type Middleware interface {
@@ -1,7 +1,15 @@
 package types
 
 import (
+	"fmt"
 	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 func BenchmarkMerkleProofEmpty(b *testing.B) {
@@ -13,3 +21,122 @@ func BenchmarkMerkleProofEmpty(b *testing.B) {
 		}
 	}
 }
+
+// benchmarkStoreSizes are the numbers of keys stored in the benchmarked iavl stores,
+// which determine the depth of the proofs.
+var benchmarkStoreSizes = []int{1, 100, 10000}
+
+// BenchmarkVerifyMembership benchmarks the verification of membership proofs of increasing depth.
+// The size of the proof and the number of hash operations are reported to calibrate the proof
+// verification gas costs.
+func BenchmarkVerifyMembership(b *testing.B) {
+	for _, size := range benchmarkStoreSizes {
+		b.Run(fmt.Sprintf("keys=%d", size), func(b *testing.B) {
+			root, path, proofBz := setupBenchmarkProof(b, size, []byte("key0"))
+
+			var proof MerkleProof
+			if err := proof.Unmarshal(proofBz); err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := proof.VerifyMembership(GetSDKSpecs(), root, path, []byte("value0")); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			reportProofMetrics(b, proofBz)
+		})
+	}
+}
+
+// BenchmarkVerifyNonMembership benchmarks the verification of non-membership proofs of increasing depth.
+// The size of the proof and the number of hash operations are reported to calibrate the proof
+// verification gas costs.
+func BenchmarkVerifyNonMembership(b *testing.B) {
+	for _, size := range benchmarkStoreSizes {
+		b.Run(fmt.Sprintf("keys=%d", size), func(b *testing.B) {
+			root, path, proofBz := setupBenchmarkProof(b, size, []byte("key0/absent"))
+
+			var proof MerkleProof
+			if err := proof.Unmarshal(proofBz); err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := proof.VerifyNonMembership(GetSDKSpecs(), root, path); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			reportProofMetrics(b, proofBz)
+		})
+	}
+}
+
+// BenchmarkProofVerificationGas benchmarks the computation of the proof verification gas, which is
+// performed before every proof verification.
+func BenchmarkProofVerificationGas(b *testing.B) {
+	for _, size := range benchmarkStoreSizes {
+		b.Run(fmt.Sprintf("keys=%d", size), func(b *testing.B) {
+			_, _, proofBz := setupBenchmarkProof(b, size, []byte("key0"))
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if ProofVerificationGas(proofBz, 1, 1) == 0 {
+					b.Fatal("expected non-zero proof verification gas")
+				}
+			}
+		})
+	}
+}
+
+// setupBenchmarkProof commits the provided number of keys to an iavl store and returns the commitment root
+// along with the merkle path and the proof of the provided key.
+func setupBenchmarkProof(b *testing.B, size int, key []byte) (MerkleRoot, MerklePath, []byte) {
+	b.Helper()
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	storeKey := storetypes.NewKVStoreKey("iavlStoreKey")
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err := store.LoadVersion(0); err != nil {
+		b.Fatal(err)
+	}
+
+	kvStore := store.GetCommitKVStore(storeKey)
+	for i := 0; i < size; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	cid := store.Commit()
+
+	res := store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", storeKey.Name()),
+		Data:  key,
+		Prove: true,
+	})
+
+	proof, err := ConvertProofs(res.ProofOps)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	proofBz, err := proof.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return NewMerkleRoot(cid.Hash), NewMerklePath(storeKey.Name(), string(key)), proofBz
+}
+
+// reportProofMetrics reports the size in bytes and the number of hash operations of the proof.
+func reportProofMetrics(b *testing.B, proof []byte) {
+	b.Helper()
+
+	b.ReportMetric(float64(len(proof)), "proof-bytes")
+	b.ReportMetric(float64(ProofHashOps(proof)), "hash-ops")
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"math"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// ProofVerificationGas returns the gas cost of verifying the provided proof. The proof is charged per byte
+// and per hash operation performed while verifying its ICS-23 commitment proofs. Proofs which are not merkle
+// proofs, such as solo machine signatures, are only charged per byte. The gas cost saturates at the
+// maximum uint64 value.
+func ProofVerificationGas(proof []byte, gasPerByte, gasPerHashOp uint64) uint64 {
+	return addUint64Saturating(ProofByteGas(proof, gasPerByte), ProofHashOpGas(proof, gasPerHashOp))
+}
+
+// ProofByteGas returns the gas cost of the provided proof bytes. It does not decode the proof, such that it
+// can be charged before the cost of decoding the proof is incurred.
+func ProofByteGas(proof []byte, gasPerByte uint64) uint64 {
+	return mulUint64Saturating(uint64(len(proof)), gasPerByte)
+}
+
+// ProofHashOpGas returns the gas cost of the hash operations performed while verifying the ICS-23 commitment
+// proofs of the provided merkle proof bytes. The proof is not decoded if the gas per hash operation is zero.
+func ProofHashOpGas(proof []byte, gasPerHashOp uint64) uint64 {
+	if gasPerHashOp == 0 {
+		return 0
+	}
+
+	return mulUint64Saturating(ProofHashOps(proof), gasPerHashOp)
+}
+
+// ProofHashOps returns the number of hash operations performed while verifying the ICS-23 commitment proofs
+// of the provided merkle proof bytes. Zero is returned if the bytes cannot be decoded as a merkle proof.
+func ProofHashOps(proof []byte) uint64 {
+	var merkleProof MerkleProof
+	if err := merkleProof.Unmarshal(proof); err != nil {
+		return 0
+	}
+
+	var hashOps uint64
+	for _, commitmentProof := range merkleProof.Proofs {
+		hashOps = addUint64Saturating(hashOps, commitmentProofHashOps(commitmentProof))
+	}
+
+	return hashOps
+}
+
+// commitmentProofHashOps returns the number of hash operations performed while verifying the provided
+// commitment proof.
+func commitmentProofHashOps(proof *ics23.CommitmentProof) uint64 {
+	var hashOps uint64
+	switch p := proof.GetProof().(type) {
+	case *ics23.CommitmentProof_Exist:
+		hashOps = existenceProofHashOps(p.Exist)
+	case *ics23.CommitmentProof_Nonexist:
+		hashOps = nonExistenceProofHashOps(p.Nonexist)
+	case *ics23.CommitmentProof_Batch:
+		for _, entry := range p.Batch.GetEntries() {
+			hashOps = addUint64Saturating(hashOps, existenceProofHashOps(entry.GetExist()))
+			hashOps = addUint64Saturating(hashOps, nonExistenceProofHashOps(entry.GetNonexist()))
+		}
+	case *ics23.CommitmentProof_Compressed:
+		// the inner ops of compressed proofs are referenced by their index in the lookup table
+		for _, entry := range p.Compressed.GetEntries() {
+			hashOps = addUint64Saturating(hashOps, compressedExistenceProofHashOps(entry.GetExist()))
+			if nonexist := entry.GetNonexist(); nonexist != nil {
+				hashOps = addUint64Saturating(hashOps, compressedExistenceProofHashOps(nonexist.Left))
+				hashOps = addUint64Saturating(hashOps, compressedExistenceProofHashOps(nonexist.Right))
+			}
+		}
+	}
+
+	return hashOps
+}
+
+// existenceProofHashOps returns the number of hash operations of an existence proof,
+// one for the leaf and one for each inner node up to the root.
+func existenceProofHashOps(proof *ics23.ExistenceProof) uint64 {
+	if proof == nil {
+		return 0
+	}
+
+	return uint64(len(proof.Path)) + 1
+}
+
+// nonExistenceProofHashOps returns the number of hash operations of a non-existence proof,
+// which is verified by the existence proofs of its left and right neighbours.
+func nonExistenceProofHashOps(proof *ics23.NonExistenceProof) uint64 {
+	if proof == nil {
+		return 0
+	}
+
+	return addUint64Saturating(existenceProofHashOps(proof.Left), existenceProofHashOps(proof.Right))
+}
+
+// compressedExistenceProofHashOps returns the number of hash operations of a compressed existence proof.
+func compressedExistenceProofHashOps(proof *ics23.CompressedExistenceProof) uint64 {
+	if proof == nil {
+		return 0
+	}
+
+	return uint64(len(proof.Path)) + 1
+}
+
+func mulUint64Saturating(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+
+	return a * b
+}
+
+func addUint64Saturating(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+
+	return a + b
+}
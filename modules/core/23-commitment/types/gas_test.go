@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"fmt"
+	"math"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+)
+
+func (suite *MerkleTestSuite) TestProofVerificationGas() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	suite.iavlStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	suite.store.Commit()
+
+	res := suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	suite.Require().NotNil(res.ProofOps)
+
+	proof, err := types.ConvertProofs(res.ProofOps)
+	suite.Require().NoError(err)
+
+	var expHashOps uint64
+	for _, commitmentProof := range proof.Proofs {
+		exist := commitmentProof.GetExist()
+		suite.Require().NotNil(exist)
+		expHashOps += uint64(len(exist.Path)) + 1
+	}
+
+	var proofBz []byte
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expHashOps uint64
+	}{
+		{
+			"success: existence proofs",
+			func() {},
+			expHashOps,
+		},
+		{
+			"success: batch proof",
+			func() {
+				batchProof := types.MerkleProof{
+					Proofs: []*ics23.CommitmentProof{
+						{
+							Proof: &ics23.CommitmentProof_Batch{
+								Batch: &ics23.BatchProof{
+									Entries: []*ics23.BatchEntry{
+										{Proof: &ics23.BatchEntry_Exist{Exist: proof.Proofs[0].GetExist()}},
+										{Proof: &ics23.BatchEntry_Exist{Exist: proof.Proofs[0].GetExist()}},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				proofBz, err = batchProof.Marshal()
+				suite.Require().NoError(err)
+			},
+			2 * (uint64(len(proof.Proofs[0].GetExist().Path)) + 1),
+		},
+		{
+			"success: non-merkle proof is charged per byte",
+			func() {
+				proofBz = []byte("signature")
+			},
+			0,
+		},
+		{
+			"success: empty proof",
+			func() {
+				proofBz = nil
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			proofBz, err = proof.Marshal()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			suite.Require().Equal(tc.expHashOps, types.ProofHashOps(proofBz))
+			suite.Require().Equal(uint64(len(proofBz))*2+tc.expHashOps*10, types.ProofVerificationGas(proofBz, 2, 10))
+		})
+	}
+
+	// the gas cost saturates instead of overflowing
+	proofBz, err = proof.Marshal()
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(math.MaxUint64), types.ProofVerificationGas(proofBz, math.MaxUint64, math.MaxUint64))
+}
@@ -203,10 +203,53 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
+// BatchVerifyMembership verifies a group of key value pairs against the given root.
+// The path is expected as the prefix of the items, []string{<store key of module>}, and the items are keyed
+// by the key of each value within the lowest subtree. The lowest proof is expected to be an ics23 batch proof
+// (or an existence proof for a single item) of all items, whose subroot is then proven up to the final root.
 func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return errorsmod.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	// BatchVerifyMembership specific argument validation
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+	if len(mpath.KeyPath) != len(specs)-1 {
+		return errorsmod.Wrapf(ErrInvalidProof, "prefix path length %d not one less than proof %d",
+			len(mpath.KeyPath), len(specs))
+	}
+	if len(items) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "no items to verify in batch membership proof")
+	}
+	for key, value := range items {
+		if len(value) == 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "empty value for key %s in batch membership proof", key)
+		}
+	}
+
+	switch proof.Proofs[0].Proof.(type) {
+	case *ics23.CommitmentProof_Batch, *ics23.CommitmentProof_Compressed, *ics23.CommitmentProof_Exist:
+		// every entry of the batch proof is expected to commit to the same subroot, which is verified for each item
+		subroot, err := proof.Proofs[0].Calculate()
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree may be empty. %v", err)
+		}
+		if ok := ics23.BatchVerifyMembership(specs[0], subroot, proof.Proofs[0], items); !ok {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not verify membership of %d items in subroot %X at index 0. Please ensure the keys and values are all correct.", len(items), subroot)
+		}
+
+		// Verify chained membership proof starting from index 1 with value = subroot.
+		// The key of the lowest subtree is left empty as it is not used when chaining from index 1.
+		keyPath := make([]string, len(mpath.KeyPath)+1)
+		copy(keyPath, mpath.KeyPath)
+		return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, NewMerklePath(keyPath...), subroot, 1)
+	default:
+		return errorsmod.Wrapf(ErrInvalidProof,
+			"expected proof type: %T, got: %T", &ics23.CommitmentProof_Batch{}, proof.Proofs[0].Proof)
+	}
 }
 
 // BatchVerifyNonMembership verifies absence of a group of keys against the given root
@@ -138,6 +138,76 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestBatchVerifyMembership() {
+	items := map[string][]byte{
+		"MYKEY1": []byte("MYVALUE1"),
+		"MYKEY2": []byte("MYVALUE2"),
+		"MYKEY3": []byte("MYVALUE3"),
+	}
+	for key, value := range items {
+		suite.iavlStore.Set([]byte(key), value)
+	}
+	cid := suite.store.Commit()
+
+	var proofs []types.MerkleProof
+	for key := range items {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		require.NoError(suite.T(), err)
+
+		proofs = append(proofs, proof)
+	}
+
+	proof, err := types.CombineProofs(proofs)
+	suite.Require().NoError(err)
+
+	_, err = types.CombineProofs(nil)
+	suite.Require().Error(err)
+
+	cases := []struct {
+		name       string
+		root       []byte
+		pathArr    []string
+		items      map[string][]byte
+		shouldPass bool
+	}{
+		{"valid proof", cid.Hash, []string{suite.storeKey.Name()}, items, true},
+		{"valid proof of subset of items", cid.Hash, []string{suite.storeKey.Name()}, map[string][]byte{"MYKEY2": []byte("MYVALUE2")}, true},
+		{"wrong value", cid.Hash, []string{suite.storeKey.Name()}, map[string][]byte{"MYKEY1": []byte("MYVALUE1"), "MYKEY2": []byte("WRONGVALUE")}, false},
+		{"nil value", cid.Hash, []string{suite.storeKey.Name()}, map[string][]byte{"MYKEY1": nil}, false},
+		{"key not in batch", cid.Hash, []string{suite.storeKey.Name()}, map[string][]byte{"MYKEY1": []byte("MYVALUE1"), "MYKEY4": []byte("MYVALUE1")}, false},
+		{"no items", cid.Hash, []string{suite.storeKey.Name()}, map[string][]byte{}, false},
+		{"wrong path", cid.Hash, []string{suite.storeKey.Name(), "MYKEY1"}, items, false},
+		{"wrong storekey", cid.Hash, []string{"otherStoreKey"}, items, false},
+		{"wrong root", []byte("WRONGROOT"), []string{suite.storeKey.Name()}, items, false},
+		{"nil root", []byte(nil), []string{suite.storeKey.Name()}, items, false},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			root := types.NewMerkleRoot(tc.root)
+			path := types.NewMerklePath(tc.pathArr...)
+
+			err := proof.BatchVerifyMembership(types.GetSDKSpecs(), &root, path, tc.items)
+
+			if tc.shouldPass {
+				//nolint: scopelint
+				suite.Require().NoError(err, "test case %d should have passed", i)
+			} else {
+				//nolint: scopelint
+				suite.Require().Error(err, "test case %d should have failed", i)
+			}
+		})
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
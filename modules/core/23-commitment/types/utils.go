@@ -27,3 +27,30 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineProofs combines merkle proofs of multiple keys within the same lowest subtree into a single
+// MerkleProof which can be verified with BatchVerifyMembership. The lowest proofs are combined into a
+// compressed ics23 batch proof and the proofs of the subroot are expected to be equal for all proofs.
+func CombineProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, errorsmod.Wrap(ErrInvalidMerkleProof, "cannot combine empty list of proofs")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if len(proof.Proofs) == 0 || len(proof.Proofs) != len(proofs[0].Proofs) {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d has length %d, expected %d", i, len(proof.Proofs), len(proofs[0].Proofs))
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batchProof, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batchProof}, proofs[0].Proofs[1:]...),
+	}, nil
+}
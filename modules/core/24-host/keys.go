@@ -27,6 +27,13 @@ const (
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
+	KeyChannelUpgradePrefix    = "channelUpgrades"
+	KeyUpgradePrefix           = "upgrades"
+	KeyUpgradeErrorPrefix      = "upgradeError"
+	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
+	KeyRecvStartSequence       = "recvStartSequence"
+	KeyPruningSequenceStart    = "pruningSequenceStart"
+	KeyPruningSequenceEnd      = "pruningSequenceEnd"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -218,6 +225,51 @@ func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// ChannelUpgradeErrorPath defines the path under which the ErrorReceipt is stored in the case that a chain does not accept the proposed upgrade
+func ChannelUpgradeErrorPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradeErrorPrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeErrorKey returns the store key for a particular channelEnd used to store the ErrorReceipt in the case that a chain does not accept the proposed upgrade
+func ChannelUpgradeErrorKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradeErrorPath(portID, channelID))
+}
+
+// ChannelUpgradePath defines the path which stores the information related to an upgrade attempt
+func ChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeKey returns the store key for a particular channel upgrade attempt
+func ChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradePath(portID, channelID))
+}
+
+// ChannelCounterpartyUpgradeKey returns the store key for the upgrade used on the counterparty channel.
+func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID)))
+}
+
+// RecvStartSequencePath defines the path under which the recv start sequence is stored
+func RecvStartSequencePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID))
+}
+
+// RecvStartSequenceKey returns the store key for the recv start sequence of a particular channel
+func RecvStartSequenceKey(portID, channelID string) []byte {
+	return []byte(RecvStartSequencePath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key for the pruning sequence start of a particular channel
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPruningSequenceStart, channelPath(portID, channelID)))
+}
+
+// PruningSequenceEndKey returns the store key for the pruning sequence end of a particular channel
+func PruningSequenceEndKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPruningSequenceEnd, channelPath(portID, channelID)))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// SubModuleName is the error codespace
+const SubModuleName string = "multihop"
+
+// IBC multi-hop sentinel errors
+var (
+	ErrInvalidMultihopProof = errorsmod.Register(SubModuleName, 2, "invalid multi-hop proof")
+	ErrInvalidConnectionHop = errorsmod.Register(SubModuleName, 3, "invalid connection hop")
+)
@@ -0,0 +1,308 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// VerifyFirstHopFn verifies a proof of the intermediate chain adjacent to the verifying chain.
+// It is expected to verify the proof using the light client of the first connection hop.
+type VerifyFirstHopFn func(proof MultihopProof) error
+
+// consensusStateWithRoot is implemented by consensus states which commit to the state root of a chain.
+type consensusStateWithRoot interface {
+	exported.ConsensusState
+
+	GetRoot() exported.Root
+}
+
+// ValidateBasic performs basic validation of the multi-hop proofs for a channel with the provided number of connection hops.
+func (p MultihopProofs) ValidateBasic(numHops int) error {
+	if numHops < 2 {
+		return errorsmod.Wrapf(ErrInvalidMultihopProof, "multi-hop proofs require at least 2 connection hops, got %d", numHops)
+	}
+
+	if len(p.KeyProof.Proof) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "key proof cannot be empty")
+	}
+
+	if len(p.ConnectionProofs) != numHops-1 {
+		return errorsmod.Wrapf(ErrInvalidMultihopProof, "expected %d connection proofs, got %d", numHops-1, len(p.ConnectionProofs))
+	}
+
+	if len(p.ConsensusProofs) != numHops-1 {
+		return errorsmod.Wrapf(ErrInvalidMultihopProof, "expected %d consensus proofs, got %d", numHops-1, len(p.ConsensusProofs))
+	}
+
+	return nil
+}
+
+// GetConnectionEnds returns the connection ends contained in the connection proofs, ordered from the
+// chain adjacent to the verifying chain towards the counterparty chain. The connection ends are not verified.
+func (p MultihopProofs) GetConnectionEnds(cdc codec.BinaryCodec) ([]connectiontypes.ConnectionEnd, error) {
+	connectionEnds := make([]connectiontypes.ConnectionEnd, len(p.ConnectionProofs))
+	for i, proof := range p.ConnectionProofs {
+		if err := cdc.Unmarshal(proof.Value, &connectionEnds[i]); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidMultihopProof, "failed to unmarshal connection end at index %d: %v", i, err)
+		}
+	}
+
+	return connectionEnds, nil
+}
+
+// GetCounterpartyConsensusState returns the consensus state of the counterparty chain and its height, as stored on the
+// intermediate chain adjacent to the counterparty chain. The consensus state is not verified.
+func (p MultihopProofs) GetCounterpartyConsensusState(cdc codec.BinaryCodec) (exported.ConsensusState, clienttypes.Height, error) {
+	if len(p.ConsensusProofs) == 0 {
+		return nil, clienttypes.ZeroHeight(), errorsmod.Wrap(ErrInvalidMultihopProof, "consensus proofs cannot be empty")
+	}
+
+	proof := p.ConsensusProofs[len(p.ConsensusProofs)-1]
+	_, height, err := parseConsensusStatePath(proof.PrefixedKey)
+	if err != nil {
+		return nil, clienttypes.ZeroHeight(), err
+	}
+
+	consensusState, err := clienttypes.UnmarshalConsensusState(cdc, proof.Value)
+	if err != nil {
+		return nil, clienttypes.ZeroHeight(), errorsmod.Wrapf(ErrInvalidMultihopProof, "failed to unmarshal consensus state: %v", err)
+	}
+
+	return consensusState, height, nil
+}
+
+// GetMaxDelayPeriod returns the maximum time delay period of the provided connection end and the connection ends
+// contained in the connection proofs.
+func (p MultihopProofs) GetMaxDelayPeriod(cdc codec.BinaryCodec, connection exported.ConnectionI) (uint64, error) {
+	connectionEnds, err := p.GetConnectionEnds(cdc)
+	if err != nil {
+		return 0, err
+	}
+
+	delayPeriod := connection.GetDelayPeriod()
+	for _, connectionEnd := range connectionEnds {
+		if connectionEnd.DelayPeriod > delayPeriod {
+			delayPeriod = connectionEnd.DelayPeriod
+		}
+	}
+
+	return delayPeriod, nil
+}
+
+// GetCounterpartyConnectionHops returns the connection hops of the counterparty channel end, which are the
+// counterparty connection identifiers of the provided connection end and the connection ends contained in the
+// connection proofs, in reverse order.
+func (p MultihopProofs) GetCounterpartyConnectionHops(cdc codec.BinaryCodec, connection exported.ConnectionI) ([]string, error) {
+	connectionEnds, err := p.GetConnectionEnds(cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyHops := make([]string, 0, len(connectionEnds)+1)
+	for i := len(connectionEnds) - 1; i >= 0; i-- {
+		counterpartyHops = append(counterpartyHops, connectionEnds[i].Counterparty.ConnectionId)
+	}
+
+	return append(counterpartyHops, connection.GetCounterparty().GetConnectionID()), nil
+}
+
+// VerifyMembership verifies the multi-hop proofs of the value stored under the provided ICS 24 path on the counterparty
+// chain at the end of the connection hops. The provided prefix is the store prefix of the chain adjacent to the verifying chain.
+func VerifyMembership(
+	cdc codec.BinaryCodec,
+	proofs MultihopProofs,
+	connectionHops []string,
+	prefix exported.Prefix,
+	verifyFirstHop VerifyFirstHopFn,
+	path string,
+	value []byte,
+) error {
+	consensusState, merklePath, keyProof, err := verifyConnectionHops(cdc, proofs, connectionHops, prefix, verifyFirstHop, path)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath, value); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify key proof for path %s", path)
+	}
+
+	return nil
+}
+
+// VerifyNonMembership verifies the multi-hop proofs of the absence of the provided ICS 24 path on the counterparty
+// chain at the end of the connection hops. The provided prefix is the store prefix of the chain adjacent to the verifying chain.
+func VerifyNonMembership(
+	cdc codec.BinaryCodec,
+	proofs MultihopProofs,
+	connectionHops []string,
+	prefix exported.Prefix,
+	verifyFirstHop VerifyFirstHopFn,
+	path string,
+) error {
+	consensusState, merklePath, keyProof, err := verifyConnectionHops(cdc, proofs, connectionHops, prefix, verifyFirstHop, path)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify key proof for path %s", path)
+	}
+
+	return nil
+}
+
+// verifyConnectionHops verifies the connection and consensus proofs of each intermediate chain along the connection hops.
+// The proofs of the chain adjacent to the verifying chain are verified using verifyFirstHop, the proofs of every
+// later chain are verified against the state root of the consensus state proven on the previous chain.
+// The consensus state of the counterparty chain, the prefixed merkle path of the provided ICS 24 path on the
+// counterparty chain and the key proof are returned.
+func verifyConnectionHops(
+	cdc codec.BinaryCodec,
+	proofs MultihopProofs,
+	connectionHops []string,
+	prefix exported.Prefix,
+	verifyFirstHop VerifyFirstHopFn,
+	path string,
+) (consensusStateWithRoot, commitmenttypes.MerklePath, commitmenttypes.MerkleProof, error) {
+	if err := proofs.ValidateBasic(len(connectionHops)); err != nil {
+		return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, err
+	}
+
+	var consensusState consensusStateWithRoot
+	for i := range proofs.ConnectionProofs {
+		connectionProof, consensusProof := proofs.ConnectionProofs[i], proofs.ConsensusProofs[i]
+
+		connectionPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.ConnectionPath(connectionHops[i+1])))
+		if err != nil {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, err
+		}
+
+		if !pathsEqual(connectionPath, connectionProof.PrefixedKey) {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "connection proof key %s does not match expected key %s", connectionProof.PrefixedKey, connectionPath)
+		}
+
+		var connectionEnd connectiontypes.ConnectionEnd
+		if err := cdc.Unmarshal(connectionProof.Value, &connectionEnd); err != nil {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "failed to unmarshal connection end %s: %v", connectionHops[i+1], err)
+		}
+
+		if connectionEnd.State != connectiontypes.OPEN {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidConnectionHop, "connection %s state is not OPEN (got %s)", connectionHops[i+1], connectionEnd.State)
+		}
+
+		clientID, height, err := parseConsensusStatePath(consensusProof.PrefixedKey)
+		if err != nil {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, err
+		}
+
+		if clientID != connectionEnd.ClientId {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "consensus proof client ID %s does not match connection %s client ID %s", clientID, connectionHops[i+1], connectionEnd.ClientId)
+		}
+
+		consensusPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullConsensusStatePath(clientID, height)))
+		if err != nil {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, err
+		}
+
+		if !pathsEqual(consensusPath, consensusProof.PrefixedKey) {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "consensus proof key %s does not match expected key %s", consensusProof.PrefixedKey, consensusPath)
+		}
+
+		if i == 0 {
+			if err := verifyFirstHop(connectionProof); err != nil {
+				return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(err, "failed to verify connection proof at index %d", i)
+			}
+
+			if err := verifyFirstHop(consensusProof); err != nil {
+				return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(err, "failed to verify consensus proof at index %d", i)
+			}
+		} else {
+			if err := verifyIntermediateProof(cdc, consensusState, connectionProof); err != nil {
+				return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(err, "failed to verify connection proof at index %d", i)
+			}
+
+			if err := verifyIntermediateProof(cdc, consensusState, consensusProof); err != nil {
+				return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(err, "failed to verify consensus proof at index %d", i)
+			}
+		}
+
+		nextConsensusState, err := clienttypes.UnmarshalConsensusState(cdc, consensusProof.Value)
+		if err != nil {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "failed to unmarshal consensus state at index %d: %v", i, err)
+		}
+
+		var ok bool
+		consensusState, ok = nextConsensusState.(consensusStateWithRoot)
+		if !ok {
+			return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(ErrInvalidMultihopProof, "consensus state type %T does not provide a commitment root", nextConsensusState)
+		}
+
+		prefix = connectionEnd.Counterparty.GetPrefix()
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, err
+	}
+
+	var keyProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proofs.KeyProof.Proof, &keyProof); err != nil {
+		return nil, commitmenttypes.MerklePath{}, commitmenttypes.MerkleProof{}, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal key proof: %v", err)
+	}
+
+	return consensusState, merklePath, keyProof, nil
+}
+
+// verifyIntermediateProof verifies the proof of an intermediate chain against the state root of its consensus state.
+func verifyIntermediateProof(cdc codec.BinaryCodec, consensusState consensusStateWithRoot, proof MultihopProof) error {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof.Proof, &merkleProof); err != nil {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal merkle proof: %v", err)
+	}
+
+	return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), proof.PrefixedKey, proof.Value)
+}
+
+// parseConsensusStatePath returns the client identifier and height of a prefixed consensus state path.
+func parseConsensusStatePath(path commitmenttypes.MerklePath) (string, clienttypes.Height, error) {
+	if len(path.KeyPath) == 0 {
+		return "", clienttypes.ZeroHeight(), errorsmod.Wrap(ErrInvalidMultihopProof, "consensus proof key cannot be empty")
+	}
+
+	// clients/{client-id}/consensusStates/{height}
+	split := strings.Split(path.KeyPath[len(path.KeyPath)-1], "/")
+	if len(split) != 4 || split[0] != string(host.KeyClientStorePrefix) || split[2] != host.KeyConsensusStatePrefix {
+		return "", clienttypes.ZeroHeight(), errorsmod.Wrapf(ErrInvalidMultihopProof, "invalid consensus proof key %s", path)
+	}
+
+	height, err := clienttypes.ParseHeight(split[3])
+	if err != nil {
+		return "", clienttypes.ZeroHeight(), errorsmod.Wrapf(ErrInvalidMultihopProof, "invalid consensus proof key %s: %v", path, err)
+	}
+
+	return split[1], height, nil
+}
+
+// pathsEqual returns true if the provided merkle paths are equal.
+func pathsEqual(a, b commitmenttypes.MerklePath) bool {
+	if len(a.KeyPath) != len(b.KeyPath) {
+		return false
+	}
+
+	for i := range a.KeyPath {
+		if a.KeyPath[i] != b.KeyPath[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,746 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/multihop/v1/multihop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultihopProof defines a proof of the value stored under a prefixed key on a chain
+// along the connection hops of a multi-hop channel.
+type MultihopProof struct {
+	// merkle proof of the value under the prefixed key
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// value stored under the prefixed key
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// key under which the value is stored, including the store prefix of the chain
+	PrefixedKey types.MerklePath `protobuf:"bytes,3,opt,name=prefixed_key,json=prefixedKey,proto3" json:"prefixed_key"`
+}
+
+func (m *MultihopProof) Reset()         { *m = MultihopProof{} }
+func (m *MultihopProof) String() string { return proto.CompactTextString(m) }
+func (*MultihopProof) ProtoMessage()    {}
+func (*MultihopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f32d4eb9f8667d, []int{0}
+}
+func (m *MultihopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProof.Merge(m, src)
+}
+func (m *MultihopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProof proto.InternalMessageInfo
+
+func (m *MultihopProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetPrefixedKey() types.MerklePath {
+	if m != nil {
+		return m.PrefixedKey
+	}
+	return types.MerklePath{}
+}
+
+// MultihopProofs defines the chained proofs used to verify state on the chain at the end of the
+// connection hops of a multi-hop channel. Connection and consensus proofs are ordered from the chain
+// adjacent to the verifying chain towards the counterparty chain.
+type MultihopProofs struct {
+	// proof of the key on the counterparty chain
+	KeyProof MultihopProof `protobuf:"bytes,1,opt,name=key_proof,json=keyProof,proto3" json:"key_proof"`
+	// proofs of the connection ends of the connection hops on each intermediate chain
+	ConnectionProofs []MultihopProof `protobuf:"bytes,2,rep,name=connection_proofs,json=connectionProofs,proto3" json:"connection_proofs"`
+	// proofs of the consensus states of the next chain stored on each intermediate chain
+	ConsensusProofs []MultihopProof `protobuf:"bytes,3,rep,name=consensus_proofs,json=consensusProofs,proto3" json:"consensus_proofs"`
+}
+
+func (m *MultihopProofs) Reset()         { *m = MultihopProofs{} }
+func (m *MultihopProofs) String() string { return proto.CompactTextString(m) }
+func (*MultihopProofs) ProtoMessage()    {}
+func (*MultihopProofs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f32d4eb9f8667d, []int{1}
+}
+func (m *MultihopProofs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProofs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProofs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProofs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProofs.Merge(m, src)
+}
+func (m *MultihopProofs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProofs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProofs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProofs proto.InternalMessageInfo
+
+func (m *MultihopProofs) GetKeyProof() MultihopProof {
+	if m != nil {
+		return m.KeyProof
+	}
+	return MultihopProof{}
+}
+
+func (m *MultihopProofs) GetConnectionProofs() []MultihopProof {
+	if m != nil {
+		return m.ConnectionProofs
+	}
+	return nil
+}
+
+func (m *MultihopProofs) GetConsensusProofs() []MultihopProof {
+	if m != nil {
+		return m.ConsensusProofs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultihopProof)(nil), "ibc.core.multihop.v1.MultihopProof")
+	proto.RegisterType((*MultihopProofs)(nil), "ibc.core.multihop.v1.MultihopProofs")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/multihop/v1/multihop.proto", fileDescriptor_d4f32d4eb9f8667d)
+}
+
+var fileDescriptor_d4f32d4eb9f8667d = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbb, 0x6e, 0xea, 0x40,
+	0x10, 0x86, 0x6d, 0x38, 0x1c, 0x9d, 0xb3, 0x90, 0x9b, 0x45, 0x61, 0x51, 0x38, 0x08, 0x8a, 0xd0,
+	0xe0, 0x15, 0x50, 0x44, 0x4a, 0x49, 0x91, 0x06, 0x21, 0x21, 0x84, 0x52, 0xa4, 0x41, 0x78, 0x19,
+	0xcc, 0xca, 0x97, 0xb1, 0xbc, 0x6b, 0x2b, 0x7e, 0x81, 0x28, 0x65, 0x1e, 0x8b, 0x92, 0x32, 0x55,
+	0x14, 0xc1, 0x8b, 0x44, 0xc6, 0x60, 0x82, 0x94, 0x86, 0x6e, 0x66, 0xf6, 0x9f, 0x6f, 0xff, 0x9d,
+	0x59, 0xd2, 0xe4, 0x16, 0xa3, 0x0c, 0x43, 0xa0, 0x5e, 0xe4, 0x4a, 0xbe, 0xc4, 0x80, 0xc6, 0x9d,
+	0x3c, 0x36, 0x83, 0x10, 0x25, 0x6a, 0x55, 0x6e, 0x31, 0x33, 0x15, 0x99, 0xf9, 0x41, 0xdc, 0xa9,
+	0x55, 0x6d, 0xb4, 0x71, 0x27, 0xa0, 0x69, 0x94, 0x69, 0x6b, 0x77, 0x39, 0x90, 0xa1, 0xe7, 0x71,
+	0xe9, 0x81, 0x2f, 0x53, 0xe4, 0x31, 0xcb, 0x84, 0x8d, 0x37, 0x95, 0x5c, 0x0c, 0xf7, 0xb8, 0x51,
+	0x88, 0xb8, 0xd0, 0xaa, 0xa4, 0x14, 0xa4, 0x81, 0xae, 0xd6, 0xd5, 0x56, 0x65, 0x5c, 0x0a, 0x0e,
+	0xd5, 0x78, 0xe6, 0x46, 0xa0, 0x17, 0xb2, 0xea, 0x2e, 0xd1, 0x06, 0xa4, 0x12, 0x84, 0xb0, 0xe0,
+	0x2f, 0x30, 0x9f, 0x3a, 0x90, 0xe8, 0xc5, 0xba, 0xda, 0x2a, 0x77, 0x1b, 0x66, 0xee, 0xf4, 0xc7,
+	0x7d, 0x71, 0xc7, 0x1c, 0x42, 0xe8, 0xb8, 0x30, 0x9a, 0xc9, 0x65, 0xff, 0xcf, 0xea, 0xf3, 0x56,
+	0x19, 0x97, 0x0f, 0xdd, 0x03, 0x48, 0x1a, 0xaf, 0x05, 0x72, 0x79, 0x62, 0x45, 0x68, 0x8f, 0xe4,
+	0xbf, 0x03, 0xc9, 0xf4, 0xe8, 0xa7, 0xdc, 0x6d, 0x9a, 0xbf, 0x8d, 0xc1, 0x3c, 0x69, 0xdc, 0xd3,
+	0xff, 0x39, 0x90, 0x64, 0x6f, 0x7a, 0x22, 0x37, 0x0c, 0x7d, 0x1f, 0x98, 0xe4, 0xe8, 0x67, 0x38,
+	0xa1, 0x17, 0xea, 0xc5, 0xf3, 0x78, 0xd7, 0x47, 0xc6, 0xde, 0xdf, 0x84, 0xa4, 0x35, 0x01, 0xbe,
+	0x88, 0xc4, 0x01, 0x5b, 0x3c, 0x17, 0x7b, 0x95, 0x23, 0x32, 0x6a, 0x7f, 0xb2, 0xda, 0x18, 0xea,
+	0x7a, 0x63, 0xa8, 0x5f, 0x1b, 0x43, 0x7d, 0xdf, 0x1a, 0xca, 0x7a, 0x6b, 0x28, 0x1f, 0x5b, 0x43,
+	0x79, 0x7e, 0xb0, 0xb9, 0x5c, 0x46, 0x56, 0x3a, 0x56, 0xca, 0x50, 0x78, 0x28, 0x28, 0xb7, 0x58,
+	0xdb, 0x46, 0x1a, 0xdf, 0x53, 0x0f, 0xe7, 0x91, 0x0b, 0x22, 0x5b, 0x7b, 0xaf, 0xd7, 0xce, 0xbf,
+	0x92, 0x4c, 0x02, 0x10, 0xd6, 0xdf, 0xdd, 0xc2, 0x7b, 0xdf, 0x03, 0x00, 0x0d, 0x93, 0xf7, 0x53,
+	0x6c, 0x02, 0x00, 0x00,
+}
+
+func (m *MultihopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrefixedKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultihopProofs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProofs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProofs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusProofs) > 0 {
+		for iNdEx := len(m.ConsensusProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionProofs) > 0 {
+		for iNdEx := len(m.ConnectionProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.KeyProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultihop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultihop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultihopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = m.PrefixedKey.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	return n
+}
+
+func (m *MultihopProofs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyProof.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	if len(m.ConnectionProofs) > 0 {
+		for _, e := range m.ConnectionProofs {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	if len(m.ConsensusProofs) > 0 {
+		for _, e := range m.ConsensusProofs {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMultihop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultihop(x uint64) (n int) {
+	return sovMultihop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultihopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrefixedKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultihopProofs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProofs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProofs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionProofs = append(m.ConnectionProofs, MultihopProof{})
+			if err := m.ConnectionProofs[len(m.ConnectionProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusProofs = append(m.ConsensusProofs, MultihopProof{})
+			if err := m.ConsensusProofs[len(m.ConsensusProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultihop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultihop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultihop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultihop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultihop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultihop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultihop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/33-multihop/types"
+)
+
+func TestMultihopProofsValidateBasic(t *testing.T) {
+	proof := types.MultihopProof{Proof: []byte("proof"), Value: []byte("value")}
+
+	testCases := []struct {
+		name    string
+		proofs  types.MultihopProofs
+		numHops int
+		expPass bool
+	}{
+		{
+			"success: 2 hops",
+			types.MultihopProofs{KeyProof: proof, ConnectionProofs: []types.MultihopProof{proof}, ConsensusProofs: []types.MultihopProof{proof}},
+			2, true,
+		},
+		{
+			"success: 3 hops",
+			types.MultihopProofs{KeyProof: proof, ConnectionProofs: []types.MultihopProof{proof, proof}, ConsensusProofs: []types.MultihopProof{proof, proof}},
+			3, true,
+		},
+		{
+			"single connection hop",
+			types.MultihopProofs{KeyProof: proof},
+			1, false,
+		},
+		{
+			"empty key proof",
+			types.MultihopProofs{ConnectionProofs: []types.MultihopProof{proof}, ConsensusProofs: []types.MultihopProof{proof}},
+			2, false,
+		},
+		{
+			"missing connection proof",
+			types.MultihopProofs{KeyProof: proof, ConnectionProofs: []types.MultihopProof{proof}, ConsensusProofs: []types.MultihopProof{proof, proof}},
+			3, false,
+		},
+		{
+			"missing consensus proof",
+			types.MultihopProofs{KeyProof: proof, ConnectionProofs: []types.MultihopProof{proof, proof}, ConsensusProofs: []types.MultihopProof{proof}},
+			3, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proofs.ValidateBasic(tc.numHops)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMultihopProof, tc.name)
+		}
+	}
+}
+
+func TestMultihopProofsConnectionEnds(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+	// the connection end on the chain adjacent to the verifying chain and the connection end proven on the next chain
+	connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, "07-tendermint-0", connectiontypes.NewCounterparty("07-tendermint-1", "connection-1", prefix), nil, 10)
+	provenConnection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, "07-tendermint-2", connectiontypes.NewCounterparty("07-tendermint-3", "connection-3", prefix), nil, 20)
+
+	bz, err := cdc.Marshal(&provenConnection)
+	require.NoError(t, err)
+
+	proofs := types.MultihopProofs{ConnectionProofs: []types.MultihopProof{{Value: bz}}}
+
+	delayPeriod, err := proofs.GetMaxDelayPeriod(cdc, connection)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), delayPeriod)
+
+	counterpartyHops, err := proofs.GetCounterpartyConnectionHops(cdc, connection)
+	require.NoError(t, err)
+	require.Equal(t, []string{"connection-3", "connection-1"}, counterpartyHops)
+
+	proofs.ConnectionProofs[0].Value = []byte("invalid")
+	_, err = proofs.GetConnectionEnds(cdc)
+	require.ErrorIs(t, err, types.ErrInvalidMultihopProof)
+}
+
+func TestGetCounterpartyConsensusState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	_, _, err := types.MultihopProofs{}.GetCounterpartyConsensusState(cdc)
+	require.ErrorIs(t, err, types.ErrInvalidMultihopProof)
+
+	// the consensus state key must be a valid consensus state path
+	proofs := types.MultihopProofs{
+		ConsensusProofs: []types.MultihopProof{{PrefixedKey: commitmenttypes.NewMerklePath("ibc", "connections/connection-0")}},
+	}
+	_, _, err = proofs.GetCounterpartyConsensusState(cdc)
+	require.ErrorIs(t, err, types.ErrInvalidMultihopProof)
+}
@@ -1,32 +1,81 @@
 package ante
 
 import (
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	coretypes "github.com/cosmos/ibc-go/v7/modules/core/types"
+)
+
+// RedundancyPolicy defines which transactions are rejected by the RedundantRelayDecorator.
+type RedundancyPolicy int
+
+const (
+	// RejectRedundantPackets rejects transactions in which all packet messages are redundant. Transactions which
+	// only contain UpdateClient messages are always accepted.
+	RejectRedundantPackets RedundancyPolicy = iota
+	// RejectRedundantIBCMessages rejects transactions in which all packet and UpdateClient messages are redundant.
+	// An UpdateClient message is redundant if the client already has a consensus state at the height of the header.
+	RejectRedundantIBCMessages
 )
 
+// RelayerLabelOther is the relayer label of the redundant relay telemetry counter for relayers which are not tracked.
+const RelayerLabelOther = "other"
+
 type RedundantRelayDecorator struct {
-	k *keeper.Keeper
+	k               *keeper.Keeper
+	policy          RedundancyPolicy
+	trackedRelayers map[string]struct{}
 }
 
+// NewRedundantRelayDecorator returns a RedundantRelayDecorator which rejects transactions in which all packet
+// messages are redundant.
 func NewRedundantRelayDecorator(k *keeper.Keeper) RedundantRelayDecorator {
-	return RedundantRelayDecorator{k: k}
+	return NewRedundantRelayDecoratorWithPolicy(k, RejectRedundantPackets)
+}
+
+// NewRedundantRelayDecoratorWithPolicy returns a RedundantRelayDecorator which rejects transactions according
+// to the given redundancy policy.
+func NewRedundantRelayDecoratorWithPolicy(k *keeper.Keeper, policy RedundancyPolicy) RedundantRelayDecorator {
+	return RedundantRelayDecorator{k: k, policy: policy}
+}
+
+// WithTrackedRelayers returns a copy of the RedundantRelayDecorator which labels the redundant relay telemetry counter
+// with the given relayer addresses. The redundant messages of all other relayers are labelled with RelayerLabelOther,
+// which keeps the cardinality of the counter bounded by the number of tracked relayers.
+func (rrd RedundantRelayDecorator) WithTrackedRelayers(relayers ...string) RedundantRelayDecorator {
+	trackedRelayers := make(map[string]struct{}, len(relayers))
+	for _, relayer := range relayers {
+		trackedRelayers[relayer] = struct{}{}
+	}
+
+	rrd.trackedRelayers = trackedRelayers
+	return rrd
 }
 
-// RedundantRelayDecorator returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout) and additional update messages
+// RedundantRelayDecorator returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout, batched Recv and Ack) and additional update messages
 // and all packet messages are redundant. If the transaction is just a single UpdateClient message, or the multimsg transaction
 // contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
 // are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
-// all packets, by rejecting the tx at the mempool layer.
+// all packets, by rejecting the tx at the mempool layer. With the RejectRedundantIBCMessages policy, UpdateClient messages are
+// also checked for redundancy and the tx is rejected if all of its IBC messages are redundant.
+// A telemetry counter labelled with the message type, channel and relayer is incremented for every redundant message
+// on the first CheckTx of the transaction, relayers which are not tracked are labelled with RelayerLabelOther.
 func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// do not run redundancy check on DeliverTx or simulate
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
-		// keep track of total packet messages and number of redundancies across `RecvPacket`, `AcknowledgePacket`, and `TimeoutPacket/OnClose`
+		// keep track of total packet messages and number of redundancies across `RecvPacket(s)`, `AcknowledgePacket(s)`, and `TimeoutPacket/OnClose`
 		redundancies := 0
 		packetMsgs := 0
+		// keep track of total UpdateClient messages and number of redundancies across them
+		updateRedundancies := 0
+		updateMsgs := 0
 		for _, m := range tx.GetMsgs() {
 			switch msg := m.(type) {
 			case *channeltypes.MsgRecvPacket:
@@ -36,6 +85,7 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				if response.Result == channeltypes.NOOP {
 					redundancies++
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packet.DestinationChannel, msg.Signer, 1)
 				}
 				packetMsgs++
 
@@ -46,9 +96,46 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				if response.Result == channeltypes.NOOP {
 					redundancies++
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packet.SourceChannel, msg.Signer, 1)
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgRecvPackets:
+				response, err := rrd.k.RecvPackets(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				// each packet of the batch is counted as a single packet message
+				batchRedundancies := 0
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						batchRedundancies++
+					}
+					packetMsgs++
+				}
+				redundancies += batchRedundancies
+				if batchRedundancies > 0 {
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packets[0].DestinationChannel, msg.Signer, batchRedundancies)
+				}
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := rrd.k.Acknowledgements(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				// each packet of the batch is counted as a single packet message
+				batchRedundancies := 0
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						batchRedundancies++
+					}
+					packetMsgs++
+				}
+				redundancies += batchRedundancies
+				if batchRedundancies > 0 {
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packets[0].SourceChannel, msg.Signer, batchRedundancies)
+				}
+
 			case *channeltypes.MsgTimeout:
 				response, err := rrd.k.Timeout(sdk.WrapSDKContext(ctx), msg)
 				if err != nil {
@@ -56,6 +143,7 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				if response.Result == channeltypes.NOOP {
 					redundancies++
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packet.SourceChannel, msg.Signer, 1)
 				}
 				packetMsgs++
 
@@ -66,14 +154,20 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				if response.Result == channeltypes.NOOP {
 					redundancies++
+					rrd.emitRedundantRelayTelemetry(ctx, msg, msg.Packet.SourceChannel, msg.Signer, 1)
 				}
 				packetMsgs++
 
 			case *clienttypes.MsgUpdateClient:
-				_, err := rrd.k.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+				redundant, err := rrd.updateClientCheckTx(ctx, msg)
 				if err != nil {
 					return ctx, err
 				}
+				if redundant {
+					updateRedundancies++
+					rrd.emitRedundantRelayTelemetry(ctx, msg, "", msg.Signer, 1)
+				}
+				updateMsgs++
 
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
@@ -87,6 +181,72 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if redundancies == packetMsgs && packetMsgs > 0 {
 			return ctx, channeltypes.ErrRedundantTx
 		}
+
+		// with the strict policy, also return error if all update messages are redundant and there are no
+		// packet messages or all of them are redundant
+		if rrd.policy == RejectRedundantIBCMessages && updateRedundancies == updateMsgs && updateMsgs > 0 && redundancies == packetMsgs {
+			return ctx, channeltypes.ErrRedundantTx
+		}
 	}
 	return next(ctx, tx, simulate)
 }
+
+// updateClientCheckTx executes the UpdateClient message and returns true if it is redundant. The message is redundant
+// if the client already has a consensus state at the height of the header and the client is not frozen by the update,
+// as a conflicting header at an existing height is misbehaviour.
+func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *clienttypes.MsgUpdateClient) (bool, error) {
+	var exists bool
+	if clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage); err == nil {
+		if header, ok := clientMsg.(interface{ GetHeight() exported.Height }); ok {
+			exists = rrd.k.ClientKeeper.HasClientConsensusState(ctx, msg.ClientId, header.GetHeight())
+		}
+	}
+
+	if _, err := rrd.k.UpdateClient(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return false, nil
+	}
+
+	clientState, found := rrd.k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+	if !found {
+		return false, nil
+	}
+
+	return rrd.k.ClientKeeper.GetClientStatus(ctx, clientState, msg.ClientId) == exported.Active, nil
+}
+
+// emitRedundantRelayTelemetry increments the counter of redundant relayed messages of the given message type, channel
+// and relayer, and logs the relayer which submitted them. The channel is empty for UpdateClient messages. Untracked
+// relayers are labelled with RelayerLabelOther. Nothing is counted on ReCheckTx, as the transaction was already counted
+// on its first CheckTx.
+func (rrd RedundantRelayDecorator) emitRedundantRelayTelemetry(ctx sdk.Context, msg sdk.Msg, channelID, relayer string, redundancies int) {
+	if ctx.IsReCheckTx() {
+		return
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+
+	relayerLabel := RelayerLabelOther
+	if _, tracked := rrd.trackedRelayers[relayer]; tracked {
+		relayerLabel = relayer
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel(coretypes.LabelMsgType, msgType)}
+	if channelID != "" {
+		labels = append(labels, telemetry.NewLabel(coretypes.LabelChannel, channelID))
+	}
+	labels = append(labels, telemetry.NewLabel(coretypes.LabelRelayer, relayerLabel))
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "relay", "redundant"},
+		float32(redundancies),
+		labels,
+	)
+
+	ctx.Logger().With("module", "x/"+exported.ModuleName).Debug(
+		"redundant IBC message", "msg-type", msgType, "channel-id", channelID, "relayer", relayer, "redundancies", redundancies,
+	)
+}
@@ -3,9 +3,11 @@ package ante_test
 import (
 	"testing"
 
+	metrics "github.com/armon/go-metrics"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -13,6 +15,7 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/ante"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v7/modules/core/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 )
 
@@ -92,6 +95,73 @@ func (suite *AnteTestSuite) createAcknowledgementMessage(isRedundant bool) sdk.M
 	return channeltypes.NewMsgAcknowledgement(packet, ibctesting.MockAcknowledgement, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for a batch of packets sent from chain A to chain B.
+// A packet is received on chain B before the message is created if it is marked as redundant.
+func (suite *AnteTestSuite) createRecvPacketsMessage(isRedundant ...bool) sdk.Msg {
+	var (
+		packets []channeltypes.Packet
+		keys    [][]byte
+	)
+	for _, redundant := range isRedundant {
+		sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+
+		if redundant {
+			err = suite.path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}
+
+		packets = append(packets, packet)
+		keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainA.QueryBatchProof(keys)
+
+	return channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
+// createAcknowledgementsMessage creates an Acknowledgements message for a batch of packets sent from chain B to chain A.
+// A packet is acknowledged on chain B before the message is created if it is marked as redundant.
+func (suite *AnteTestSuite) createAcknowledgementsMessage(isRedundant ...bool) sdk.Msg {
+	var (
+		packets []channeltypes.Packet
+		acks    [][]byte
+		keys    [][]byte
+	)
+	for _, redundant := range isRedundant {
+		sequence, err := suite.path.EndpointB.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+		err = suite.path.EndpointA.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		if redundant {
+			err = suite.path.EndpointB.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+		}
+
+		packets = append(packets, packet)
+		acks = append(acks, ibctesting.MockAcknowledgement)
+		keys = append(keys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+
+	proof, proofHeight := suite.chainA.QueryBatchProof(keys)
+
+	return channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createTimeoutMessage creates an Timeout message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createTimeoutMessage(isRedundant bool) sdk.Msg {
 	height := suite.chainA.LastHeader.GetHeight()
@@ -147,7 +217,7 @@ func (suite *AnteTestSuite) createTimeoutOnCloseMessage(isRedundant bool) sdk.Ms
 	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
 	proofClosed, _ := suite.chainA.QueryProof(channelKey)
 
-	return channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	return channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String(), 0)
 }
 
 func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
@@ -174,6 +244,16 @@ func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
 	return msg
 }
 
+// createRedundantUpdateClientMessage creates an UpdateClient message which has already been executed on chain B.
+func (suite *AnteTestSuite) createRedundantUpdateClientMessage() sdk.Msg {
+	msg := suite.createUpdateClientMessage()
+
+	_, err := suite.chainB.App.GetIBCKeeper().UpdateClient(sdk.WrapSDKContext(suite.chainB.GetContext()), msg.(*clienttypes.MsgUpdateClient))
+	suite.Require().NoError(err)
+
+	return msg
+}
+
 func (suite *AnteTestSuite) TestAnteDecorator() {
 	testCases := []struct {
 		name     string
@@ -308,6 +388,13 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one redundant UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage()}
+			},
+			true,
+		},
 		{
 			"success on three new UpdateClient messages",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -342,6 +429,51 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one new RecvPackets message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				// none of the packets have been received on the chain yet
+				return []sdk.Msg{suite.createRecvPacketsMessage(false, false, false)}
+			},
+			true,
+		},
+		{
+			"success on one RecvPackets message with one new packet and two redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				// the batch is not redundant as long as a single packet has not been received
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false, true)}
+			},
+			true,
+		},
+		{
+			"success on one new Acknowledgements message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				// none of the packets have been acknowledged on the chain yet
+				return []sdk.Msg{suite.createAcknowledgementsMessage(false, false)}
+			},
+			true,
+		},
+		{
+			"success on one Acknowledgements message with one new packet and one redundant packet",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, false)}
+			},
+			true,
+		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true, true)}
+			},
+			false,
+		},
+		{
+			"no success on one Acknowledgements message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, true)}
+			},
+			false,
+		},
 		{
 			"no success on one redundant RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -488,3 +620,156 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestAnteDecoratorRejectRedundantIBCMessages() {
+	testCases := []struct {
+		name     string
+		malleate func(suite *AnteTestSuite) []sdk.Msg
+		expPass  bool
+	}{
+		{
+			"success on one new UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientMessage()}
+			},
+			true,
+		},
+		{
+			"success on one redundant and one new UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage(), suite.createUpdateClientMessage()}
+			},
+			true,
+		},
+		{
+			"success on one redundant UpdateClient message and one new RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage(), suite.createRecvPacketMessage(false)}
+			},
+			true,
+		},
+		{
+			"no success on one new UpdateClient message and one redundant RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientMessage(), suite.createRecvPacketMessage(true)}
+			},
+			false,
+		},
+		{
+			"no success on one redundant UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage()}
+			},
+			false,
+		},
+		{
+			"no success on one redundant UpdateClient message and one redundant RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage(), suite.createRecvPacketMessage(true)}
+			},
+			false,
+		},
+		{
+			"success on one redundant UpdateClient message and one non-IBC message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRedundantUpdateClientMessage(), &clienttypes.MsgSubmitMisbehaviour{}}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			// reset suite
+			suite.SetupTest()
+
+			k := suite.chainB.App.GetIBCKeeper()
+			decorator := ante.NewRedundantRelayDecoratorWithPolicy(k, ante.RejectRedundantIBCMessages)
+
+			msgs := tc.malleate(suite)
+
+			checkCtx := suite.chainB.GetContext().WithIsCheckTx(true)
+
+			txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+			err := txBuilder.SetMsgs(msgs...)
+			suite.Require().NoError(err)
+			tx := txBuilder.GetTx()
+
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+			_, err = decorator.AnteHandle(checkCtx, tx, false, next)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, channeltypes.ErrRedundantTx)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestRedundantRelayTelemetry() {
+	var relayer string
+
+	testCases := []struct {
+		name            string
+		trackedRelayers func() []string
+		expRelayerLabel func() string
+	}{
+		{
+			"tracked relayer is labelled with its address",
+			func() []string { return []string{relayer} },
+			func() string { return relayer },
+		},
+		{
+			"untracked relayer is labelled as other",
+			func() []string { return []string{ibctesting.InvalidID} },
+			func() string { return ante.RelayerLabelOther },
+		},
+		{
+			"relayer is labelled as other without tracked relayers",
+			func() []string { return nil },
+			func() string { return ante.RelayerLabelOther },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			// reset suite
+			suite.SetupTest()
+			sink := ibctesting.SetupInmemTelemetry(suite.T())
+			relayer = suite.chainA.SenderAccount.GetAddress().String()
+
+			k := suite.chainB.App.GetIBCKeeper()
+			decorator := ante.NewRedundantRelayDecorator(k).WithTrackedRelayers(tc.trackedRelayers()...)
+
+			msg := suite.createRecvPacketMessage(true)
+
+			txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+			err := txBuilder.SetMsgs(msg)
+			suite.Require().NoError(err)
+			tx := txBuilder.GetTx()
+
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+			checkCtx := suite.chainB.GetContext().WithIsCheckTx(true)
+			_, err = decorator.AnteHandle(checkCtx, tx, false, next)
+			suite.Require().ErrorIs(err, channeltypes.ErrRedundantTx)
+
+			// the transaction is not counted again on recheck
+			recheckCtx := suite.chainB.GetContext().WithIsReCheckTx(true)
+			_, err = decorator.AnteHandle(recheckCtx, tx, false, next)
+			suite.Require().ErrorIs(err, channeltypes.ErrRedundantTx)
+
+			labels := []metrics.Label{
+				telemetry.NewLabel(coretypes.LabelMsgType, sdk.MsgTypeURL(msg)),
+				telemetry.NewLabel(coretypes.LabelChannel, suite.path.EndpointB.ChannelID),
+				telemetry.NewLabel(coretypes.LabelRelayer, tc.expRelayerLabel()),
+			}
+			suite.Require().Equal(float64(1), ibctesting.GetTelemetryCounter(sink, []string{"ibc", "relay", "redundant"}, labels))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package exported
 
 import (
+	"time"
+
 	proto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,6 +22,9 @@ const (
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
+	// Wasm is used to indicate that the light client is a on-chain wasm program
+	Wasm string = "08-wasm"
+
 	// Localhost is the client type for the localhost client.
 	Localhost string = "09-localhost"
 
@@ -42,6 +47,112 @@ const (
 	Unauthorized Status = "Unauthorized"
 )
 
+// ClientStoreProvider provides the isolated prefix store of a client identifier to a light client module.
+type ClientStoreProvider interface {
+	// ClientStore returns the client prefixed store of the provided client identifier.
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+}
+
+// LightClientModule is an interface which core IBC uses to interact with light client implementations.
+// Light client modules are registered on the 02-client router for the client type they implement and
+// are responsible for all the state kept in the client prefixed stores of the clients of that type.
+// A light client module may additionally provide its own codec, parameters, queries, CLI and genesis
+// through its application module.
+type LightClientModule interface {
+	// RegisterStoreProvider is called by core IBC when the light client module is added to the router.
+	// It allows the light client module to access the client prefixed stores of its clients.
+	RegisterStoreProvider(storeProvider ClientStoreProvider)
+
+	// Initialize is called upon client creation, it allows the light client module to perform validation on the initial
+	// client and consensus states and set the client state, consensus state and any client-specific metadata in the client store.
+	Initialize(ctx sdk.Context, clientID string, clientState ClientState, consensusState ConsensusState) error
+
+	// VerifyClientMessage must verify a ClientMessage. A ClientMessage could be a Header, Misbehaviour, or batch update.
+	// It must handle each type of ClientMessage appropriately. Calls to CheckForMisbehaviour, UpdateState, and UpdateStateOnMisbehaviour
+	// will assume that the content of the ClientMessage has been verified and can be trusted. An error should be returned
+	// if the ClientMessage fails to verify.
+	VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg ClientMessage) error
+
+	// CheckForMisbehaviour checks for evidence of a misbehaviour in Header or Misbehaviour type. It assumes the ClientMessage
+	// has already been verified.
+	CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg ClientMessage) bool
+
+	// UpdateStateOnMisbehaviour should perform appropriate state changes on a client state given that misbehaviour has been detected and verified.
+	UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg ClientMessage)
+
+	// UpdateState updates and stores as necessary any associated information for an IBC client, such as the ClientState and corresponding ConsensusState.
+	// Upon successful update, a list of consensus heights is returned. It assumes the ClientMessage has already been verified.
+	UpdateState(ctx sdk.Context, clientID string, clientMsg ClientMessage) []Height
+
+	// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+	// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		value []byte,
+	) error
+
+	// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+	// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyNonMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+	) error
+
+	// VerifyBatchMembership verifies a single proof of the existence of multiple values at the specified height.
+	// The prefix is the CommitmentPrefix of the counterparty and the items are the values keyed by their standardized
+	// path (as defined in ICS 24). An error must be returned if the light client does not support batch proofs.
+	VerifyBatchMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		prefix Prefix,
+		items map[string][]byte,
+	) error
+
+	// PruneExpiredConsensusStates prunes at most limit expired consensus states of the client and their metadata in
+	// ascending height order. All expired consensus states are pruned if the limit is zero. The number of pruned
+	// consensus states is returned. An error must be returned if the light client does not support pruning.
+	PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error)
+
+	// Status must return the status of the client. Only Active clients are allowed to process packets.
+	Status(ctx sdk.Context, clientID string) Status
+
+	// LatestHeight returns the latest height of the client. If no client is present for the provided client identifier a zero value height is returned.
+	LatestHeight(ctx sdk.Context, clientID string) Height
+
+	// TimestampAtHeight must return the timestamp for the consensus state associated with the provided height.
+	TimestampAtHeight(ctx sdk.Context, clientID string, height Height) (uint64, error)
+
+	// RecoverClient must verify that the provided substitute may be used to update the subject client.
+	// The light client module must set the updated client and consensus states within the client store of the subject client.
+	RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error
+
+	// VerifyUpgradeAndUpdateState verifies the upgraded client and consensus states committed to by the counterparty
+	// and sets them in the client store if the upgrade is verified.
+	VerifyUpgradeAndUpdateState(
+		ctx sdk.Context,
+		clientID string,
+		newClient ClientState,
+		newConsState ConsensusState,
+		proofUpgradeClient,
+		proofUpgradeConsState []byte,
+	) error
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -139,6 +250,13 @@ type ClientState interface {
 	) error
 }
 
+// ExpiringClientState is an optional interface which may be implemented by a ClientState whose consensus states
+// expire after a period of time, such as the trusting period of a tendermint client.
+type ExpiringClientState interface {
+	// ExpiryTime returns the time at which a consensus state with the given timestamp expires.
+	ExpiryTime(consensusTimestamp time.Time) time.Time
+}
+
 // ConsensusState is the state of the consensus process
 type ConsensusState interface {
 	proto.Message
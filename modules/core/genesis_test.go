@@ -144,6 +144,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					channeltypes.DefaultParams(),
 				),
 			},
 			expPass: true,
@@ -302,6 +303,7 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					channeltypes.DefaultParams(),
 				),
 			},
 		},
@@ -38,6 +38,26 @@ func (k Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStat
 	return k.ClientKeeper.ClientStatus(c, req)
 }
 
+// ClientStatuses implements the IBC QueryServer interface
+func (k Keeper) ClientStatuses(c context.Context, req *clienttypes.QueryClientStatusesRequest) (*clienttypes.QueryClientStatusesResponse, error) {
+	return k.ClientKeeper.ClientStatuses(c, req)
+}
+
+// SelfConsensusStates implements the IBC QueryServer interface
+func (k Keeper) SelfConsensusStates(c context.Context, req *clienttypes.QuerySelfConsensusStatesRequest) (*clienttypes.QuerySelfConsensusStatesResponse, error) {
+	return k.ClientKeeper.SelfConsensusStates(c, req)
+}
+
+// VerifyMembership implements the IBC QueryServer interface
+func (k Keeper) VerifyMembership(c context.Context, req *clienttypes.QueryVerifyMembershipRequest) (*clienttypes.QueryVerifyMembershipResponse, error) {
+	return k.ClientKeeper.VerifyMembership(c, req)
+}
+
+// VerifyNonMembership implements the IBC QueryServer interface
+func (k Keeper) VerifyNonMembership(c context.Context, req *clienttypes.QueryVerifyNonMembershipRequest) (*clienttypes.QueryVerifyNonMembershipResponse, error) {
+	return k.ClientKeeper.VerifyNonMembership(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (k Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return k.ClientKeeper.ClientParams(c, req)
@@ -152,3 +172,23 @@ func (k Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNe
 func (k Keeper) NextSequenceSend(c context.Context, req *channeltypes.QueryNextSequenceSendRequest) (*channeltypes.QueryNextSequenceSendResponse, error) {
 	return k.ChannelKeeper.NextSequenceSend(c, req)
 }
+
+// UpgradeError implements the IBC QueryServer interface
+func (k Keeper) UpgradeError(c context.Context, req *channeltypes.QueryUpgradeErrorRequest) (*channeltypes.QueryUpgradeErrorResponse, error) {
+	return k.ChannelKeeper.UpgradeError(c, req)
+}
+
+// Upgrade implements the IBC QueryServer interface
+func (k Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeRequest) (*channeltypes.QueryUpgradeResponse, error) {
+	return k.ChannelKeeper.Upgrade(c, req)
+}
+
+// ChannelParams implements the IBC QueryServer interface
+func (k Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
+	return k.ChannelKeeper.ChannelParams(c, req)
+}
+
+// PruningSequences implements the IBC QueryServer interface
+func (k Keeper) PruningSequences(c context.Context, req *channeltypes.QueryPruningSequencesRequest) (*channeltypes.QueryPruningSequencesResponse, error) {
+	return k.ChannelKeeper.PruningSequences(c, req)
+}
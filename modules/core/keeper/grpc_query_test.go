@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// TestQueryVerificationDelay tests that proof verification queries are rejected when their delay periods are
+// below the delay period of the connections of the client.
+func (suite *KeeperTestSuite) TestQueryVerificationDelay() {
+	delayPeriod := uint64(time.Hour.Nanoseconds())
+
+	var (
+		timeDelay  uint64
+		blockDelay uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"success: delay periods of the connection",
+			func() {},
+			false,
+		},
+		{
+			"time delay below connection delay period",
+			func() {
+				timeDelay = delayPeriod - 1
+			},
+			true,
+		},
+		{
+			"block delay below connection block delay",
+			func() {
+				blockDelay--
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ConnectionConfig.DelayPeriod = delayPeriod
+			path.EndpointB.ConnectionConfig.DelayPeriod = delayPeriod
+			suite.coordinator.Setup(path)
+
+			ibcKeeper := suite.chainA.App.GetIBCKeeper()
+			timeDelay, blockDelay = ibcKeeper.ConnectionKeeper.GetClientDelayPeriods(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().Equal(delayPeriod, timeDelay)
+			suite.Require().NotZero(blockDelay)
+
+			tc.malleate()
+
+			connectionKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+			proof, proofHeight := suite.chainB.QueryProof(connectionKey)
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			_, membershipErr := ibcKeeper.VerifyMembership(ctx, &clienttypes.QueryVerifyMembershipRequest{
+				ClientId:    path.EndpointA.ClientID,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				MerklePath:  merklePath,
+				Value:       value,
+				TimeDelay:   timeDelay,
+				BlockDelay:  blockDelay,
+			})
+			_, nonMembershipErr := ibcKeeper.VerifyNonMembership(ctx, &clienttypes.QueryVerifyNonMembershipRequest{
+				ClientId:    path.EndpointA.ClientID,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				MerklePath:  merklePath,
+				TimeDelay:   timeDelay,
+				BlockDelay:  blockDelay,
+			})
+
+			if tc.expErr {
+				suite.Require().Equal(codes.InvalidArgument, status.Code(membershipErr))
+				suite.Require().Equal(codes.InvalidArgument, status.Code(nonMembershipErr))
+			} else {
+				suite.Require().NoError(membershipErr)
+				suite.Require().NoError(nonMembershipErr)
+			}
+		})
+	}
+}
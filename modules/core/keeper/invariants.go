@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// RegisterInvariants registers all ibc core invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(exported.ModuleName, "packet-commitments",
+		PacketCommitmentsInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "ordered-channel-sequences",
+		OrderedChannelSequencesInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "channel-connection-hops",
+		ChannelConnectionHopsInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "channel-capabilities",
+		ChannelCapabilitiesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ibc core module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PacketCommitmentsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = OrderedChannelSequencesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ChannelConnectionHopsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ChannelCapabilitiesInvariant(k)(ctx)
+	}
+}
+
+// PacketCommitmentsInvariant checks that the sequence of every stored packet commitment
+// is lower than the next send sequence of its channel.
+func PacketCommitmentsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, commitment := range k.ChannelKeeper.GetAllPacketCommitments(ctx) {
+			nextSequenceSend, found := k.ChannelKeeper.GetNextSequenceSend(ctx, commitment.PortId, commitment.ChannelId)
+			if !found || commitment.Sequence >= nextSequenceSend {
+				broken = true
+				msg += fmt.Sprintf("\tpacket commitment (port ID: %s, channel ID: %s, sequence: %d) with next send sequence %d\n",
+					commitment.PortId, commitment.ChannelId, commitment.Sequence, nextSequenceSend)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			exported.ModuleName,
+			"packet commitments",
+			fmt.Sprintf("found packet commitment(s) not lower than the next send sequence of their channel:\n%s", msg)), broken
+	}
+}
+
+// OrderedChannelSequencesInvariant checks that the next acknowledgement sequence of every ordered channel
+// does not exceed its next send sequence.
+func OrderedChannelSequencesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			if channel.Ordering != channeltypes.ORDERED && channel.Ordering != channeltypes.ORDERED_ALLOW_TIMEOUT {
+				continue
+			}
+
+			nextSequenceSend, _ := k.ChannelKeeper.GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId)
+			nextSequenceAck, _ := k.ChannelKeeper.GetNextSequenceAck(ctx, channel.PortId, channel.ChannelId)
+			if nextSequenceAck > nextSequenceSend {
+				broken = true
+				msg += fmt.Sprintf("\tchannel (port ID: %s, channel ID: %s) with next ack sequence %d and next send sequence %d\n",
+					channel.PortId, channel.ChannelId, nextSequenceAck, nextSequenceSend)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			exported.ModuleName,
+			"ordered channel sequences",
+			fmt.Sprintf("found ordered channel(s) with a next ack sequence greater than the next send sequence:\n%s", msg)), broken
+	}
+}
+
+// ChannelConnectionHopsInvariant checks that the connection hop of every channel exists and references
+// an existing client. Only the first hop of a multi-hop channel is stored on this chain, so the remaining
+// hops are not checked.
+func ChannelConnectionHopsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			if len(channel.ConnectionHops) == 0 {
+				broken = true
+				msg += fmt.Sprintf("\tchannel (port ID: %s, channel ID: %s) has no connection hops\n", channel.PortId, channel.ChannelId)
+				continue
+			}
+
+			connectionID := channel.ConnectionHops[0]
+			connection, found := k.ConnectionKeeper.GetConnection(ctx, connectionID)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tchannel (port ID: %s, channel ID: %s) references connection %s which does not exist\n",
+					channel.PortId, channel.ChannelId, connectionID)
+				continue
+			}
+
+			if _, found := k.ClientKeeper.GetClientState(ctx, connection.ClientId); !found {
+				broken = true
+				msg += fmt.Sprintf("\tchannel (port ID: %s, channel ID: %s) references connection %s with client %s which does not exist\n",
+					channel.PortId, channel.ChannelId, connectionID, connection.ClientId)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			exported.ModuleName,
+			"channel connection hops",
+			fmt.Sprintf("found channel(s) with a missing connection or client:\n%s", msg)), broken
+	}
+}
+
+// ChannelCapabilitiesInvariant checks that a channel capability exists for every open channel, including
+// open channels which are being upgraded.
+func ChannelCapabilitiesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			switch channel.State {
+			case channeltypes.OPEN, channeltypes.FLUSHING, channeltypes.FLUSHCOMPLETE:
+			default:
+				continue
+			}
+
+			if _, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, channel.PortId, channel.ChannelId); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tchannel (port ID: %s, channel ID: %s): %s\n", channel.PortId, channel.ChannelId, err)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			exported.ModuleName,
+			"channel capabilities",
+			fmt.Sprintf("found open channel(s) without a channel capability:\n%s", msg)), broken
+	}
+}
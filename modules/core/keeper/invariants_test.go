@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"fails with packet commitment at the next send sequence",
+			func() {
+				nextSequenceSend, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceSend, []byte("hash"))
+			},
+			false,
+		},
+		{
+			"fails with next ack sequence greater than next send sequence on ordered channel",
+			func() {
+				nextSequenceSend, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceSend+1)
+			},
+			false,
+		},
+		{
+			"fails with channel on a connection which does not exist",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.ConnectionHops = []string{ibctesting.InvalidID}
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+		{
+			"fails with channel on a connection whose client does not exist",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = clienttypes.FormatClientIdentifier(ibcexported.Tendermint, 100)
+				path.EndpointA.SetConnection(connection)
+			},
+			false,
+		},
+		{
+			"fails with open channel without channel capability",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channeltypes.FormatChannelIdentifier(100), channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			out, broken := keeper.AllInvariants(suite.chainA.App.GetIBCKeeper())(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
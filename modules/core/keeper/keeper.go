@@ -37,7 +37,8 @@ type Keeper struct {
 	authority string
 }
 
-// NewKeeper creates a new ibc Keeper
+// NewKeeper creates a new ibc Keeper. If the staking keeper is nil, the consensus host of the running
+// chain must be set on the client keeper with SetConsensusHost.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
@@ -51,8 +52,9 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(keyTable)
 	}
 
-	// panic if any of the keepers passed in is empty
-	if isEmpty(stakingKeeper) {
+	// panic if any of the keepers passed in is empty, the staking keeper may be nil for
+	// chains which set their own consensus host on the client keeper
+	if stakingKeeper != nil && isEmpty(stakingKeeper) {
 		panic(fmt.Errorf("cannot initialize IBC keeper: empty staking keeper"))
 	}
 	if isEmpty(upgradeKeeper) {
@@ -63,19 +65,22 @@ func NewKeeper(
 		panic(fmt.Errorf("cannot initialize IBC keeper: empty scoped keeper"))
 	}
 
-	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper)
-	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
-	portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
-
-	return &Keeper{
-		cdc:              cdc,
-		ClientKeeper:     clientKeeper,
-		ConnectionKeeper: connectionKeeper,
-		ChannelKeeper:    channelKeeper,
-		PortKeeper:       portKeeper,
-		authority:        authority,
+	k := &Keeper{
+		cdc:          cdc,
+		ClientKeeper: clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper),
+		PortKeeper:   portkeeper.NewKeeper(scopedKeeper),
+		authority:    authority,
 	}
+
+	// the connection and channel keepers reference the client keeper of the IBC keeper, so that
+	// a consensus host set on the client keeper after initialization is used by all submodules
+	k.ConnectionKeeper = connectionkeeper.NewKeeper(cdc, key, paramSpace, &k.ClientKeeper)
+	k.ChannelKeeper = channelkeeper.NewKeeper(cdc, key, &k.ClientKeeper, k.ConnectionKeeper, k.PortKeeper, scopedKeeper)
+
+	// the client keeper enforces the delay periods of the connections of a client when verifying proofs
+	k.ClientKeeper.SetConnectionKeeper(k.ConnectionKeeper)
+
+	return k
 }
 
 // Codec returns the IBC module codec.
@@ -118,6 +118,10 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 
 			stakingKeeper = mockStakingKeeper
 		}, true},
+		{"success: nil staking keeper", func() {
+			// chains without a staking module set their own consensus host
+			stakingKeeper = nil
+		}, true},
 	}
 
 	for _, tc := range testCases {
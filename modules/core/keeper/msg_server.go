@@ -10,11 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v7/modules/core/types"
 )
 
@@ -100,6 +103,54 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// RecoverClient defines a rpc handler method for MsgRecoverClient.
+func (k Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecoverClient) (*clienttypes.MsgRecoverClientResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ClientKeeper.RecoverClient(ctx, msg.SubjectClientId, msg.SubstituteClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client recovery failed")
+	}
+
+	return &clienttypes.MsgRecoverClientResponse{}, nil
+}
+
+// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
+func (k Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upgradedClientState, err := clienttypes.UnpackClientState(msg.UpgradedClientState)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "cannot unpack upgraded client state")
+	}
+
+	if err := k.ClientKeeper.ScheduleIBCSoftwareUpgrade(ctx, msg.Plan, upgradedClientState); err != nil {
+		return nil, errorsmod.Wrap(err, "cannot schedule IBC client upgrade")
+	}
+
+	return &clienttypes.MsgIBCSoftwareUpgradeResponse{}, nil
+}
+
+// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+// Pruning is permissionless, any signer may prune the expired consensus states of a client.
+func (k Keeper) PruneConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneConsensusStates) (*clienttypes.MsgPruneConsensusStatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, err := k.ClientKeeper.PruneExpiredConsensusStates(ctx, msg.ClientId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "consensus state pruning failed")
+	}
+
+	return &clienttypes.MsgPruneConsensusStatesResponse{TotalPruned: pruned}, nil
+}
+
 // ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -398,7 +449,7 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 		return nil, errorsmod.Wrapf(err, "channel close confirm callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
-	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, capability, msg.ProofInit, msg.ProofHeight)
+	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, capability, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence)
 	if err != nil {
 		ctx.Logger().Error("channel close confirm failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
 		return nil, errorsmod.Wrap(err, "channel handshake close confirm failed")
@@ -447,16 +498,111 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		// no-ops do not need event emission as they will be ignored
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is committed
+		// and the application callbacks are not executed
+		writeFn()
+		ctx.Logger().Info("timeout receipt written", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
 	// Perform application logic callback
+	if err := k.onRecvPacket(ctx, cbs, capability, msg.Packet, relayer); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// RecvPackets defines a rpc handler method for MsgRecvPackets.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// Lookup module by channel capability, all packets are received on the same channel
+	portID, channelID := msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	packets := make([]exported.PacketI, len(msg.Packets))
+	for i := range msg.Packets {
+		packets[i] = msg.Packets[i]
+	}
+
+	// Perform TAO verification of all packets with a single proof
 	//
+	// The state changes of each packet are only written if the packet was received
+	packetErrs, err := k.ChannelKeeper.RecvPackets(ctx, capability, packets, msg.ProofCommitment, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch packetErrs[i] {
+		case nil:
+		case channeltypes.ErrNoOpMsg:
+			// no-ops do not need event emission as they will be ignored
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.NOOP
+			continue
+		case channeltypes.ErrTimeoutReceiptWritten:
+			// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is committed
+			// and the application callbacks are not executed
+			ctx.Logger().Info("timeout receipt written", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.SUCCESS
+			continue
+		default:
+			ctx.Logger().Error("receive packet failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", errorsmod.Wrap(packetErrs[i], "receive packet verification failed"))
+			results[i] = channeltypes.FAILURE
+			continue
+		}
+
+		// Perform application logic callback
+		if err := k.onRecvPacket(ctx, cbs, capability, packet, relayer); err != nil {
+			return nil, err
+		}
+
+		results[i] = channeltypes.SUCCESS
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// onRecvPacket executes the application callback of a received packet and writes its acknowledgement.
+func (k Keeper) onRecvPacket(
+	ctx sdk.Context,
+	cbs porttypes.IBCModule,
+	capability *capabilitytypes.Capability,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+	cacheCtx, writeFn := ctx.CacheContext()
+	ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
@@ -466,8 +612,8 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, packet, ack); err != nil {
+			return err
 		}
 	}
 
@@ -475,16 +621,16 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
 		1,
 		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 		},
 	)
 
-	ctx.Logger().Info("receive packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	ctx.Logger().Info("receive packet callback succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "result", channeltypes.SUCCESS.String())
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -587,7 +733,7 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, capability, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
+	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, capability, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv, msg.CounterpartyUpgradeSequence)
 
 	switch err {
 	case nil:
@@ -675,26 +821,373 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	}
 
 	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	if err := k.onAcknowledgementPacket(ctx, cbs, msg.Packet, msg.Acknowledgement, relayer); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+func (k Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// Lookup module by channel capability, all packets are sent on the same channel
+	portID, channelID := msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	packets := make([]exported.PacketI, len(msg.Packets))
+	for i := range msg.Packets {
+		packets[i] = msg.Packets[i]
+	}
+
+	// Perform TAO verification of all acknowledgements with a single proof
+	//
+	// The state changes of each packet are only written if the packet was acknowledged
+	packetErrs, err := k.ChannelKeeper.AcknowledgePackets(ctx, capability, packets, msg.Acknowledgements, msg.ProofAcked, msg.ProofHeight)
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
-		return nil, errorsmod.Wrap(err, "acknowledge packet callback failed")
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch packetErrs[i] {
+		case nil:
+		case channeltypes.ErrNoOpMsg:
+			// no-ops do not need event emission as they will be ignored
+			results[i] = channeltypes.NOOP
+			continue
+		default:
+			ctx.Logger().Error("acknowledgement failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", errorsmod.Wrap(packetErrs[i], "acknowledge packet verification failed"))
+			results[i] = channeltypes.FAILURE
+			continue
+		}
+
+		// Perform application logic callback
+		if err := k.onAcknowledgementPacket(ctx, cbs, packet, msg.Acknowledgements[i], relayer); err != nil {
+			return nil, err
+		}
+
+		results[i] = channeltypes.SUCCESS
+	}
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// onAcknowledgementPacket executes the application callback of an acknowledged packet.
+func (k Keeper) onAcknowledgementPacket(
+	ctx sdk.Context,
+	cbs porttypes.IBCModule,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		ctx.Logger().Error("acknowledgement failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
+		return errorsmod.Wrap(err, "acknowledge packet callback failed")
 	}
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 		1,
 		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 		},
 	)
 
-	ctx.Logger().Info("acknowledgement succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	ctx.Logger().Info("acknowledgement succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "result", channeltypes.SUCCESS.String())
 
-	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
+}
+
+// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
+func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	app, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", err)
+		return nil, err
+	}
+
+	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Fields)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel upgrade init failed")
+	}
+
+	// NOTE: a cached context is used to discard any application state changes made in the callback
+	cacheCtx, _ := ctx.CacheContext()
+	upgradeVersion, err := app.OnChanUpgradeInit(
+		cacheCtx,
+		msg.PortId,
+		msg.ChannelId,
+		upgrade.Fields.Ordering,
+		upgrade.Fields.ConnectionHops,
+		upgrade.Fields.Version,
+	)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade init callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrapf(err, "channel upgrade init callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
+	}
+
+	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
+
+	ctx.Logger().Info("channel upgrade init succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId, "version", upgradeVersion)
+
+	return &channeltypes.MsgChannelUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: channel.UpgradeSequence,
+	}, nil
+}
+
+// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	app, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade try failed", "port-id", msg.PortId, "error", err)
+		return nil, err
+	}
+
+	channel, upgrade, err := k.ChannelKeeper.ChanUpgradeTry(ctx, msg.PortId, msg.ChannelId, msg.ProposedUpgradeConnectionHops, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade try failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, errorsmod.Wrap(err, "channel upgrade try failed")
+	}
+
+	// NOTE: a cached context is used to discard any application state changes made in the callback
+	cacheCtx, _ := ctx.CacheContext()
+	upgradeVersion, err := app.OnChanUpgradeTry(cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade try callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+		return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	channel, upgrade = k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
+
+	ctx.Logger().Info("channel upgrade try succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTryResponse{
+		Result:          channeltypes.SUCCESS,
+		Upgrade:         upgrade,
+		UpgradeSequence: channel.UpgradeSequence,
+	}, nil
+}
+
+// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
+func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	app, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade ack failed", "port-id", msg.PortId, "error", err)
+		return nil, err
+	}
+
+	err = k.ChannelKeeper.ChanUpgradeAck(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade ack failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, errorsmod.Wrap(err, "channel upgrade ack failed")
+	}
+
+	// NOTE: a cached context is used to discard any application state changes made in the callback
+	cacheCtx, _ := ctx.CacheContext()
+	if err := app.OnChanUpgradeAck(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade.Fields.Version); err != nil {
+		ctx.Logger().Error("channel upgrade ack callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	ctx.Logger().Info("channel upgrade ack succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm.
+func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	app, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade confirm failed", "port-id", msg.PortId, "error", err)
+		return nil, err
+	}
+
+	err = k.ChannelKeeper.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade confirm failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, errorsmod.Wrap(err, "channel upgrade confirm failed")
+	}
+
+	channel := k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	// Move channel to OPEN state if both chains have finished flushing in-flight packets.
+	// Counterparty channel state has been verified in ChanUpgradeConfirm.
+	if channel.State == channeltypes.FLUSHCOMPLETE && msg.CounterpartyChannelState == channeltypes.FLUSHCOMPLETE {
+		upgrade, found := k.ChannelKeeper.GetUpgrade(ctx, msg.PortId, msg.ChannelId)
+		if !found {
+			return nil, errorsmod.Wrapf(channeltypes.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+		}
+
+		k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+		app.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
+
+		ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	}
+
+	ctx.Logger().Info("channel upgrade confirm succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeOpen defines a rpc handler method for MsgChannelUpgradeOpen.
+func (k Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeOpen) (*channeltypes.MsgChannelUpgradeOpenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	app, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade open failed", "port-id", msg.PortId, "error", err)
+		return nil, err
+	}
+
+	if err = k.ChannelKeeper.ChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("channel upgrade open failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel upgrade open failed")
+	}
+
+	upgrade, found := k.ChannelKeeper.GetUpgrade(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+	app.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
+
+	ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeOpenResponse{}, nil
+}
+
+// ChannelUpgradeTimeout defines a rpc handler method for MsgChannelUpgradeTimeout.
+func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("channel upgrade timeout failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel upgrade timeout failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
+
+	ctx.Logger().Info("channel upgrade timeout succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
+}
+
+// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
+func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channel, found := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	isAuthority := k.GetAuthority() == msg.Signer
+	if err := k.ChannelKeeper.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight, isAuthority); err != nil {
+		ctx.Logger().Error("channel upgrade cancel failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel upgrade cancel failed")
+	}
+
+	// the authority may cancel the upgrade without an error receipt, in which case the
+	// current upgrade sequence is used to write the error receipt.
+	sequence := channel.UpgradeSequence
+	if !isAuthority || msg.ErrorReceipt.Sequence > sequence {
+		sequence = msg.ErrorReceipt.Sequence
+	}
+
+	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, sequence)
+
+	ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
+}
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+// Pruning is permissionless, any signer may prune acknowledgements and receipts
+// of upgraded channels up to the provided limit.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, remaining, err := k.ChannelKeeper.PruneAcknowledgements(ctx, msg.PortId, msg.ChannelId, msg.Limit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "acknowledgement pruning failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    pruned,
+		TotalRemainingSequences: remaining,
+	}, nil
 }
 
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
@@ -720,3 +1213,36 @@ func (k Keeper) UpdateConnectionParams(goCtx context.Context, msg *connectiontyp
 
 	return &connectiontypes.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateChannelParams defines a rpc handler method for MsgUpdateParams for the 04-channel submodule.
+func (k Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.MsgUpdateParams) (*channeltypes.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.ChannelKeeper.SetParams(ctx, msg.Params)
+
+	return &channeltypes.MsgUpdateParamsResponse{}, nil
+}
+
+// getUpgradableModule retrieves the module which owns the capability for the given port and channel identifiers,
+// returning an error if the module does not implement the UpgradableModule interface.
+func (k Keeper) getUpgradableModule(ctx sdk.Context, portID, channelID string) (porttypes.UpgradableModule, error) {
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	upgradableModule, ok := cbs.(porttypes.UpgradableModule)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
+	}
+
+	return upgradableModule, nil
+}
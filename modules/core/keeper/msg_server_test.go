@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -9,6 +12,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
@@ -342,6 +346,227 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets with a single proof. It verifies
+// that a result is returned for each packet and that acknowledgements are written for
+// the packets which were received. More rigorous testing of 'RecvPackets' can be found
+// in the 04-channel/keeper/packet_test.go.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	sendPacket := func(data []byte) channeltypes.Packet {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, data)
+		suite.Require().NoError(err)
+
+		return channeltypes.NewPacket(data, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendPacket(ibctesting.MockPacketData), sendPacket(ibctesting.MockPacketData), sendPacket(ibctesting.MockPacketData)}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendPacket(ibctesting.MockPacketData), sendPacket(ibctesting.MockPacketData)}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: failed and async acknowledgements", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendPacket(ibcmock.MockFailPacketData), sendPacket(ibcmock.MockAsyncPacketData)}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: packet already received is a no-op", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendPacket(ibctesting.MockPacketData), sendPacket(ibctesting.MockPacketData)}
+			err := path.EndpointB.RecvPacket(packets[0])
+			suite.Require().NoError(err)
+
+			// update chainB's client to the latest height of chainA
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED packet out of order fails", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			sendPacket(ibctesting.MockPacketData)
+			packets = []channeltypes.Packet{sendPacket(ibctesting.MockPacketData)}
+			expResults = []channeltypes.ResponseResultType{channeltypes.FAILURE}
+		}, true},
+		{"failure: packet commitment mismatch", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendPacket(ibctesting.MockPacketData), sendPacket(ibctesting.MockPacketData)}
+			packets[1].Data = []byte("invalid data")
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			keys := make([][]byte, len(packets))
+			for i, packet := range packets {
+				keys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			}
+			proof, proofHeight := suite.chainA.QueryBatchProof(keys)
+
+			msg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				for i, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					switch {
+					case expResults[i] == channeltypes.FAILURE:
+						suite.Require().False(found)
+					case bytes.Equal(packet.GetData(), ibcmock.MockAsyncPacketData):
+						suite.Require().False(found)
+					default:
+						suite.Require().True(found)
+					}
+				}
+
+				// replay should not error as it is treated as a no-op
+				res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+				suite.Require().NoError(err)
+				for i, result := range res.Results {
+					if expResults[i] != channeltypes.FAILURE {
+						suite.Require().Equal(channeltypes.NOOP, result)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// tests the IBC handler acknowledging a batch of packets with a single proof. It verifies
+// that a result is returned for each packet and that the packet commitments are deleted.
+// More rigorous testing of 'AcknowledgePackets' can be found in the 04-channel/keeper/packet_test.go.
+func (suite *KeeperTestSuite) TestHandleAcknowledgements() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	sendAndRecvPacket := func() channeltypes.Packet {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		return packet
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendAndRecvPacket(), sendAndRecvPacket()}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendAndRecvPacket(), sendAndRecvPacket()}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: packet already acknowledged is a no-op", func() {
+			suite.coordinator.Setup(path)
+
+			packets = []channeltypes.Packet{sendAndRecvPacket(), sendAndRecvPacket()}
+			err := path.EndpointA.AcknowledgePacket(packets[1], ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP}
+		}, true},
+		{"failure: packet not received", func() {
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packets = []channeltypes.Packet{sendAndRecvPacket(), channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			keys := make([][]byte, len(packets))
+			acks := make([][]byte, len(packets))
+			for i, packet := range packets {
+				keys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				acks[i] = ibcmock.MockAcknowledgement.Acknowledgement()
+			}
+			proof, proofHeight := suite.chainB.QueryBatchProof(keys)
+
+			msg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// verify packet commitments were deleted on source chain
+				for _, packet := range packets {
+					has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().False(has)
+				}
+
+				// replay should not error as it is treated as a no-op
+				res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP}, res.Results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -634,7 +859,7 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			proofClosed, _ := suite.chainB.QueryProof(channelKey)
 
-			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.chainA.SenderAccount.GetAddress().String(), 0)
 
 			_, err := keeper.Keeper.TimeoutOnClose(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
 
@@ -776,6 +1001,206 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 	}
 }
 
+// TestRecoverClient tests the RecoverClient rpc handler
+func (suite *KeeperTestSuite) TestRecoverClient() {
+	var msg *clienttypes.MsgRecoverClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: recover client",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"subject client is Active",
+			func() {
+				msg.SubjectClientId = msg.SubstituteClientId
+			},
+			clienttypes.ErrInvalidRecoveryClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+			subject := subjectPath.EndpointA.ClientID
+			subjectClientState := suite.chainA.GetClientState(subject)
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			substitute := substitutePath.EndpointA.ClientID
+
+			// update substitute twice
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+			err = substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			tmClientState, ok := subjectClientState.(*ibctm.ClientState)
+			suite.Require().True(ok)
+			tmClientState.FrozenHeight = tmClientState.LatestHeight
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+
+			msg = clienttypes.NewMsgRecoverClient(suite.chainA.App.GetIBCKeeper().GetAuthority(), subject, substitute)
+
+			tc.malleate()
+
+			_, err = keeper.Keeper.RecoverClient(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				// Assert that client status is now Active
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subject)
+				tmClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(exported.Active, tmClientState.Status(suite.chainA.GetContext(), clientStore, suite.chainA.App.AppCodec()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestPruneConsensusStates tests the PruneConsensusStates rpc handler
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var msg *clienttypes.MsgPruneConsensusStates
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: any signer may prune",
+			func() {},
+			nil,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+			msg = clienttypes.NewMsgPruneConsensusStates(path.EndpointA.ClientID, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneConsensusStates(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), res.TotalPruned)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestIBCSoftwareUpgrade tests the IBCSoftwareUpgrade rpc handler
+func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
+	var msg *clienttypes.MsgIBCSoftwareUpgrade
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: schedule IBC software upgrade",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: cannot unpack client state",
+			func() {
+				protoAny, err := clienttypes.PackConsensusState(&ibctm.ConsensusState{})
+				suite.Require().NoError(err)
+				msg.UpgradedClientState = protoAny
+			},
+			ibcerrors.ErrUnpackAny,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			upgradedClientState := suite.chainA.GetClientState(path.EndpointA.ClientID).ZeroCustomFields()
+
+			plan := upgradetypes.Plan{
+				Name:   "upgrade IBC clients",
+				Height: 1000,
+			}
+
+			var err error
+			msg, err = clienttypes.NewMsgIBCSoftwareUpgrade(suite.chainA.App.GetIBCKeeper().GetAuthority(), plan, upgradedClientState)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, err = keeper.Keeper.IBCSoftwareUpgrade(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				// check that the plan and the upgraded client state were stored
+				storedPlan, found := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradePlan(suite.chainA.GetContext())
+				suite.Require().True(found)
+				suite.Require().Equal(plan, storedPlan)
+
+				bz, found := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradedClient(suite.chainA.GetContext(), plan.Height)
+				suite.Require().True(found)
+				clientState, err := clienttypes.UnmarshalClientState(suite.chainA.App.AppCodec(), bz)
+				suite.Require().NoError(err)
+				suite.Require().Equal(upgradedClientState, clientState)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // TestUpdateClientParams tests the UpdateClientParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateClientParams() {
 	validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -877,3 +1302,334 @@ func (suite *KeeperTestSuite) TestUpdateConnectionParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateChannelParams() {
+	validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name    string
+		msg     *channeltypes.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"success: valid authority and default params",
+			channeltypes.NewMsgUpdateChannelParams(validAuthority, channeltypes.DefaultParams()),
+			true,
+		},
+		{
+			"failure: malformed authority address",
+			channeltypes.NewMsgUpdateChannelParams(ibctesting.InvalidID, channeltypes.DefaultParams()),
+			false,
+		},
+		{
+			"failure: empty authority address",
+			channeltypes.NewMsgUpdateChannelParams("", channeltypes.DefaultParams()),
+			false,
+		},
+		{
+			"failure: unauthorized authority address",
+			channeltypes.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, channeltypes.DefaultParams()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := keeper.Keeper.UpdateChannelParams(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), tc.msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				p := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				suite.Require().Equal(tc.msg.Params, p)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelUpgradeInit() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelUpgradeInit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			false,
+		},
+		{
+			"invalid proposed version",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+					return "", ibcmock.MockApplicationCallbackError
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+			msg = channeltypes.NewMsgChannelUpgradeInit(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointA.GetProposedUpgrade().Fields,
+				suite.chainA.App.GetIBCKeeper().GetAuthority(),
+			)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.ChannelUpgradeInit(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+				suite.Require().Equal(ibcmock.UpgradeVersion, res.Upgrade.Fields.Version)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelUpgradeTry() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func(res *channeltypes.MsgChannelUpgradeTryResponse, err error)
+	}{
+		{
+			"success",
+			func() {},
+			func(res *channeltypes.MsgChannelUpgradeTryResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+				channel := path.EndpointB.GetChannel()
+				suite.Require().Equal(channeltypes.FLUSHING, channel.State)
+				suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+			},
+		},
+		{
+			"application callback returns error and error receipt is written",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeTry = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, counterpartyVersion string) (string, error) {
+					return "", ibcmock.MockApplicationCallbackError
+				}
+			},
+			func(res *channeltypes.MsgChannelUpgradeTryResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FAILURE, res.Result)
+
+				channel := path.EndpointB.GetChannel()
+				suite.Require().Equal(channeltypes.OPEN, channel.State)
+
+				errorReceipt, found := suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channel.UpgradeSequence, errorReceipt.Sequence)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			tc.malleate()
+
+			counterpartyUpgrade, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			proofChannel, proofUpgrade, proofHeight := path.EndpointB.QueryChannelUpgradeProof()
+
+			msg := channeltypes.NewMsgChannelUpgradeTry(
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				path.EndpointB.GetProposedUpgrade().Fields.ConnectionHops,
+				counterpartyUpgrade.Fields,
+				path.EndpointA.GetChannel().UpgradeSequence,
+				proofChannel,
+				proofUpgrade,
+				proofHeight,
+				suite.chainB.SenderAccount.GetAddress().String(),
+			)
+
+			res, err := keeper.Keeper.ChannelUpgradeTry(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+
+			tc.expResult(res, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelUpgradeCancel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelUpgradeCancel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: authority cancels the upgrade without an error receipt proof",
+			func() {},
+			true,
+		},
+		{
+			"failure: non-authority signer without an error receipt proof",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+			msg = channeltypes.NewMsgChannelUpgradeCancel(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				channeltypes.ErrorReceipt{Sequence: path.EndpointA.GetChannel().UpgradeSequence},
+				nil,
+				clienttypes.ZeroHeight(),
+				suite.chainA.App.GetIBCKeeper().GetAuthority(),
+			)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.ChannelUpgradeCancel(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(channeltypes.OPEN, channel.State)
+				suite.Require().Equal(ibcmock.Version, channel.Version)
+
+				_, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgPruneAcknowledgements
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: any signer may prune",
+			func() {},
+			true,
+		},
+		{
+			"failure: channel has not been upgraded",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				msg.ChannelId = path.EndpointB.ChannelID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+			msg = channeltypes.NewMsgPruneAcknowledgements(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 10, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneAcknowledgements(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.TotalPrunedSequences)
+				suite.Require().Equal(uint64(0), res.TotalRemainingSequences)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), msg.PortId, msg.ChannelId, sequence)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectionkeeper "github.com/cosmos/ibc-go/v7/modules/core/03-connection/keeper"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v7/modules/core/04-channel"
+	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/client/cli"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -114,7 +116,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants registers the ibc module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO:
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers module services.
@@ -149,6 +151,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(err)
 	}
+
+	channelMigrator := channelkeeper.NewMigrator(am.keeper.ChannelKeeper)
+	if err := cfg.RegisterMigration(exported.ModuleName, 5, channelMigrator.MigrateParams); err != nil {
+		panic(err)
+	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 6, clientMigrator.Migrate6to7); err != nil {
+		panic(err)
+	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 7, clientMigrator.Migrate7to8); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -170,7 +185,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 5 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -180,6 +195,8 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 // EndBlock returns the end blocker for the ibc module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	ibcclient.EndBlocker(ctx, am.keeper.ClientKeeper)
+	ibcchannel.EndBlocker(ctx, am.keeper.ChannelKeeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,12 @@
+/*
+Package simulation implements the store decoders, randomized genesis and weighted operations
+of the ibc core module for the simulations of an app.
+
+A simulation runs a single app, so the operations cannot relay between separate chains like
+the in-process chains of the testing package. Instead, clients are created and connections
+are opened against solo machines, which are controlled by the simulation accounts. Channels
+are opened and packets are relayed over the 09-localhost connection of the simulated chain.
+The operations of the ibc apps build on the same localhost channels. The testing package
+chains are only used by the tests of the operations.
+*/
+package simulation
@@ -0,0 +1,671 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v7/modules/light-clients/09-localhost"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateClient          = "op_weight_msg_create_client"           //nolint:gosec
+	OpWeightMsgUpdateClient          = "op_weight_msg_update_client"           //nolint:gosec
+	OpWeightMsgConnectionOpenInit    = "op_weight_msg_connection_open_init"    //nolint:gosec
+	OpWeightMsgConnectionOpenTry     = "op_weight_msg_connection_open_try"     //nolint:gosec
+	OpWeightMsgConnectionOpenAck     = "op_weight_msg_connection_open_ack"     //nolint:gosec
+	OpWeightMsgConnectionOpenConfirm = "op_weight_msg_connection_open_confirm" //nolint:gosec
+	OpWeightMsgChannelOpenTry        = "op_weight_msg_channel_open_try"        //nolint:gosec
+	OpWeightMsgChannelOpenAck        = "op_weight_msg_channel_open_ack"        //nolint:gosec
+	OpWeightMsgChannelOpenConfirm    = "op_weight_msg_channel_open_confirm"    //nolint:gosec
+
+	DefaultWeightMsgCreateClient          = 10
+	DefaultWeightMsgUpdateClient          = 20
+	DefaultWeightMsgConnectionOpenInit    = 10
+	DefaultWeightMsgConnectionOpenTry     = 10
+	DefaultWeightMsgConnectionOpenAck     = 10
+	DefaultWeightMsgConnectionOpenConfirm = 10
+	DefaultWeightMsgChannelOpenTry        = 50
+	DefaultWeightMsgChannelOpenAck        = 50
+	DefaultWeightMsgChannelOpenConfirm    = 50
+)
+
+const (
+	// soloMachineClientID is the identifier of the client of the simulated chain stored by the solo machine
+	soloMachineClientID = "07-tendermint-0"
+	// soloMachineConnectionID is the identifier of the connection end stored by the solo machine
+	soloMachineConnectionID = "connection-0"
+	// maxClockDrift is the max clock drift of the client of the simulated chain stored by the solo machine
+	maxClockDrift = 10 * time.Second
+)
+
+// soloMachinePrefix is the commitment prefix of the solo machine
+var soloMachinePrefix = commitmenttypes.NewMerklePrefix([]byte(exported.StoreKey))
+
+// WeightedOperations returns all the ibc core operations with their respective weights. Client and connection
+// handshakes are performed against solo machines controlled by the simulation accounts, while channel handshakes
+// are completed over the 09-localhost connection of the simulated chain.
+func WeightedOperations(
+	appParams simtypes.AppParams, registry codectypes.InterfaceRegistry, k *keeper.Keeper,
+	ak simulation.AccountKeeper, bk simulation.BankKeeper, sk clienttypes.StakingKeeper,
+) simulation.WeightedOperations {
+	cdc := codec.NewProtoCodec(registry)
+
+	var (
+		weightMsgCreateClient          int
+		weightMsgUpdateClient          int
+		weightMsgConnectionOpenInit    int
+		weightMsgConnectionOpenTry     int
+		weightMsgConnectionOpenAck     int
+		weightMsgConnectionOpenConfirm int
+		weightMsgChannelOpenTry        int
+		weightMsgChannelOpenAck        int
+		weightMsgChannelOpenConfirm    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClient, &weightMsgCreateClient, nil,
+		func(_ *rand.Rand) { weightMsgCreateClient = DefaultWeightMsgCreateClient },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateClient, &weightMsgUpdateClient, nil,
+		func(_ *rand.Rand) { weightMsgUpdateClient = DefaultWeightMsgUpdateClient },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenInit, &weightMsgConnectionOpenInit, nil,
+		func(_ *rand.Rand) { weightMsgConnectionOpenInit = DefaultWeightMsgConnectionOpenInit },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenTry, &weightMsgConnectionOpenTry, nil,
+		func(_ *rand.Rand) { weightMsgConnectionOpenTry = DefaultWeightMsgConnectionOpenTry },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenAck, &weightMsgConnectionOpenAck, nil,
+		func(_ *rand.Rand) { weightMsgConnectionOpenAck = DefaultWeightMsgConnectionOpenAck },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConnectionOpenConfirm, &weightMsgConnectionOpenConfirm, nil,
+		func(_ *rand.Rand) { weightMsgConnectionOpenConfirm = DefaultWeightMsgConnectionOpenConfirm },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenTry, &weightMsgChannelOpenTry, nil,
+		func(_ *rand.Rand) { weightMsgChannelOpenTry = DefaultWeightMsgChannelOpenTry },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenAck, &weightMsgChannelOpenAck, nil,
+		func(_ *rand.Rand) { weightMsgChannelOpenAck = DefaultWeightMsgChannelOpenAck },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgChannelOpenConfirm, &weightMsgChannelOpenConfirm, nil,
+		func(_ *rand.Rand) { weightMsgChannelOpenConfirm = DefaultWeightMsgChannelOpenConfirm },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateClient, SimulateMsgCreateClient(cdc, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgUpdateClient, SimulateMsgUpdateClient(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgConnectionOpenInit, SimulateMsgConnectionOpenInit(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgConnectionOpenTry, SimulateMsgConnectionOpenTry(cdc, k, ak, bk, sk)),
+		simulation.NewWeightedOperation(weightMsgConnectionOpenAck, SimulateMsgConnectionOpenAck(cdc, k, ak, bk, sk)),
+		simulation.NewWeightedOperation(weightMsgConnectionOpenConfirm, SimulateMsgConnectionOpenConfirm(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgChannelOpenTry, SimulateMsgChannelOpenTry(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgChannelOpenAck, SimulateMsgChannelOpenAck(cdc, k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgChannelOpenConfirm, SimulateMsgChannelOpenConfirm(cdc, k, ak, bk)),
+	}
+}
+
+// SimulateMsgCreateClient generates a MsgCreateClient creating a solo machine client whose public key
+// belongs to a random simulation account.
+func SimulateMsgCreateClient(cdc *codec.ProtoCodec, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		soloMachineAcc, _ := simtypes.RandomAcc(r, accs)
+		consensusState, err := newSoloMachineConsensusState(ctx, soloMachineAcc.PubKey, simtypes.RandStringOfLength(r, 10))
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&clienttypes.MsgCreateClient{}), "unable to create consensus state"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg, err := clienttypes.NewMsgCreateClient(solomachine.NewClientState(1, consensusState), consensusState, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&clienttypes.MsgCreateClient{}), "unable to create msg"), nil, err
+		}
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgUpdateClient generates a MsgUpdateClient for a random solo machine client, rotating its public key
+// to the key of another random simulation account.
+func SimulateMsgUpdateClient(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{})
+
+		clientID, soloMachine, found := randomSoloMachineClient(r, ctx, cdc, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no active solo machine client found"), nil, nil
+		}
+
+		newAcc, _ := simtypes.RandomAcc(r, accs)
+		header, err := soloMachine.header(newAcc.PubKey, simtypes.RandStringOfLength(r, 10))
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to create header"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg, err := clienttypes.NewMsgUpdateClient(clientID, header, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgConnectionOpenInit generates a MsgConnectionOpenInit on a random solo machine client.
+func SimulateMsgConnectionOpenInit(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		clientID, _, found := randomSoloMachineClient(r, ctx, cdc, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenInit{}), "no active solo machine client found"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenInit(
+			clientID, soloMachineClientID, soloMachinePrefix, nil, 0, simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgConnectionOpenTry generates a MsgConnectionOpenTry on a random solo machine client. The solo machine
+// proves that it initialised a connection end and stores a client of the simulated chain.
+func SimulateMsgConnectionOpenTry(
+	cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, sk clienttypes.StakingKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenTry{})
+
+		clientID, soloMachine, found := randomSoloMachineClient(r, ctx, cdc, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no active solo machine client found"), nil, nil
+		}
+
+		clientState, consensusState, err := selfClient(ctx, k, sk)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		versions := connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions())
+		counterparty := connectiontypes.NewCounterparty(clientID, "", commitmenttypes.NewMerklePrefix(k.ConnectionKeeper.GetCommitmentPrefix().Bytes()))
+		connection := connectiontypes.NewConnectionEnd(connectiontypes.INIT, soloMachineClientID, counterparty, versions, 0)
+
+		proofInit, proofClient, proofConsensus, err := connectionHandshakeProofs(cdc, soloMachine, connection, clientState, consensusState)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to generate proofs"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenTry(
+			clientID, soloMachineConnectionID, soloMachineClientID, clientState, soloMachinePrefix, versions, 0,
+			proofInit, proofClient, proofConsensus, clienttypes.ZeroHeight(), clientState.LatestHeight, simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgConnectionOpenAck generates a MsgConnectionOpenAck for a random connection in the INIT state on a solo
+// machine client. The solo machine proves that it stored the counterparty connection end in the TRYOPEN state.
+func SimulateMsgConnectionOpenAck(
+	cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, sk clienttypes.StakingKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenAck{})
+
+		connection, soloMachine, found := randomSoloMachineConnection(r, ctx, cdc, k, accs, connectiontypes.INIT)
+		if !found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no solo machine connection in INIT state found"), nil, nil
+		}
+
+		clientState, consensusState, err := selfClient(ctx, k, sk)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		version := connection.Versions[0]
+		counterparty := connectiontypes.NewCounterparty(connection.ClientId, connection.Id, commitmenttypes.NewMerklePrefix(k.ConnectionKeeper.GetCommitmentPrefix().Bytes()))
+		counterpartyConnection := connectiontypes.NewConnectionEnd(
+			connectiontypes.TRYOPEN, connection.Counterparty.ClientId, counterparty, []*connectiontypes.Version{version}, connection.DelayPeriod,
+		)
+
+		proofTry, proofClient, proofConsensus, err := connectionHandshakeProofs(cdc, soloMachine, counterpartyConnection, clientState, consensusState)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to generate proofs"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenAck(
+			connection.Id, soloMachineConnectionID, clientState, proofTry, proofClient, proofConsensus,
+			clienttypes.ZeroHeight(), clientState.LatestHeight, version, simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgConnectionOpenConfirm generates a MsgConnectionOpenConfirm for a random connection in the TRYOPEN state
+// on a solo machine client. The solo machine proves that it opened the counterparty connection end.
+func SimulateMsgConnectionOpenConfirm(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&connectiontypes.MsgConnectionOpenConfirm{})
+
+		connection, soloMachine, found := randomSoloMachineConnection(r, ctx, cdc, k, accs, connectiontypes.TRYOPEN)
+		if !found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no solo machine connection in TRYOPEN state found"), nil, nil
+		}
+
+		counterparty := connectiontypes.NewCounterparty(connection.ClientId, connection.Id, commitmenttypes.NewMerklePrefix(k.ConnectionKeeper.GetCommitmentPrefix().Bytes()))
+		counterpartyConnection := connectiontypes.NewConnectionEnd(
+			connectiontypes.OPEN, connection.Counterparty.ClientId, counterparty, connection.Versions, connection.DelayPeriod,
+		)
+
+		proofAck, err := connectionProof(cdc, soloMachine, connection.Counterparty.ConnectionId, counterpartyConnection)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to generate proof"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := connectiontypes.NewMsgConnectionOpenConfirm(connection.Id, proofAck, clienttypes.ZeroHeight(), simAccount.Address.String())
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgChannelOpenTry generates a MsgChannelOpenTry for a random channel in the INIT state on the localhost
+// connection which has no counterparty channel yet.
+func SimulateMsgChannelOpenTry(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var channels []channeltypes.IdentifiedChannel
+		localhostChannels := GetLocalhostChannels(ctx, k)
+		for _, channel := range localhostChannels {
+			if channel.State != channeltypes.INIT {
+				continue
+			}
+
+			if _, found := getCounterpartyChannel(localhostChannels, channel); !found {
+				channels = append(channels, channel)
+			}
+		}
+
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenTry{}), "no localhost channel in INIT state found"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenTry(
+			channel.Counterparty.PortId, channel.Version, channel.Ordering, channel.ConnectionHops,
+			channel.PortId, channel.ChannelId, channel.Version, localhost.SentinelProof, clienttypes.GetSelfHeight(ctx),
+			simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgChannelOpenAck generates a MsgChannelOpenAck for a random channel in the INIT state on the localhost
+// connection whose counterparty channel is in the TRYOPEN state.
+func SimulateMsgChannelOpenAck(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var channels, counterpartyChannels []channeltypes.IdentifiedChannel
+		localhostChannels := GetLocalhostChannels(ctx, k)
+		for _, channel := range localhostChannels {
+			if channel.State != channeltypes.INIT {
+				continue
+			}
+
+			if counterpartyChannel, found := getCounterpartyChannel(localhostChannels, channel); found && counterpartyChannel.State == channeltypes.TRYOPEN {
+				channels = append(channels, channel)
+				counterpartyChannels = append(counterpartyChannels, counterpartyChannel)
+			}
+		}
+
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenAck{}), "no localhost channel awaiting ack found"), nil, nil
+		}
+
+		i := r.Intn(len(channels))
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenAck(
+			channels[i].PortId, channels[i].ChannelId, counterpartyChannels[i].ChannelId, counterpartyChannels[i].Version,
+			localhost.SentinelProof, clienttypes.GetSelfHeight(ctx), simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgChannelOpenConfirm generates a MsgChannelOpenConfirm for a random channel in the TRYOPEN state on the
+// localhost connection whose counterparty channel is open.
+func SimulateMsgChannelOpenConfirm(cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var channels []channeltypes.IdentifiedChannel
+		for _, channel := range GetLocalhostChannels(ctx, k) {
+			if channel.State != channeltypes.TRYOPEN {
+				continue
+			}
+
+			counterpartyChannel, found := k.ChannelKeeper.GetChannel(ctx, channel.Counterparty.PortId, channel.Counterparty.ChannelId)
+			if found && counterpartyChannel.State == channeltypes.OPEN {
+				channels = append(channels, channel)
+			}
+		}
+
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&channeltypes.MsgChannelOpenConfirm{}), "no localhost channel awaiting confirm found"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgChannelOpenConfirm(
+			channel.PortId, channel.ChannelId, localhost.SentinelProof, clienttypes.GetSelfHeight(ctx), simAccount.Address.String(),
+		)
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// SimulateMsgRecvPacket returns an operation which generates a MsgRecvPacket relaying the provided packet over
+// the localhost connection. Applications schedule it as a future operation for the packets they send. If the
+// packet is acknowledged synchronously, the acknowledgement is relayed back in a future operation.
+func SimulateMsgRecvPacket(
+	cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, packet channeltypes.Packet,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})
+
+		if !k.ChannelKeeper.HasPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence) {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "packet commitment not found"), nil, nil
+		}
+
+		if _, found := k.ChannelKeeper.GetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence); found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "packet already received"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, clienttypes.GetSelfHeight(ctx), simAccount.Address.String())
+
+		opMsg, res, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		if err != nil || res == nil {
+			return opMsg, nil, err
+		}
+
+		ack, found, err := ParseAckFromEvents(res.Events)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "unable to parse acknowledgement"), nil, err
+		}
+
+		if !found {
+			return opMsg, nil, nil
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+				Op:          SimulateMsgAcknowledgement(cdc, k, ak, bk, packet, ack),
+			},
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgAcknowledgement returns an operation which generates a MsgAcknowledgement relaying the acknowledgement
+// of the provided packet over the localhost connection.
+func SimulateMsgAcknowledgement(
+	cdc *codec.ProtoCodec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, packet channeltypes.Packet, ack []byte,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.ChannelKeeper.HasPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence) {
+			return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{}), "packet commitment not found"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgAcknowledgement(packet, ack, localhost.SentinelProof, clienttypes.GetSelfHeight(ctx), simAccount.Address.String())
+
+		opMsg, _, err := DeliverMsg(newOperationInput(r, app, ctx, cdc, msg, simAccount, ak, bk))
+		return opMsg, nil, err
+	}
+}
+
+// GetLocalhostChannels returns all channels on the localhost connection of the simulated chain.
+func GetLocalhostChannels(ctx sdk.Context, k *keeper.Keeper) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	k.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if len(channel.ConnectionHops) == 1 && channel.ConnectionHops[0] == exported.LocalhostConnectionID {
+			channels = append(channels, channel)
+		}
+		return false
+	})
+
+	return channels
+}
+
+// getCounterpartyChannel returns the channel which was opened in response to the provided channel, if any.
+func getCounterpartyChannel(channels []channeltypes.IdentifiedChannel, channel channeltypes.IdentifiedChannel) (channeltypes.IdentifiedChannel, bool) {
+	for _, counterpartyChannel := range channels {
+		if counterpartyChannel.Counterparty.PortId == channel.PortId && counterpartyChannel.Counterparty.ChannelId == channel.ChannelId {
+			return counterpartyChannel, true
+		}
+	}
+
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// randomSoloMachineClient returns a random active solo machine client whose public key belongs to one of the
+// simulation accounts.
+func randomSoloMachineClient(
+	r *rand.Rand, ctx sdk.Context, cdc codec.BinaryCodec, k *keeper.Keeper, accs []simtypes.Account,
+) (string, *soloMachine, bool) {
+	var (
+		clientIDs    []string
+		soloMachines []*soloMachine
+	)
+
+	k.ClientKeeper.IterateClientStates(ctx, []byte(exported.Solomachine), func(clientID string, clientState exported.ClientState) bool {
+		smClientState, ok := clientState.(*solomachine.ClientState)
+		if !ok || k.ClientKeeper.GetClientStatus(ctx, clientState, clientID) != exported.Active {
+			return false
+		}
+
+		if soloMachine, found := newSoloMachine(ctx, cdc, smClientState, accs); found {
+			clientIDs = append(clientIDs, clientID)
+			soloMachines = append(soloMachines, soloMachine)
+		}
+		return false
+	})
+
+	if len(clientIDs) == 0 {
+		return "", nil, false
+	}
+
+	i := r.Intn(len(clientIDs))
+	return clientIDs[i], soloMachines[i], true
+}
+
+// randomSoloMachineConnection returns a random connection in the provided state on an active solo machine client
+// whose public key belongs to one of the simulation accounts.
+func randomSoloMachineConnection(
+	r *rand.Rand, ctx sdk.Context, cdc codec.BinaryCodec, k *keeper.Keeper, accs []simtypes.Account, state connectiontypes.State,
+) (connectiontypes.IdentifiedConnection, *soloMachine, bool) {
+	var connections []connectiontypes.IdentifiedConnection
+	k.ConnectionKeeper.IterateConnections(ctx, func(connection connectiontypes.IdentifiedConnection) bool {
+		if connection.State == state {
+			connections = append(connections, connection)
+		}
+		return false
+	})
+
+	r.Shuffle(len(connections), func(i, j int) {
+		connections[i], connections[j] = connections[j], connections[i]
+	})
+
+	for _, connection := range connections {
+		clientState, found := k.ClientKeeper.GetClientState(ctx, connection.ClientId)
+		if !found {
+			continue
+		}
+
+		smClientState, ok := clientState.(*solomachine.ClientState)
+		if !ok || k.ClientKeeper.GetClientStatus(ctx, clientState, connection.ClientId) != exported.Active {
+			continue
+		}
+
+		if soloMachine, found := newSoloMachine(ctx, cdc, smClientState, accs); found {
+			return connection, soloMachine, true
+		}
+	}
+
+	return connectiontypes.IdentifiedConnection{}, nil, false
+}
+
+// selfClient returns the client state and consensus state of the simulated chain at the previous block height,
+// as stored by the solo machine.
+func selfClient(ctx sdk.Context, k *keeper.Keeper, sk clienttypes.StakingKeeper) (*ibctm.ClientState, exported.ConsensusState, error) {
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if selfHeight.RevisionHeight <= 1 {
+		return nil, nil, clienttypes.ErrInvalidHeight
+	}
+
+	height := clienttypes.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight-1)
+	unbondingPeriod := sk.UnbondingTime(ctx)
+	clientState := ibctm.NewClientState(
+		ctx.ChainID(), ibctm.DefaultTrustLevel, unbondingPeriod*2/3, unbondingPeriod, maxClockDrift, height,
+		commitmenttypes.GetSDKSpecs(), []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState},
+	)
+
+	if err := k.ClientKeeper.ValidateSelfClient(ctx, clientState); err != nil {
+		return nil, nil, err
+	}
+
+	consensusState, err := k.ClientKeeper.GetSelfConsensusState(ctx, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientState, consensusState, nil
+}
+
+// connectionHandshakeProofs returns the solo machine proofs of the connection end, the client state and the
+// consensus state of the simulated chain, in the order in which they are verified by the connection handshake.
+func connectionHandshakeProofs(
+	cdc codec.Codec, soloMachine *soloMachine, connection connectiontypes.ConnectionEnd,
+	clientState *ibctm.ClientState, consensusState exported.ConsensusState,
+) ([]byte, []byte, []byte, error) {
+	proofConnection, err := connectionProof(cdc, soloMachine, soloMachineConnectionID, connection)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clientStateBz, err := cdc.MarshalInterface(clientState)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proofClient, err := soloMachineProof(soloMachine, host.FullClientStatePath(soloMachineClientID), clientStateBz)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	consensusStateBz, err := cdc.MarshalInterface(consensusState)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proofConsensus, err := soloMachineProof(soloMachine, host.FullConsensusStatePath(soloMachineClientID, clientState.LatestHeight), consensusStateBz)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return proofConnection, proofClient, proofConsensus, nil
+}
+
+// connectionProof returns the solo machine proof of the connection end stored under the provided connection identifier.
+func connectionProof(cdc codec.Codec, soloMachine *soloMachine, connectionID string, connection connectiontypes.ConnectionEnd) ([]byte, error) {
+	bz, err := cdc.Marshal(&connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return soloMachineProof(soloMachine, host.ConnectionPath(connectionID), bz)
+}
+
+// soloMachineProof returns the solo machine proof of the value stored at the path under the solo machine prefix.
+func soloMachineProof(soloMachine *soloMachine, path string, value []byte) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(soloMachinePrefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return soloMachine.proof(merklePath, value)
+}
+
+// newOperationInput returns the operation input delivering the provided message signed by the simulation account.
+func newOperationInput(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec, msg sdk.Msg,
+	simAccount simtypes.Account, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:           cdc,
+		Msg:           msg,
+		MsgType:       sdk.MsgTypeURL(msg),
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    exported.ModuleName,
+	}
+}
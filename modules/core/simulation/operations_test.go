@@ -0,0 +1,145 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/core/simulation"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestSimulateClientAndConnectionOperations(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	coordinator.CommitNBlocks(chain, 2)
+
+	app := chain.GetSimApp()
+	cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+
+	runOperation(t, chain, simulation.SimulateMsgCreateClient(cdc, app.AccountKeeper, app.BankKeeper))
+
+	var clientID string
+	app.IBCKeeper.ClientKeeper.IterateClientStates(chain.GetContext(), []byte(exported.Solomachine), func(id string, _ exported.ClientState) bool {
+		clientID = id
+		return true
+	})
+	require.NotEmpty(t, clientID)
+
+	clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(chain.GetContext(), clientID)
+	require.True(t, found)
+	sequence := clientState.(*solomachine.ClientState).Sequence
+
+	runOperation(t, chain, simulation.SimulateMsgUpdateClient(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+
+	clientState, found = app.IBCKeeper.ClientKeeper.GetClientState(chain.GetContext(), clientID)
+	require.True(t, found)
+	require.Equal(t, sequence+1, clientState.(*solomachine.ClientState).Sequence)
+
+	// the connection initialised on the simulated chain is opened by the solo machine
+	runOperation(t, chain, simulation.SimulateMsgConnectionOpenInit(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Equal(t, []connectiontypes.State{connectiontypes.INIT}, connectionStates(chain))
+
+	runOperation(t, chain, simulation.SimulateMsgConnectionOpenAck(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper))
+	require.Equal(t, []connectiontypes.State{connectiontypes.OPEN}, connectionStates(chain))
+
+	// the connection initialised by the solo machine is opened on the simulated chain
+	runOperation(t, chain, simulation.SimulateMsgConnectionOpenTry(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper))
+	require.Equal(t, []connectiontypes.State{connectiontypes.OPEN, connectiontypes.TRYOPEN}, connectionStates(chain))
+
+	runOperation(t, chain, simulation.SimulateMsgConnectionOpenConfirm(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Equal(t, []connectiontypes.State{connectiontypes.OPEN, connectiontypes.OPEN}, connectionStates(chain))
+}
+
+func TestSimulateChannelAndPacketOperations(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	app := chain.GetSimApp()
+	cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+
+	msgChannelOpenInit := channeltypes.NewMsgChannelOpenInit(
+		ibctesting.TransferPort, transfertypes.Version, channeltypes.UNORDERED, []string{exported.LocalhostConnectionID},
+		ibctesting.TransferPort, chain.SenderAccount.GetAddress().String(),
+	)
+	_, err := chain.SendMsgs(msgChannelOpenInit)
+	require.NoError(t, err)
+	require.Equal(t, []channeltypes.State{channeltypes.INIT}, localhostChannelStates(chain))
+
+	runOperation(t, chain, simulation.SimulateMsgChannelOpenTry(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Equal(t, []channeltypes.State{channeltypes.INIT, channeltypes.TRYOPEN}, localhostChannelStates(chain))
+
+	runOperation(t, chain, simulation.SimulateMsgChannelOpenAck(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Equal(t, []channeltypes.State{channeltypes.OPEN, channeltypes.TRYOPEN}, localhostChannelStates(chain))
+
+	runOperation(t, chain, simulation.SimulateMsgChannelOpenConfirm(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper))
+	require.Equal(t, []channeltypes.State{channeltypes.OPEN, channeltypes.OPEN}, localhostChannelStates(chain))
+
+	coordinator.CommitBlock(chain)
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TestCoin,
+		chain.SenderAccount.GetAddress().String(), chain.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(), uint64(chain.GetContext().BlockTime().UnixNano())+transfertypes.DefaultRelativePacketTimeoutTimestamp, "",
+	)
+	res, err := chain.SendMsgs(msgTransfer)
+	require.NoError(t, err)
+
+	packet, err := simulation.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	// the synchronous acknowledgement of the received packet is relayed in a future operation
+	futureOps := runOperation(t, chain, simulation.SimulateMsgRecvPacket(cdc, app.IBCKeeper, app.AccountKeeper, app.BankKeeper, packet))
+	require.Len(t, futureOps, 1)
+
+	_, found := app.IBCKeeper.ChannelKeeper.GetPacketReceipt(chain.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.True(t, found)
+
+	runOperation(t, chain, futureOps[0].Op)
+	require.False(t, app.IBCKeeper.ChannelKeeper.HasPacketCommitment(chain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence))
+}
+
+// runOperation runs the simulation operation against the chain and requires the generated message to be delivered.
+func runOperation(t *testing.T, chain *ibctesting.TestChain, op simtypes.Operation) []simtypes.FutureOperation {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(1))
+	opMsg, futureOps, err := op(r, chain.App.GetBaseApp(), chain.GetContext(), chain.GetSimulationAccounts(), chain.ChainID)
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+
+	return futureOps
+}
+
+// connectionStates returns the states of the connections of the chain other than the localhost connection.
+func connectionStates(chain *ibctesting.TestChain) []connectiontypes.State {
+	var states []connectiontypes.State
+	chain.App.GetIBCKeeper().ConnectionKeeper.IterateConnections(chain.GetContext(), func(connection connectiontypes.IdentifiedConnection) bool {
+		if connection.Id != exported.LocalhostConnectionID {
+			states = append(states, connection.State)
+		}
+		return false
+	})
+
+	return states
+}
+
+// localhostChannelStates returns the states of the channels on the localhost connection of the chain.
+func localhostChannelStates(chain *ibctesting.TestChain) []channeltypes.State {
+	var states []channeltypes.State
+	for _, channel := range simulation.GetLocalhostChannels(chain.GetContext(), chain.App.GetIBCKeeper()) {
+		states = append(states, channel.State)
+	}
+
+	return states
+}
@@ -0,0 +1,137 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+)
+
+// soloMachine acts as the counterparty of a solo machine client. It signs headers and proofs with the
+// private key of the simulation account which is registered as the public key of the client.
+type soloMachine struct {
+	cdc         codec.BinaryCodec
+	account     simtypes.Account
+	sequence    uint64
+	timestamp   uint64
+	diversifier string
+}
+
+// newSoloMachine returns the solo machine for the provided client state. False is returned if the public
+// key of the client does not belong to any of the simulation accounts.
+func newSoloMachine(ctx sdk.Context, cdc codec.BinaryCodec, clientState *solomachine.ClientState, accs []simtypes.Account) (*soloMachine, bool) {
+	publicKey, err := clientState.ConsensusState.GetPubKey()
+	if err != nil {
+		return nil, false
+	}
+
+	for _, acc := range accs {
+		if !acc.PubKey.Equals(publicKey) {
+			continue
+		}
+
+		// signatures must not be older than the latest consensus state of the client
+		timestamp := uint64(ctx.BlockTime().UnixNano())
+		if timestamp < clientState.ConsensusState.Timestamp {
+			timestamp = clientState.ConsensusState.Timestamp
+		}
+
+		return &soloMachine{
+			cdc:         cdc,
+			account:     acc,
+			sequence:    clientState.Sequence,
+			timestamp:   timestamp,
+			diversifier: clientState.ConsensusState.Diversifier,
+		}, true
+	}
+
+	return nil, false
+}
+
+// newSoloMachineConsensusState returns a solo machine consensus state for the provided public key and diversifier.
+func newSoloMachineConsensusState(ctx sdk.Context, publicKey cryptotypes.PubKey, diversifier string) (*solomachine.ConsensusState, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &solomachine.ConsensusState{
+		PublicKey:   anyPubKey,
+		Diversifier: diversifier,
+		Timestamp:   uint64(ctx.BlockTime().UnixNano()),
+	}, nil
+}
+
+// header returns a header which rotates the public key and diversifier of the solo machine client.
+func (sm *soloMachine) header(newPublicKey cryptotypes.PubKey, newDiversifier string) (*solomachine.Header, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(newPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := sm.cdc.Marshal(&solomachine.HeaderData{
+		NewPubKey:      anyPubKey,
+		NewDiversifier: newDiversifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := sm.sign([]byte(solomachine.SentinelHeaderPath), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &solomachine.Header{
+		Timestamp:      sm.timestamp,
+		Signature:      signature,
+		NewPublicKey:   anyPubKey,
+		NewDiversifier: newDiversifier,
+	}, nil
+}
+
+// proof returns a proof that the value is stored at the provided path. Each proof is verified at the next
+// sequence of the solo machine client.
+func (sm *soloMachine) proof(path commitmenttypes.MerklePath, value []byte) ([]byte, error) {
+	signature, err := sm.sign([]byte(path.String()), value)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.sequence++
+
+	return sm.cdc.Marshal(&solomachine.TimestampedSignatureData{
+		SignatureData: signature,
+		Timestamp:     sm.timestamp,
+	})
+}
+
+// sign signs the sign bytes of the data at the provided path with the private key of the solo machine.
+func (sm *soloMachine) sign(path, data []byte) ([]byte, error) {
+	signBytes, err := sm.cdc.Marshal(&solomachine.SignBytes{
+		Sequence:    sm.sequence,
+		Timestamp:   sm.timestamp,
+		Diversifier: sm.diversifier,
+		Path:        path,
+		Data:        data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := sm.account.PrivKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sigData := signing.SignatureDataToProto(&signing.SingleSignatureData{
+		Signature: signature,
+	})
+
+	return sm.cdc.Marshal(sigData)
+}
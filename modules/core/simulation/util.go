@@ -0,0 +1,140 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// DeliverMsg delivers the message of the operation input in a transaction with random fees and returns the
+// result of its execution. The message is first executed against a cache of the current state: if it would
+// be rejected, for instance by the callbacks of the application owning a channel, a no-op is returned and
+// the result is nil.
+func DeliverMsg(txCtx simulation.OperationInput) (simtypes.OperationMsg, *sdk.Result, error) {
+	if err := checkMsg(txCtx.App, txCtx.Context, txCtx.Msg); err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, err.Error()), nil, nil
+	}
+
+	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
+	spendable := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, account.GetAddress())
+
+	coins, hasNeg := spendable.SafeSub(txCtx.CoinsSpentInMsg...)
+	if hasNeg {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "message doesn't leave room for fees"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(txCtx.R, txCtx.Context, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate fees"), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		txCtx.R,
+		txCtx.TxGen,
+		[]sdk.Msg{txCtx.Msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		txCtx.Context.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		txCtx.SimAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, res, err := txCtx.App.SimDeliver(txCtx.TxGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(txCtx.Msg, true, "", txCtx.Cdc), res, nil
+}
+
+// checkMsg executes the message with its registered handler against a cache of the current state.
+func checkMsg(app *baseapp.BaseApp, ctx sdk.Context, msg sdk.Msg) error {
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := handler(cacheCtx, msg)
+	return err
+}
+
+// ParsePacketFromEvents parses the events emitted by a delivered transaction and returns the first packet sent.
+func ParsePacketFromEvents(events []abci.Event) (channeltypes.Packet, error) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		var (
+			packet channeltypes.Packet
+			err    error
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeyDataHex:
+				packet.Data, err = hex.DecodeString(attr.Value)
+			case channeltypes.AttributeKeySequence:
+				packet.Sequence, err = strconv.ParseUint(attr.Value, 10, 64)
+			case channeltypes.AttributeKeySrcPort:
+				packet.SourcePort = attr.Value
+			case channeltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = attr.Value
+			case channeltypes.AttributeKeyDstPort:
+				packet.DestinationPort = attr.Value
+			case channeltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = attr.Value
+			case channeltypes.AttributeKeyTimeoutHeight:
+				packet.TimeoutHeight, err = clienttypes.ParseHeight(attr.Value)
+			case channeltypes.AttributeKeyTimeoutTimestamp:
+				packet.TimeoutTimestamp, err = strconv.ParseUint(attr.Value, 10, 64)
+			}
+
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
+		}
+
+		return packet, nil
+	}
+
+	return channeltypes.Packet{}, fmt.Errorf("%s event not found", channeltypes.EventTypeSendPacket)
+}
+
+// ParseAckFromEvents parses the events emitted by a delivered transaction and returns the first acknowledgement
+// written. False is returned if no acknowledgement was written.
+func ParseAckFromEvents(events []abci.Event) ([]byte, bool, error) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == channeltypes.AttributeKeyAckHex {
+				ack, err := hex.DecodeString(attr.Value)
+				if err != nil {
+					return nil, false, err
+				}
+
+				return ack, true, nil
+			}
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,62 @@
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func TestParsePacketAndAckFromEvents(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	coordinator.Setup(path)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin,
+		chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 100), 0, "",
+	)
+
+	res, err := chainA.SendMsgs(msg)
+	require.NoError(t, err)
+
+	packet, err := simulation.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	expPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.Equal(t, expPacket, packet)
+
+	// no acknowledgement is written when sending a packet
+	_, found, err := simulation.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, path.EndpointB.UpdateClient())
+	res, err = path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+
+	ack, found, err := simulation.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	expAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.Equal(t, expAck, ack)
+
+	// no packet is sent when receiving a packet
+	_, err = simulation.ParsePacketFromEvents(res.Events)
+	require.Error(t, err)
+}
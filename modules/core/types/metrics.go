@@ -9,4 +9,7 @@ const (
 	LabelTimeoutType        = "timeout_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
+	LabelMsgType            = "msg_type"
+	LabelChannel            = "channel"
+	LabelRelayer            = "relayer"
 )
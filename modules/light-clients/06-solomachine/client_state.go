@@ -241,6 +241,18 @@ func produceVerificationArgs(
 	return publicKey, sigData, timestamp, sequence, nil
 }
 
+// getClientState retrieves the client state from the client prefixed store.
+func getClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	clientState, ok := clientStateI.(*ClientState)
+	return clientState, ok
+}
+
 // sets the client state to the store
 func setClientState(store sdk.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
 	bz := clienttypes.MustMarshalClientState(cdc, clientState)
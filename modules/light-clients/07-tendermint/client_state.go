@@ -20,7 +20,10 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState         = (*ClientState)(nil)
+	_ exported.ExpiringClientState = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -107,8 +110,13 @@ func (cs ClientState) Status(
 // IsExpired returns whether or not the client has passed the trusting period since the last
 // update (in which case no headers are considered valid).
 func (cs ClientState) IsExpired(latestTimestamp, now time.Time) bool {
-	expirationTime := latestTimestamp.Add(cs.TrustingPeriod)
-	return !expirationTime.After(now)
+	return !cs.ExpiryTime(latestTimestamp).After(now)
+}
+
+// ExpiryTime implements the exported.ExpiringClientState interface. A consensus state expires once
+// the trusting period has passed since its timestamp.
+func (cs ClientState) ExpiryTime(consensusTimestamp time.Time) time.Time {
+	return consensusTimestamp.Add(cs.TrustingPeriod)
 }
 
 // Validate performs a basic validation of the client state fields.
@@ -287,6 +295,48 @@ func (cs ClientState) VerifyNonMembership(
 	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
 }
 
+// VerifyBatchMembership is a proof verification method which verifies a single batch proof of the existence of multiple values
+// stored under the provided CommitmentPrefix at the specified height. The items are keyed by their standardized path (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	prefix exported.Prefix,
+	items map[string][]byte,
+) error {
+	if cs.GetLatestHeight().LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	if prefix == nil || prefix.Empty() {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix can't be empty")
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof.BatchVerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), commitmenttypes.NewMerklePath(string(prefix.Bytes())), items)
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
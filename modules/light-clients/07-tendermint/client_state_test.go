@@ -689,3 +689,117 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyBatchMembership() {
+	var (
+		testingpath      *ibctesting.Path
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		proof            []byte
+		prefix           exported.Prefix
+		items            map[string][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful batch verification of packet commitments", func() {}, true,
+		},
+		{
+			"successful verification outside the delay period", func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+				delayBlockPeriod = 1
+
+				// commit a block and update the client past the delay period
+				suite.coordinator.IncrementTimeBy(2 * time.Second)
+				err := testingpath.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"client state latest height is less than proof height", func() {
+				proofHeight = clienttypes.NewHeight(1, testingpath.EndpointA.GetClientState().GetLatestHeight().GetRevisionHeight()+1)
+			}, false,
+		},
+		{
+			"empty prefix", func() {
+				prefix = commitmenttypes.NewMerklePrefix(nil)
+			}, false,
+		},
+		{
+			"empty items", func() {
+				items = map[string][]byte{}
+			}, false,
+		},
+		{
+			"invalid value", func() {
+				for key := range items {
+					items[key] = []byte("invalid value")
+					break
+				}
+			}, false,
+		},
+		{
+			"proof is invalid", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(testingpath)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+
+			// create default proof, prefix and items which pass
+			// may be overwritten by malleate()
+			var keys [][]byte
+			items = make(map[string][]byte)
+			for i := 0; i < 3; i++ {
+				sequence, err := testingpath.EndpointB.SendPacket(clienttypes.NewHeight(1, 100), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, testingpath.EndpointB.ChannelConfig.PortID, testingpath.EndpointB.ChannelID, testingpath.EndpointA.ChannelConfig.PortID, testingpath.EndpointA.ChannelID, clienttypes.NewHeight(1, 100), 0)
+				keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				items[host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())] = channeltypes.CommitPacket(suite.chainB.App.AppCodec(), packet)
+			}
+
+			prefix = suite.chainB.GetPrefix()
+			proof, proofHeight = suite.chainB.QueryBatchProof(keys)
+
+			tc.malleate() // make changes as necessary
+
+			clientState := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
+
+			err := clientState.VerifyBatchMembership(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, prefix, items,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC exported.LightClientModule interface for the tendermint light client.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new 07-tendermint LightClientModule.
+func NewLightClientModule(cdc codec.BinaryCodec) *LightClientModule {
+	return &LightClientModule{
+		cdc: cdc,
+	}
+}
+
+// RegisterStoreProvider is called by core IBC when a LightClientModule is added to the router.
+// It allows the LightClientModule to set a ClientStoreProvider which supplies isolated prefix client stores
+// to IBC light client instances.
+func (l *LightClientModule) RegisterStoreProvider(storeProvider exported.ClientStoreProvider) {
+	l.storeProvider = storeProvider
+}
+
+// Initialize checks that the initial consensus state is an 07-tendermint consensus state and
+// sets the client state, consensus state and associated metadata in the client store.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState) error {
+	tmClientState, ok := clientState.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, clientState)
+	}
+
+	return tmClientState.Initialize(ctx, l.cdc, l.storeProvider.ClientStore(ctx, clientID), consensusState)
+}
+
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// CheckForMisbehaviour checks if the clientMessage is evidence of misbehaviour.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.CheckForMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// UpdateStateOnMisbehaviour freezes the client after misbehaviour has been detected and verified.
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientState.UpdateStateOnMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// UpdateState updates the client and consensus states from the verified header and returns the updated consensus heights.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership verifies a merkle proof of the existence of the value at the provided path at the specified height.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership verifies a merkle proof of the absence of the provided path at the specified height.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// VerifyBatchMembership verifies a single merkle proof of the existence of the provided values at the specified height.
+func (l LightClientModule) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	prefix exported.Prefix,
+	items map[string][]byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyBatchMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, prefix, items)
+}
+
+// PruneExpiredConsensusStates prunes at most limit expired consensus states of the tendermint client, or all
+// expired consensus states if the limit is zero.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.PruneExpiredConsensusStates(ctx, l.cdc, clientStore, limit), nil
+}
+
+// Status returns the status of the tendermint client. Unknown is returned if the client state is not found.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	return clientState.Status(ctx, clientStore, l.cdc)
+}
+
+// LatestHeight returns the latest height of the tendermint client. A zero value height is returned
+// if the client state is not found.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clientState.LatestHeight
+}
+
+// TimestampAtHeight returns the timestamp of the consensus state at the given height.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.GetTimestampAtHeight(ctx, clientStore, l.cdc, height)
+}
+
+// RecoverClient verifies that the substitute client may be used to update the subject client and copies the
+// latest consensus state of the substitute to the subject client store.
+func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
+	bz := substituteClientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
+	}
+
+	substituteClient := clienttypes.MustUnmarshalClientState(l.cdc, bz)
+
+	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
+}
+
+// VerifyUpgradeAndUpdateState verifies the upgraded client and consensus states committed to by the counterparty
+// and sets them in the client store.
+func (l LightClientModule) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context,
+	clientID string,
+	newClient exported.ClientState,
+	newConsState exported.ConsensusState,
+	proofUpgradeClient,
+	proofUpgradeConsState []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyUpgradeAndUpdateState(ctx, l.cdc, clientStore, newClient, newConsState, proofUpgradeClient, proofUpgradeConsState)
+}
@@ -0,0 +1,101 @@
+package tendermint_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+func (suite *TendermintTestSuite) TestLightClientModuleStatus() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			exported.Frozen,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			clientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(clientID)
+			suite.Require().True(found)
+
+			suite.Require().Equal(tc.expStatus, clientModule.Status(suite.chainA.GetContext(), clientID))
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestLightClientModuleLatestHeight() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	latestHeight := clientModule.LatestHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().Equal(path.EndpointA.GetClientState().GetLatestHeight(), latestHeight)
+
+	latestHeight = clientModule.LatestHeight(suite.chainA.GetContext(), clienttypes.FormatClientIdentifier(exported.Tendermint, 100))
+	suite.Require().True(latestHeight.IsZero())
+}
+
+func (suite *TendermintTestSuite) TestLightClientModulePruneExpiredConsensusStates() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	clientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	pruned, err := clientModule.PruneExpiredConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 0)
+	suite.Require().NoError(err)
+	suite.Require().Zero(pruned)
+
+	// both consensus states expire, but only one is pruned within the limit
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	pruned, err = clientModule.PruneExpiredConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+
+	_, err = clientModule.PruneExpiredConsensusStates(suite.chainA.GetContext(), clienttypes.FormatClientIdentifier(exported.Tendermint, 100), 0)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+}
@@ -42,6 +42,18 @@ var (
 	KeyIteration = []byte("/iterationKey")
 )
 
+// getClientState retrieves the client state from the client prefixed store.
+func getClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	clientState, ok := clientStateI.(*ClientState)
+	return clientState, ok
+}
+
 // setClientState stores the client state
 func setClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec, clientState *ClientState) {
 	key := host.ClientStateKey()
@@ -277,6 +289,17 @@ func GetPreviousConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, h
 func PruneAllExpiredConsensusStates(
 	ctx sdk.Context, clientStore sdk.KVStore,
 	cdc codec.BinaryCodec, clientState *ClientState,
+) int {
+	return pruneExpiredConsensusStates(ctx, clientStore, cdc, clientState, 0)
+}
+
+// pruneExpiredConsensusStates deletes at most limit expired consensus states and their metadata
+// in ascending height order, or all expired consensus states if the limit is zero. As consensus
+// state timestamps increase with height, a bounded prune stops at the first consensus state which
+// is not expired instead of iterating over the remaining consensus states.
+func pruneExpiredConsensusStates(
+	ctx sdk.Context, clientStore sdk.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit int,
 ) int {
 	var heights []exported.Height
 
@@ -286,11 +309,13 @@ func PruneAllExpiredConsensusStates(
 			return true
 		}
 
-		if clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
-			heights = append(heights, height)
+		if !clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+			return limit > 0
 		}
 
-		return false
+		heights = append(heights, height)
+
+		return limit > 0 && len(heights) >= limit
 	}
 
 	IterateConsensusStateAscending(clientStore, pruneCb)
@@ -196,6 +196,16 @@ func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.Binar
 	}
 }
 
+// PruneExpiredConsensusStates prunes at most limit expired consensus states along with their metadata, or all
+// expired consensus states if the limit is zero. The number of pruned consensus states is returned.
+func (cs ClientState) PruneExpiredConsensusStates(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, limit uint64) uint64 {
+	if limit == 0 {
+		return uint64(PruneAllExpiredConsensusStates(ctx, clientStore, cdc, &cs))
+	}
+
+	return uint64(pruneExpiredConsensusStates(ctx, clientStore, cdc, &cs, int(limit)))
+}
+
 // UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
 // as it does not perform any misbehaviour checks.
 func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, _ exported.ClientMessage) {
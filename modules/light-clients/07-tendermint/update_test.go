@@ -602,6 +602,61 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
 }
 
+func (suite *TendermintTestSuite) TestPruneExpiredConsensusStates() {
+	testCases := []struct {
+		name      string
+		expire    bool
+		limit     uint64
+		expPruned uint64
+	}{
+		{"all expired consensus states pruned with zero limit", true, 0, 3},
+		{"expired consensus states pruned up to limit", true, 2, 2},
+		{"all expired consensus states pruned within limit", true, 10, 3},
+		{"no consensus states expired", false, 10, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			if tc.expire {
+				suite.coordinator.IncrementTimeBy(path.EndpointA.ClientConfig.(*ibctesting.TendermintConfig).TrustingPeriod + time.Hour)
+			}
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			var heights []exported.Height
+			ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+				heights = append(heights, height)
+				return false
+			})
+			suite.Require().Len(heights, 3)
+
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			pruned := clientState.PruneExpiredConsensusStates(ctx, suite.chainA.App.AppCodec(), clientStore, tc.limit)
+			suite.Require().Equal(tc.expPruned, pruned)
+
+			// consensus states are pruned in ascending height order
+			for i, height := range heights {
+				_, found := ibctm.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), height)
+				suite.Require().Equal(uint64(i) >= tc.expPruned, found, "height %s", height)
+
+				_, found = ibctm.GetProcessedTime(clientStore, height)
+				suite.Require().Equal(uint64(i) >= tc.expPruned, found, "height %s", height)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 	var (
 		path          *ibctesting.Path
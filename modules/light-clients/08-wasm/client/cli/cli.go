@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the 08-wasm light client
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-wasm",
+		Short:                      "IBC wasm manager module query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		getCmdCode(),
+		getCmdChecksums(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the 08-wasm light client
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-wasm",
+		Short:                      "IBC wasm manager module transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		newSubmitStoreCodeProposalCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// getCmdCode defines the command to query wasm code for given checksum.
+func getCmdCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code [checksum]",
+		Short:   "Query wasm code",
+		Long:    "Query wasm code for a light client wasm contract with a given checksum",
+		Example: fmt.Sprintf("%s query %s wasm code [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			checksum := args[0]
+			req := types.QueryCodeRequest{
+				Checksum: checksum,
+			}
+
+			res, err := queryClient.Code(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdChecksums defines the command to query all wasm checksums.
+func getCmdChecksums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksums",
+		Short:   "Query all checksums",
+		Long:    "Query all checksums for deployed light client wasm contracts",
+		Example: fmt.Sprintf("%s query %s wasm checksums", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryChecksumsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Checksums(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "checksums")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// newSubmitStoreCodeProposalCmd returns the command to send the proposal to store wasm bytecode.
+func newSubmitStoreCodeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "store-code [path/to/wasm-file]",
+		Short:   "Submit a proposal to store wasm code",
+		Long:    "Submit a governance proposal to store wasm byte code of a light client contract. The wasm file may be gzip compressed.",
+		Example: fmt.Sprintf("%s tx %s wasm store-code [path/to/wasm_file] --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			code, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgStoreCode(authority, code)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "summary of proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,8 @@
+/*
+Package wasm implements a concrete ClientState, ConsensusState and ClientMessage
+for a light client hosted as a Wasm contract. It allows new light clients to be
+added to a chain by storing their contract byte code through governance instead
+of requiring a chain upgrade. This implementation is based off the ICS 08
+specification (https://github.com/cosmos/ibc/tree/main/spec/client/ics-008-wasm-client)
+*/
+package wasm
@@ -0,0 +1,43 @@
+package ibcwasm
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Checksum is the sha256 hash of the Wasm byte code of a light client contract.
+type Checksum = []byte
+
+// BlockInfo defines the block information passed to a light client contract.
+type BlockInfo struct {
+	// Height is the current block height.
+	Height uint64 `json:"height"`
+	// Time is the current block time in nanoseconds since the unix epoch.
+	Time uint64 `json:"time"`
+	// ChainID is the identifier of the host chain.
+	ChainID string `json:"chain_id"`
+}
+
+// Env defines the environment passed to every light client contract call.
+type Env struct {
+	Block BlockInfo `json:"block"`
+}
+
+// WasmEngine defines the interface of the virtual machine executing light client contracts.
+// All contract calls receive the isolated client store of the light client as their storage and
+// return the number of gas units used, which is consumed from the transaction gas meter.
+type WasmEngine interface {
+	// StoreCode stores the provided Wasm byte code and returns its checksum.
+	StoreCode(code []byte) (Checksum, error)
+
+	// GetCode returns the Wasm byte code stored under the provided checksum.
+	GetCode(checksum Checksum) ([]byte, error)
+
+	// Instantiate calls the instantiate entry point of the contract.
+	Instantiate(checksum Checksum, env Env, initMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+
+	// Query calls the query entry point of the contract. The contract must not modify the store.
+	Query(checksum Checksum, env Env, queryMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+
+	// Sudo calls the sudo entry point of the contract.
+	Sudo(checksum Checksum, env Env, sudoMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+}
@@ -0,0 +1,40 @@
+package ibcwasm
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	vm WasmEngine
+
+	// storeKey is the key of the store holding the set of allowed checksums.
+	storeKey storetypes.StoreKey
+)
+
+// SetVM sets the wasm VM for the 08-wasm module.
+// It panics if the wasm VM is nil.
+func SetVM(wasmVM WasmEngine) {
+	if wasmVM == nil {
+		panic("wasm VM must be not nil")
+	}
+	vm = wasmVM
+}
+
+// GetVM returns the wasm VM for the 08-wasm module.
+func GetVM() WasmEngine {
+	return vm
+}
+
+// SetStoreKey sets the store key of the 08-wasm module.
+// It panics if the store key is nil.
+func SetStoreKey(key storetypes.StoreKey) {
+	if key == nil {
+		panic("store key must be not nil")
+	}
+	storeKey = key
+}
+
+// GetStoreKey returns the store key of the 08-wasm module.
+func GetStoreKey() storetypes.StoreKey {
+	return storeKey
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/internal/ibcwasm"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// InitGenesis initializes the 08-wasm module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	for _, contract := range gs.Contracts {
+		if _, err := k.storeWasmCode(ctx, contract.CodeBytes); err != nil {
+			return errorsmod.Wrapf(err, "failed to store contract")
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
+// for all contracts previously stored.
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	var contracts []types.Contract
+	for _, checksum := range k.GetAllChecksums(ctx) {
+		code, err := ibcwasm.GetVM().GetCode(checksum)
+		if err != nil {
+			panic(err)
+		}
+
+		contracts = append(contracts, types.Contract{
+			CodeBytes: code,
+		})
+	}
+
+	return types.GenesisState{
+		Contracts: contracts,
+	}
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryCode() {
+	checksum := suite.storeCode()
+
+	res, err := suite.queryClient.Code(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryCodeRequest{Checksum: hex.EncodeToString(checksum)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmtesting.Code, res.Data)
+
+	_, err = suite.queryClient.Code(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryCodeRequest{Checksum: "invalid hex"})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.Code(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryCodeRequest{Checksum: hex.EncodeToString(types.CreateChecksum([]byte("unknown")))})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryChecksums() {
+	res, err := suite.queryClient.Checksums(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryChecksumsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Checksums)
+
+	checksum := suite.storeCode()
+
+	res, err = suite.queryClient.Checksums(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryChecksumsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{hex.EncodeToString(checksum)}, res.Checksums)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	checksum := suite.storeCode()
+
+	genesisState := suite.chainA.GetSimApp().WasmClientKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(types.NewGenesisState([]types.Contract{{CodeBytes: wasmtesting.Code}}), &genesisState)
+
+	suite.SetupTest()
+
+	err := suite.chainA.GetSimApp().WasmClientKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.chainA.GetSimApp().WasmClientKeeper.HasChecksum(suite.chainA.GetContext(), checksum))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/internal/ibcwasm"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// Keeper defines the 08-wasm keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// authority is the address capable of storing and removing wasm code. Usually the gov module account.
+	authority string
+}
+
+// NewKeeperWithVM creates a new 08-wasm Keeper instance using the provided Wasm VM.
+// The VM is shared by all light clients of type 08-wasm on the chain.
+func NewKeeperWithVM(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	authority string,
+	vm types.WasmEngine,
+) Keeper {
+	if vm == nil {
+		panic("wasm VM must be not nil")
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic("authority must be non-empty")
+	}
+
+	ibcwasm.SetVM(vm)
+	ibcwasm.SetStoreKey(key)
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the 08-wasm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// storeWasmCode validates the provided wasm code, stores it in the VM and adds its checksum
+// to the set of allowed checksums. Gzip compressed code is uncompressed first.
+func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte) ([]byte, error) {
+	var err error
+	if types.IsGzip(code) {
+		code, err = types.Uncompress(code)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to store contract")
+		}
+	}
+
+	if err := types.ValidateWasmCode(code); err != nil {
+		return nil, errorsmod.Wrap(err, "wasm bytecode validation failed")
+	}
+
+	// Check to see if store already has checksum.
+	checksum := types.CreateChecksum(code)
+	if k.HasChecksum(ctx, checksum) {
+		return nil, types.ErrWasmCodeExists
+	}
+
+	// create the code in the vm
+	vmChecksum, err := ibcwasm.GetVM().StoreCode(code)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store contract")
+	}
+
+	// SANITY: We've checked our store, additional safety check to assert that the checksum returned by WasmVM equals checksum generated by us.
+	if !bytes.Equal(vmChecksum, checksum) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChecksum, "expected %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(vmChecksum))
+	}
+
+	k.SetChecksum(ctx, checksum)
+
+	return checksum, nil
+}
+
+// HasChecksum returns true if the given checksum has been stored.
+func (k Keeper) HasChecksum(ctx sdk.Context, checksum []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ChecksumKey(checksum))
+}
+
+// SetChecksum adds the given checksum to the set of allowed checksums.
+func (k Keeper) SetChecksum(ctx sdk.Context, checksum []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChecksumKey(checksum), []byte{byte(1)})
+}
+
+// DeleteChecksum removes the given checksum from the set of allowed checksums.
+func (k Keeper) DeleteChecksum(ctx sdk.Context, checksum []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChecksumKey(checksum))
+}
+
+// IterateChecksums iterates over all stored checksums and performs a callback function.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateChecksums(ctx sdk.Context, cb func(checksum []byte) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyChecksumPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// GetAllChecksums returns all stored checksums.
+func (k Keeper) GetAllChecksums(ctx sdk.Context) [][]byte {
+	var checksums [][]byte
+	k.IterateChecksums(ctx, func(checksum []byte) bool {
+		checksums = append(checksums, checksum)
+		return false
+	})
+
+	return checksums
+}
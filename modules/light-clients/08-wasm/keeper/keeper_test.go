@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	queryClient types.QueryClient
+	authority   string
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().WasmClientKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// storeCode stores the test light client contract and returns its checksum.
+func (suite *KeeperTestSuite) storeCode() []byte {
+	msg := types.NewMsgStoreCode(suite.authority, wasmtesting.Code)
+	res, err := suite.chainA.GetSimApp().WasmClientKeeper.StoreCode(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	return res.Checksum
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// StoreCode defines a rpc handler method for MsgStoreCode
+func (k Keeper) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	checksum, err := k.storeWasmCode(ctx, msg.WasmByteCode)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store wasm bytecode")
+	}
+
+	emitStoreWasmCodeEvent(ctx, checksum)
+
+	return &types.MsgStoreCodeResponse{
+		Checksum: checksum,
+	}, nil
+}
+
+// RemoveChecksum defines a rpc handler method for MsgRemoveChecksum. Existing clients using the
+// removed checksum continue to operate, but new clients can no longer be created with it.
+func (k Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveChecksum) (*types.MsgRemoveChecksumResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasChecksum(ctx, msg.Checksum) {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	k.DeleteChecksum(ctx, msg.Checksum)
+
+	return &types.MsgRemoveChecksumResponse{}, nil
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"bytes"
+	"compress/gzip"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgStoreCode() {
+	var msg *types.MsgStoreCode
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: gzip compressed code",
+			func() {
+				var buf bytes.Buffer
+				writer := gzip.NewWriter(&buf)
+				_, err := writer.Write(wasmtesting.Code)
+				suite.Require().NoError(err)
+				suite.Require().NoError(writer.Close())
+
+				msg.WasmByteCode = buf.Bytes()
+			},
+			nil,
+		},
+		{
+			"failure: code already stored",
+			func() {
+				suite.storeCode()
+			},
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: code is not wasm",
+			func() {
+				msg.WasmByteCode = []byte("not wasm")
+			},
+			types.ErrInvalidData,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgStoreCode(suite.authority, wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().WasmClientKeeper.StoreCode(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.CreateChecksum(wasmtesting.Code), res.Checksum)
+				suite.Require().True(suite.chainA.GetSimApp().WasmClientKeeper.HasChecksum(ctx, res.Checksum))
+
+				events := ctx.EventManager().Events()
+				suite.Require().NotEmpty(events)
+				suite.Require().Equal(types.EventTypeStoreWasmCode, events[0].Type)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
+	var msg *types.MsgRemoveChecksum
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				msg.Checksum = types.CreateChecksum([]byte("unknown"))
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			checksum := suite.storeCode()
+			msg = types.NewMsgRemoveChecksum(suite.authority, checksum)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().WasmClientKeeper.RemoveChecksum(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.GetSimApp().WasmClientKeeper.HasChecksum(ctx, checksum))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().True(suite.chainA.GetSimApp().WasmClientKeeper.HasChecksum(ctx, checksum))
+			}
+		})
+	}
+}
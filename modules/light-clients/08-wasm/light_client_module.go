@@ -0,0 +1,231 @@
+package wasm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC exported.LightClientModule interface for the 08-wasm light client.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new 08-wasm LightClientModule.
+func NewLightClientModule(cdc codec.BinaryCodec) *LightClientModule {
+	return &LightClientModule{
+		cdc: cdc,
+	}
+}
+
+// RegisterStoreProvider is called by core IBC when a LightClientModule is added to the router.
+// It allows the LightClientModule to set a ClientStoreProvider which supplies isolated prefix client stores
+// to IBC light client instances.
+func (l *LightClientModule) RegisterStoreProvider(storeProvider exported.ClientStoreProvider) {
+	l.storeProvider = storeProvider
+}
+
+// Initialize checks that the contract of the initial client state has been stored and calls the
+// contract to set the client state, consensus state and associated metadata in the client store.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState) error {
+	wasmClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &types.ClientState{}, clientState)
+	}
+
+	return wasmClientState.Initialize(ctx, l.cdc, l.storeProvider.ClientStore(ctx, clientID), consensusState)
+}
+
+// VerifyClientMessage calls the contract to verify the clientMessage.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// CheckForMisbehaviour calls the contract to check if the clientMessage is evidence of misbehaviour.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.CheckForMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// UpdateStateOnMisbehaviour calls the contract to update the client state after misbehaviour has been detected and verified.
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientState.UpdateStateOnMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// UpdateState calls the contract to update the client and consensus states and returns the updated consensus heights.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership calls the contract to verify a proof of the existence of the value at the provided path at the specified height.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership calls the contract to verify a proof of the absence of the provided path at the specified height.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// VerifyBatchMembership returns an error. The 08-wasm client does not support batch proof verification.
+func (l LightClientModule) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	prefix exported.Prefix,
+	items map[string][]byte,
+) error {
+	return errorsmod.Wrapf(clienttypes.ErrBatchVerificationNotSupported, "client type %s", exported.Wasm)
+}
+
+// PruneExpiredConsensusStates returns an error. The 08-wasm client does not support consensus state pruning.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStatePruningNotSupported, "client type %s", exported.Wasm)
+}
+
+// Status returns the status of the 08-wasm client returned by its contract. Unknown is returned if the client state is not found.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	return clientState.Status(ctx, clientStore, l.cdc)
+}
+
+// LatestHeight returns the latest height of the 08-wasm client. A zero value height is returned
+// if the client state is not found.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clientState.GetLatestHeight()
+}
+
+// TimestampAtHeight calls the contract to return the timestamp of the consensus state at the given height.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.GetTimestampAtHeight(ctx, clientStore, l.cdc, height)
+}
+
+// RecoverClient calls the contract to verify that the substitute client may be used to update the subject client
+// and to update the subject client store.
+func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
+	bz := substituteClientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
+	}
+
+	substituteClient := clienttypes.MustUnmarshalClientState(l.cdc, bz)
+
+	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
+}
+
+// VerifyUpgradeAndUpdateState calls the contract to verify the upgraded client and consensus states committed to by
+// the counterparty and to set them in the client store.
+func (l LightClientModule) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context,
+	clientID string,
+	newClient exported.ClientState,
+	newConsState exported.ConsensusState,
+	proofUpgradeClient,
+	proofUpgradeConsState []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyUpgradeAndUpdateState(ctx, l.cdc, clientStore, newClient, newConsState, proofUpgradeClient, proofUpgradeConsState)
+}
+
+// getClientState retrieves the 08-wasm client state from the client prefixed store.
+func getClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec) (*types.ClientState, bool) {
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientState, ok := clienttypes.MustUnmarshalClientState(cdc, bz).(*types.ClientState)
+	return clientState, ok
+}
@@ -0,0 +1,285 @@
+package testing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// Code is the byte code of the test light client contract. Any code accepted by the
+// MockWasmEngine is executed by the LightClientContract unless another contract is registered.
+var Code = []byte("\x00asm\x01\x00\x00\x0008-wasm test light client")
+
+// ClientStateData is the JSON encoded data of the test light client state.
+type ClientStateData struct {
+	Frozen bool `json:"frozen"`
+}
+
+// ConsensusStateData is the JSON encoded data of the test light client consensus state.
+type ConsensusStateData struct {
+	Timestamp uint64 `json:"timestamp"`
+	Root      []byte `json:"root"`
+}
+
+// Header is the JSON encoded data of a test light client message. A header conflicting with
+// an already stored consensus state is considered misbehaviour.
+type Header struct {
+	Height    clienttypes.Height `json:"height"`
+	Timestamp uint64             `json:"timestamp"`
+	Root      []byte             `json:"root"`
+}
+
+// MembershipProof returns the proof accepted by the test light client for the existence of value at path.
+func MembershipProof(root []byte, path commitmenttypes.MerklePath, value []byte) []byte {
+	hash := sha256.Sum256(append(append(append([]byte{}, root...), strings.Join(path.KeyPath, "/")...), value...))
+	return hash[:]
+}
+
+// NonMembershipProof returns the proof accepted by the test light client for the absence of a value at path.
+func NonMembershipProof(root []byte, path commitmenttypes.MerklePath) []byte {
+	hash := sha256.Sum256(append(append([]byte{}, root...), strings.Join(path.KeyPath, "/")...))
+	return hash[:]
+}
+
+var _ Contract = LightClientContract{}
+
+// LightClientContract is a tiny light client implementation used to test the 08-wasm light client.
+// It trusts every well formed header and accepts proofs computed by MembershipProof and NonMembershipProof.
+type LightClientContract struct{}
+
+var cdc = func() codec.BinaryCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}()
+
+// Instantiate implements Contract. It validates the initial client and consensus state data.
+func (LightClientContract) Instantiate(_ types.Env, initMsg []byte, _ storetypes.KVStore) ([]byte, error) {
+	var msg types.InstantiateMessage
+	if err := json.Unmarshal(initMsg, &msg); err != nil {
+		return nil, err
+	}
+
+	var clientStateData ClientStateData
+	if err := json.Unmarshal(msg.ClientState, &clientStateData); err != nil {
+		return nil, err
+	}
+
+	var consensusStateData ConsensusStateData
+	if err := json.Unmarshal(msg.ConsensusState, &consensusStateData); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Query implements Contract.
+func (LightClientContract) Query(_ types.Env, queryMsg []byte, store storetypes.KVStore) ([]byte, error) {
+	var msg types.QueryMsg
+	if err := json.Unmarshal(queryMsg, &msg); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case msg.Status != nil:
+		clientState, data, err := getClientState(store)
+		if err != nil {
+			return nil, err
+		}
+		status := exported.Active
+		if data.Frozen {
+			status = exported.Frozen
+		} else if _, _, err := getConsensusState(store, clientState.LatestHeight); err != nil {
+			status = exported.Expired
+		}
+		return json.Marshal(types.StatusResult{Status: status.String()})
+	case msg.ExportMetadata != nil:
+		return json.Marshal(types.ExportMetadataResult{})
+	case msg.TimestampAtHeight != nil:
+		_, data, err := getConsensusState(store, msg.TimestampAtHeight.Height)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(types.TimestampAtHeightResult{Timestamp: data.Timestamp})
+	case msg.VerifyClientMessage != nil:
+		header, err := decodeHeader(msg.VerifyClientMessage.ClientMessage)
+		if err != nil {
+			return nil, err
+		}
+		if header.Height.IsZero() || header.Timestamp == 0 {
+			return nil, errors.New("header height and timestamp must be non-zero")
+		}
+		return json.Marshal(types.EmptyResult{})
+	case msg.CheckForMisbehaviour != nil:
+		header, err := decodeHeader(msg.CheckForMisbehaviour.ClientMessage)
+		if err != nil {
+			return nil, err
+		}
+		_, data, err := getConsensusState(store, header.Height)
+		found := err == nil && !bytes.Equal(data.Root, header.Root)
+		return json.Marshal(types.CheckForMisbehaviourResult{FoundMisbehaviour: found})
+	default:
+		return nil, errors.New("unknown query message")
+	}
+}
+
+// Sudo implements Contract.
+func (LightClientContract) Sudo(_ types.Env, sudoMsg []byte, store storetypes.KVStore) ([]byte, error) {
+	var msg types.SudoMsg
+	if err := json.Unmarshal(sudoMsg, &msg); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case msg.UpdateState != nil:
+		header, err := decodeHeader(msg.UpdateState.ClientMessage)
+		if err != nil {
+			return nil, err
+		}
+		clientState, _, err := getClientState(store)
+		if err != nil {
+			return nil, err
+		}
+		consensusState := types.NewConsensusState(mustMarshalJSON(ConsensusStateData{Timestamp: header.Timestamp, Root: header.Root}))
+		store.Set(host.ConsensusStateKey(header.Height), clienttypes.MustMarshalConsensusState(cdc, consensusState))
+		if header.Height.GT(clientState.LatestHeight) {
+			clientState.LatestHeight = header.Height
+			store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, clientState))
+		}
+		return json.Marshal(types.UpdateStateResult{Heights: []clienttypes.Height{header.Height}})
+	case msg.UpdateStateOnMisbehaviour != nil:
+		clientState, _, err := getClientState(store)
+		if err != nil {
+			return nil, err
+		}
+		clientState.Data = mustMarshalJSON(ClientStateData{Frozen: true})
+		store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, clientState))
+		return json.Marshal(types.EmptyResult{})
+	case msg.VerifyMembership != nil:
+		_, data, err := getConsensusState(store, msg.VerifyMembership.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(msg.VerifyMembership.Proof, MembershipProof(data.Root, msg.VerifyMembership.Path, msg.VerifyMembership.Value)) {
+			return nil, errors.New("membership proof verification failed")
+		}
+		return json.Marshal(types.EmptyResult{})
+	case msg.VerifyNonMembership != nil:
+		_, data, err := getConsensusState(store, msg.VerifyNonMembership.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(msg.VerifyNonMembership.Proof, NonMembershipProof(data.Root, msg.VerifyNonMembership.Path)) {
+			return nil, errors.New("non-membership proof verification failed")
+		}
+		return json.Marshal(types.EmptyResult{})
+	case msg.VerifyUpgradeAndUpdateState != nil:
+		var (
+			clientState    types.ClientState
+			consensusState types.ConsensusState
+		)
+		if err := cdc.Unmarshal(msg.VerifyUpgradeAndUpdateState.UpgradeClientState, &clientState); err != nil {
+			return nil, err
+		}
+		if err := cdc.Unmarshal(msg.VerifyUpgradeAndUpdateState.UpgradeConsensusState, &consensusState); err != nil {
+			return nil, err
+		}
+		if len(msg.VerifyUpgradeAndUpdateState.ProofUpgradeClient) == 0 || len(msg.VerifyUpgradeAndUpdateState.ProofUpgradeConsensusState) == 0 {
+			return nil, errors.New("upgrade proofs must be non-empty")
+		}
+		store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &clientState))
+		store.Set(host.ConsensusStateKey(clientState.LatestHeight), clienttypes.MustMarshalConsensusState(cdc, &consensusState))
+		return json.Marshal(types.EmptyResult{})
+	case msg.MigrateClientStore != nil:
+		substituteClientState, _, err := getClientState(prefixedStore{store, types.SubstitutePrefix})
+		if err != nil {
+			return nil, err
+		}
+		consensusStateBz := store.Get(append(append([]byte{}, types.SubstitutePrefix...), host.ConsensusStateKey(substituteClientState.LatestHeight)...))
+		if consensusStateBz == nil {
+			return nil, errors.New("substitute consensus state not found")
+		}
+		store.Set(append(append([]byte{}, types.SubjectPrefix...), host.ClientStateKey()...), clienttypes.MustMarshalClientState(cdc, substituteClientState))
+		store.Set(append(append([]byte{}, types.SubjectPrefix...), host.ConsensusStateKey(substituteClientState.LatestHeight)...), consensusStateBz)
+		return json.Marshal(types.EmptyResult{})
+	default:
+		return nil, errors.New("unknown sudo message")
+	}
+}
+
+// prefixedStore is a minimal read-only view used to read the substitute client store.
+type prefixedStore struct {
+	storetypes.KVStore
+	prefix []byte
+}
+
+func (ps prefixedStore) Get(key []byte) []byte {
+	return ps.KVStore.Get(append(append([]byte{}, ps.prefix...), key...))
+}
+
+func getClientState(store storetypes.KVStore) (*types.ClientState, ClientStateData, error) {
+	var data ClientStateData
+
+	bz := store.Get(host.ClientStateKey())
+	if bz == nil {
+		return nil, data, errors.New("client state not found")
+	}
+
+	clientState, ok := clienttypes.MustUnmarshalClientState(cdc, bz).(*types.ClientState)
+	if !ok {
+		return nil, data, errors.New("invalid client state type")
+	}
+
+	if err := json.Unmarshal(clientState.Data, &data); err != nil {
+		return nil, data, err
+	}
+
+	return clientState, data, nil
+}
+
+func getConsensusState(store storetypes.KVStore, height exported.Height) (*types.ConsensusState, ConsensusStateData, error) {
+	var data ConsensusStateData
+
+	bz := store.Get(host.ConsensusStateKey(height))
+	if bz == nil {
+		return nil, data, fmt.Errorf("consensus state not found for height %s", height)
+	}
+
+	consensusState, ok := clienttypes.MustUnmarshalConsensusState(cdc, bz).(*types.ConsensusState)
+	if !ok {
+		return nil, data, errors.New("invalid consensus state type")
+	}
+
+	if err := json.Unmarshal(consensusState.Data, &data); err != nil {
+		return nil, data, err
+	}
+
+	return consensusState, data, nil
+}
+
+func decodeHeader(bz []byte) (Header, error) {
+	var header Header
+	err := json.Unmarshal(bz, &header)
+	return header, err
+}
+
+func mustMarshalJSON(v interface{}) []byte {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// DefaultGasUsed is the gas reported as used by every call to a contract of the MockWasmEngine.
+const DefaultGasUsed = uint64(1)
+
+var _ types.WasmEngine = (*MockWasmEngine)(nil)
+
+// Contract defines the entry points of a contract executed by the MockWasmEngine.
+type Contract interface {
+	Instantiate(env types.Env, initMsg []byte, store storetypes.KVStore) ([]byte, error)
+	Query(env types.Env, queryMsg []byte, store storetypes.KVStore) ([]byte, error)
+	Sudo(env types.Env, sudoMsg []byte, store storetypes.KVStore) ([]byte, error)
+}
+
+// MockWasmEngine implements types.WasmEngine without a Wasm runtime. Stored byte code is kept in
+// memory and calls are dispatched to Go implementations of the contract entry points. Unless a
+// contract is registered for a checksum, calls are handled by the LightClientContract.
+type MockWasmEngine struct {
+	mtx       sync.RWMutex
+	codes     map[string][]byte
+	contracts map[string]Contract
+}
+
+// NewMockWasmEngine creates a new MockWasmEngine.
+func NewMockWasmEngine() *MockWasmEngine {
+	return &MockWasmEngine{
+		codes:     make(map[string][]byte),
+		contracts: make(map[string]Contract),
+	}
+}
+
+// RegisterContract registers the contract executed for the given checksum.
+func (m *MockWasmEngine) RegisterContract(checksum []byte, contract Contract) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.contracts[hex.EncodeToString(checksum)] = contract
+}
+
+// StoreCode implements the WasmEngine interface.
+func (m *MockWasmEngine) StoreCode(code []byte) ([]byte, error) {
+	if !types.IsWasm(code) {
+		return nil, errors.New("code is not a wasm binary")
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	checksum := types.CreateChecksum(code)
+	m.codes[hex.EncodeToString(checksum)] = code
+
+	return checksum, nil
+}
+
+// GetCode implements the WasmEngine interface.
+func (m *MockWasmEngine) GetCode(checksum []byte) ([]byte, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	code, ok := m.codes[hex.EncodeToString(checksum)]
+	if !ok {
+		return nil, fmt.Errorf("code for checksum %X not found", checksum)
+	}
+
+	return code, nil
+}
+
+// Instantiate implements the WasmEngine interface.
+func (m *MockWasmEngine) Instantiate(checksum []byte, env types.Env, initMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	contract, err := m.getContract(checksum, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := contract.Instantiate(env, initMsg, store)
+	return resp, DefaultGasUsed, err
+}
+
+// Query implements the WasmEngine interface.
+func (m *MockWasmEngine) Query(checksum []byte, env types.Env, queryMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	contract, err := m.getContract(checksum, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := contract.Query(env, queryMsg, store)
+	return resp, DefaultGasUsed, err
+}
+
+// Sudo implements the WasmEngine interface.
+func (m *MockWasmEngine) Sudo(checksum []byte, env types.Env, sudoMsg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	contract, err := m.getContract(checksum, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := contract.Sudo(env, sudoMsg, store)
+	return resp, DefaultGasUsed, err
+}
+
+func (m *MockWasmEngine) getContract(checksum []byte, gasLimit uint64) (Contract, error) {
+	if gasLimit < DefaultGasUsed {
+		return nil, errors.New("out of gas")
+	}
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	key := hex.EncodeToString(checksum)
+	if _, ok := m.codes[key]; !ok {
+		return nil, fmt.Errorf("code for checksum %X not found", checksum)
+	}
+
+	if contract, ok := m.contracts[key]; ok {
+		return contract, nil
+	}
+
+	return LightClientContract{}, nil
+}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(data []byte, checksum []byte, height clienttypes.Height) *ClientState {
+	return &ClientState{
+		Data:         data,
+		Checksum:     checksum,
+		LatestHeight: height,
+	}
+}
+
+// ClientType is Wasm light client.
+func (ClientState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetLatestHeight returns latest block height.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return cs.LatestHeight
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if len(cs.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	return ValidateWasmChecksum(cs.Checksum)
+}
+
+// Status returns the status of the wasm client as reported by the contract.
+// Unknown is returned if the contract call fails.
+func (cs ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+	payload := QueryMsg{Status: &StatusMsg{}}
+
+	result, err := wasmQuery[StatusResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		return exported.Unknown
+	}
+
+	return exported.Status(result.Status)
+}
+
+// ZeroCustomFields returns a ClientState that is a copy of the current ClientState.
+// The 08-wasm light client does not know which fields of the contract data are
+// customizable, so the contract is responsible for clearing them on upgrade.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return &cs
+}
+
+// GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
+func (cs ClientState) GetTimestampAtHeight(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+) (uint64, error) {
+	timestampHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	payload := QueryMsg{
+		TimestampAtHeight: &TimestampAtHeightMsg{
+			Height: timestampHeight,
+		},
+	}
+
+	result, err := wasmQuery[TimestampAtHeightResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "height (%s)", height)
+	}
+
+	return result.Timestamp, nil
+}
+
+// Initialize checks that the initial consensus state is an 08-wasm consensus state and
+// sets the client state, consensus state in the provided client store.
+// It also initializes the wasm contract for the client.
+func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, state exported.ConsensusState) error {
+	consensusState, ok := state.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, state)
+	}
+
+	// Do not allow initialization of a client with a checksum that hasn't been previously stored via storeWasmCode.
+	if !HasChecksum(ctx, cs.Checksum) {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "checksum (%X) has not been previously stored", cs.Checksum)
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.GetLatestHeight())
+
+	payload := InstantiateMessage{
+		ClientState:    cs.Data,
+		ConsensusState: consensusState.Data,
+		Checksum:       cs.Checksum,
+	}
+
+	return wasmInstantiate(ctx, clientStore, &cs, payload)
+}
+
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	if cs.GetLatestHeight().LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	payload := SudoMsg{
+		VerifyMembership: &VerifyMembershipMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Path:             merklePath,
+			Value:            value,
+		},
+	}
+
+	_, err := wasmSudo[EmptyResult](ctx, clientStore, &cs, payload)
+	return err
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	if cs.GetLatestHeight().LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	payload := SudoMsg{
+		VerifyNonMembership: &VerifyNonMembershipMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Path:             merklePath,
+		},
+	}
+
+	_, err := wasmSudo[EmptyResult](ctx, clientStore, &cs, payload)
+	return err
+}
+
+// ExportMetadata exports all the consensus metadata in the client store so
+// they can be included in clients genesis and imported by a ClientKeeper.
+// The contract is queried with an unmetered context as no context is available.
+func (cs ClientState) ExportMetadata(clientStore sdk.KVStore) []exported.GenesisMetadata {
+	payload := QueryMsg{ExportMetadata: &ExportMetadataMsg{}}
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	result, err := wasmQuery[ExportMetadataResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		panic(err)
+	}
+
+	genesisMetadata := make([]exported.GenesisMetadata, len(result.GenesisMetadata))
+	for i, metadata := range result.GenesisMetadata {
+		genesisMetadata[i] = metadata
+	}
+
+	return genesisMetadata
+}
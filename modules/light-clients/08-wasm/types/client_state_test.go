@@ -0,0 +1,259 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+func (suite *TypesTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		expPass     bool
+	}{
+		{
+			"valid client state",
+			types.NewClientState([]byte("data"), suite.checksum, defaultHeight),
+			true,
+		},
+		{
+			"empty data",
+			types.NewClientState(nil, suite.checksum, defaultHeight),
+			false,
+		},
+		{
+			"empty checksum",
+			types.NewClientState([]byte("data"), nil, defaultHeight),
+			false,
+		},
+		{
+			"invalid checksum length",
+			types.NewClientState([]byte("data"), []byte("checksum"), defaultHeight),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.clientState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestInitialize() {
+	var (
+		clientState    *types.ClientState
+		consensusState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid consensus state type",
+			func() {
+				consensusState = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1).ConsensusState()
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"checksum has not been stored",
+			func() {
+				clientState.Checksum = types.CreateChecksum([]byte("unknown"))
+			},
+			types.ErrInvalidChecksum,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientState = types.NewClientState(mustMarshalJSON(wasmtesting.ClientStateData{}), suite.checksum, defaultHeight)
+			consensusState = types.NewConsensusState(mustMarshalJSON(wasmtesting.ConsensusStateData{Timestamp: 1, Root: defaultRoot}))
+
+			tc.malleate()
+
+			clientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), ibctesting.FirstClientID)
+			err := clientState.Initialize(suite.chainA.GetContext(), suite.chainA.Codec, clientStore, consensusState)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(clientStore.Has(host.ClientStateKey()))
+				suite.Require().True(clientStore.Has(host.ConsensusStateKey(defaultHeight)))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(clientStore.Has(host.ClientStateKey()))
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState := suite.getClientState(clientID)
+				clientState.Data = mustMarshalJSON(wasmtesting.ClientStateData{Frozen: true})
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Frozen,
+		},
+		{
+			"contract call fails",
+			func() {
+				clientState := suite.getClientState(clientID)
+				clientState.Data = []byte("invalid json")
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			tc.malleate()
+
+			status := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientStatus(suite.chainA.GetContext(), suite.getClientState(clientID), clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestGetTimestampAtHeight() {
+	clientID := suite.createClient()
+	clientState := suite.getClientState(clientID)
+	clientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+
+	timestamp, err := clientState.GetTimestampAtHeight(suite.chainA.GetContext(), clientStore, suite.chainA.Codec, defaultHeight)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), timestamp)
+
+	_, err = clientState.GetTimestampAtHeight(suite.chainA.GetContext(), clientStore, suite.chainA.Codec, defaultHeight.Increment())
+	suite.Require().Error(err)
+}
+
+func (suite *TypesTestSuite) TestVerifyMembership() {
+	var (
+		height exported.Height
+		proof  []byte
+		path   exported.Path
+		value  []byte
+	)
+
+	merklePath := commitmenttypes.NewMerklePath("ibc", "key")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"value does not match proof",
+			func() {
+				value = []byte("other value")
+			},
+			false,
+		},
+		{
+			"consensus state not found",
+			func() {
+				height = defaultHeight.Increment()
+			},
+			false,
+		},
+		{
+			"invalid path type",
+			func() {
+				path = ibcmock.KeyPath{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID := suite.createClient()
+			clientState := suite.getClientState(clientID)
+			clientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+
+			height = defaultHeight
+			path = merklePath
+			value = []byte("value")
+			proof = wasmtesting.MembershipProof(defaultRoot, merklePath, value)
+
+			tc.malleate()
+
+			err := clientState.VerifyMembership(suite.chainA.GetContext(), clientStore, suite.chainA.Codec, height, 0, 0, proof, path, value)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestVerifyNonMembership() {
+	clientID := suite.createClient()
+	clientState := suite.getClientState(clientID)
+	clientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+
+	path := commitmenttypes.NewMerklePath("ibc", "key")
+	proof := wasmtesting.NonMembershipProof(defaultRoot, path)
+
+	err := clientState.VerifyNonMembership(suite.chainA.GetContext(), clientStore, suite.chainA.Codec, defaultHeight, 0, 0, proof, path)
+	suite.Require().NoError(err)
+
+	err = clientState.VerifyNonMembership(suite.chainA.GetContext(), clientStore, suite.chainA.Codec, defaultHeight, 0, 0, []byte("invalid proof"), path)
+	suite.Require().Error(err)
+}
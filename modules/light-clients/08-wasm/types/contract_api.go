@@ -0,0 +1,139 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+)
+
+// The messages and results below define the JSON API between the 08-wasm light client
+// and the light client contracts it hosts.
+
+// InstantiateMessage is the message passed to the contract when a client is created.
+type InstantiateMessage struct {
+	ClientState    []byte `json:"client_state"`
+	ConsensusState []byte `json:"consensus_state"`
+	Checksum       []byte `json:"checksum"`
+}
+
+// QueryMsg is used to encode query messages sent to the contract.
+// Exactly one field must be set.
+type QueryMsg struct {
+	Status               *StatusMsg               `json:"status,omitempty"`
+	ExportMetadata       *ExportMetadataMsg       `json:"export_metadata,omitempty"`
+	TimestampAtHeight    *TimestampAtHeightMsg    `json:"timestamp_at_height,omitempty"`
+	VerifyClientMessage  *VerifyClientMessageMsg  `json:"verify_client_message,omitempty"`
+	CheckForMisbehaviour *CheckForMisbehaviourMsg `json:"check_for_misbehaviour,omitempty"`
+}
+
+// StatusMsg is a query message sent to the contract to get the status of the light client.
+type StatusMsg struct{}
+
+// ExportMetadataMsg is a query message sent to the contract to export the client metadata.
+type ExportMetadataMsg struct{}
+
+// TimestampAtHeightMsg is a query message sent to the contract to get the timestamp of the
+// consensus state at the given height.
+type TimestampAtHeightMsg struct {
+	Height clienttypes.Height `json:"height"`
+}
+
+// VerifyClientMessageMsg is a query message sent to the contract to verify a client message.
+type VerifyClientMessageMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// CheckForMisbehaviourMsg is a query message sent to the contract to check for misbehaviour.
+type CheckForMisbehaviourMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// SudoMsg is used to encode sudo messages sent to the contract.
+// Exactly one field must be set.
+type SudoMsg struct {
+	UpdateState                 *UpdateStateMsg                 `json:"update_state,omitempty"`
+	UpdateStateOnMisbehaviour   *UpdateStateOnMisbehaviourMsg   `json:"update_state_on_misbehaviour,omitempty"`
+	VerifyUpgradeAndUpdateState *VerifyUpgradeAndUpdateStateMsg `json:"verify_upgrade_and_update_state,omitempty"`
+	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
+	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
+	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+}
+
+// UpdateStateMsg is a sudo message sent to the contract to update the client state
+// and store the consensus states derived from the client message.
+type UpdateStateMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// UpdateStateOnMisbehaviourMsg is a sudo message sent to the contract to freeze the client
+// after misbehaviour has been detected.
+type UpdateStateOnMisbehaviourMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// VerifyUpgradeAndUpdateStateMsg is a sudo message sent to the contract to verify an upgrade
+// of the counterparty chain and to update the client accordingly. The upgraded client and
+// consensus states are the protobuf encoded 08-wasm ClientState and ConsensusState.
+type VerifyUpgradeAndUpdateStateMsg struct {
+	UpgradeClientState         []byte `json:"upgrade_client_state"`
+	UpgradeConsensusState      []byte `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte `json:"proof_upgrade_consensus_state"`
+}
+
+// VerifyMembershipMsg is a sudo message sent to the contract to verify a membership proof.
+type VerifyMembershipMsg struct {
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	Path             commitmenttypes.MerklePath `json:"path"`
+	Value            []byte                     `json:"value"`
+}
+
+// VerifyNonMembershipMsg is a sudo message sent to the contract to verify a non-membership proof.
+type VerifyNonMembershipMsg struct {
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	Path             commitmenttypes.MerklePath `json:"path"`
+}
+
+// MigrateClientStoreMsg is a sudo message sent to the contract to replace the subject client
+// with the substitute client. The store passed to the contract prefixes the keys of the
+// subject client store with SubjectPrefix and the keys of the substitute client store with
+// SubstitutePrefix.
+type MigrateClientStoreMsg struct{}
+
+// ContractResult is the set of results which may be returned by a contract call.
+type ContractResult interface {
+	StatusResult | ExportMetadataResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult | EmptyResult
+}
+
+// StatusResult is the result of a StatusMsg query.
+type StatusResult struct {
+	Status string `json:"status"`
+}
+
+// ExportMetadataResult is the result of an ExportMetadataMsg query.
+type ExportMetadataResult struct {
+	GenesisMetadata []clienttypes.GenesisMetadata `json:"genesis_metadata"`
+}
+
+// TimestampAtHeightResult is the result of a TimestampAtHeightMsg query.
+type TimestampAtHeightResult struct {
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// CheckForMisbehaviourResult is the result of a CheckForMisbehaviourMsg query.
+type CheckForMisbehaviourResult struct {
+	FoundMisbehaviour bool `json:"found_misbehaviour"`
+}
+
+// UpdateStateResult is the result of an UpdateStateMsg sudo call.
+type UpdateStateResult struct {
+	Heights []clienttypes.Height `json:"heights"`
+}
+
+// EmptyResult is the result of calls which do not return any data.
+type EmptyResult struct{}
@@ -0,0 +1,7 @@
+/*
+Package types implements the 08-wasm light client. The ClientState, ConsensusState
+and ClientMessage types wrap opaque bytes which are interpreted by a light client
+implemented as a Wasm contract. Every light client function delegates to the
+contract identified by the checksum stored in the ClientState.
+*/
+package types
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalid                 = errorsmod.Register(ModuleName, 2, "invalid")
+	ErrInvalidData             = errorsmod.Register(ModuleName, 3, "invalid data")
+	ErrInvalidChecksum         = errorsmod.Register(ModuleName, 4, "invalid checksum")
+	ErrInvalidClientMessage    = errorsmod.Register(ModuleName, 5, "invalid client message")
+	ErrWasmEmptyCode           = errorsmod.Register(ModuleName, 6, "empty wasm code")
+	ErrWasmCodeTooLarge        = errorsmod.Register(ModuleName, 7, "wasm code too large")
+	ErrWasmCodeExists          = errorsmod.Register(ModuleName, 8, "wasm code already exists")
+	ErrWasmChecksumNotFound    = errorsmod.Register(ModuleName, 9, "wasm checksum not found")
+	ErrWasmContractCallFailed  = errorsmod.Register(ModuleName, 10, "wasm contract call failed")
+	ErrWasmInvalidResponseData = errorsmod.Register(ModuleName, 11, "wasm contract returned invalid response data")
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"fmt"
+
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// IBC 08-wasm events
+const (
+	// EventTypeStoreWasmCode defines the event type for bytecode storage
+	EventTypeStoreWasmCode = "store_wasm_code"
+
+	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored
+	AttributeKeyWasmChecksum = "wasm_checksum"
+)
+
+// AttributeValueCategory defines the event category of the 08-wasm module
+var AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, ModuleName)
@@ -0,0 +1,31 @@
+package types
+
+// NewGenesisState creates an 08-wasm GenesisState instance.
+func NewGenesisState(contracts []Contract) *GenesisState {
+	return &GenesisState{Contracts: contracts}
+}
+
+// DefaultGenesisState returns the default 08-wasm genesis state with no stored contracts.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, contract := range gs.Contracts {
+		code := contract.CodeBytes
+		if IsGzip(code) {
+			var err error
+			if code, err = Uncompress(code); err != nil {
+				return err
+			}
+		}
+
+		if err := ValidateWasmCode(code); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
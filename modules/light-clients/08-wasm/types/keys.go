@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName for the wasm client
+	ModuleName = "08-wasm"
+
+	// StoreKey is the store key string for 08-wasm
+	StoreKey = ModuleName
+
+	// KeyChecksumPrefix is the prefix under which all checksums of stored wasm codes are kept
+	KeyChecksumPrefix = "checksums/"
+)
+
+// ChecksumKey returns the store key under which the given checksum is stored
+func ChecksumKey(checksum []byte) []byte {
+	return append([]byte(KeyChecksumPrefix), checksum...)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v7/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgStoreCode)(nil)
+	_ sdk.Msg = (*MsgRemoveChecksum)(nil)
+)
+
+// NewMsgStoreCode creates a new MsgStoreCode instance
+func NewMsgStoreCode(signer string, code []byte) *MsgStoreCode {
+	return &MsgStoreCode{
+		Signer:       signer,
+		WasmByteCode: code,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgStoreCode) ValidateBasic() error {
+	if len(m.WasmByteCode) == 0 {
+		return ErrWasmEmptyCode
+	}
+
+	// the code may be gzip compressed, its contents are validated once uncompressed
+	if uint64(len(m.WasmByteCode)) > MaxWasmByteSize() {
+		return ErrWasmCodeTooLarge
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgStoreCode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRemoveChecksum creates a new MsgRemoveChecksum instance
+func NewMsgRemoveChecksum(signer string, checksum []byte) *MsgRemoveChecksum {
+	return &MsgRemoveChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgRemoveChecksum) ValidateBasic() error {
+	if err := ValidateWasmChecksum(m.Checksum); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgRemoveChecksum) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
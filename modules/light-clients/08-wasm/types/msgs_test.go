@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func TestMsgStoreCodeValidateBasic(t *testing.T) {
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgStoreCode
+		expPass bool
+	}{
+		{"success", types.NewMsgStoreCode(signer, wasmtesting.Code), true},
+		{"empty code", types.NewMsgStoreCode(signer, []byte{}), false},
+		{"code too large", types.NewMsgStoreCode(signer, make([]byte, types.MaxWasmByteSize()+1)), false},
+		{"invalid signer", types.NewMsgStoreCode("signer", wasmtesting.Code), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveChecksumValidateBasic(t *testing.T) {
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	checksum := types.CreateChecksum(wasmtesting.Code)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveChecksum
+		expPass bool
+	}{
+		{"success", types.NewMsgRemoveChecksum(signer, checksum), true},
+		{"invalid checksum", types.NewMsgRemoveChecksum(signer, []byte("checksum")), false},
+		{"invalid signer", types.NewMsgRemoveChecksum("signer", checksum), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will verify that a substitute client state is valid and update the subject client state.
+// The substitute must be a wasm client using the same contract as the subject. The contract is called with a store
+// combining the subject client store (keys prefixed with SubjectPrefix) and the read-only substitute client store
+// (keys prefixed with SubstitutePrefix) and is responsible for the migration.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	subjectClientStore, substituteClientStore sdk.KVStore,
+	substituteClient exported.ClientState,
+) error {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidClient,
+			"invalid substitute client state: expected type %T, got %T", &ClientState{}, substituteClient,
+		)
+	}
+
+	if !bytes.Equal(substituteClientState.Checksum, cs.Checksum) {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidSubstitute,
+			"expected checksums to be equal: expected %s, got %s", hex.EncodeToString(cs.Checksum), hex.EncodeToString(substituteClientState.Checksum),
+		)
+	}
+
+	store := newMigrateClientWrappedStore(subjectClientStore, substituteClientStore)
+
+	payload := SudoMsg{
+		MigrateClientStore: &MigrateClientStoreMsg{},
+	}
+
+	_, err := wasmSudo[EmptyResult](ctx, store, &cs, payload)
+	return err
+}
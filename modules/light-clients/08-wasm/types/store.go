@@ -0,0 +1,173 @@
+package types
+
+import (
+	"bytes"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var (
+	// SubjectPrefix is the prefix used for the subject client store keys in the store
+	// passed to the contract in MigrateClientStoreMsg.
+	SubjectPrefix = []byte("subject/")
+	// SubstitutePrefix is the prefix used for the substitute client store keys in the store
+	// passed to the contract in MigrateClientStoreMsg.
+	SubstitutePrefix = []byte("substitute/")
+)
+
+// setClientState stores the client state
+func setClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec, clientState *ClientState) {
+	key := host.ClientStateKey()
+	val := clienttypes.MustMarshalClientState(cdc, clientState)
+	clientStore.Set(key, val)
+}
+
+// setConsensusState stores the consensus state at the given height.
+func setConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
+	key := host.ConsensusStateKey(height)
+	val := clienttypes.MustMarshalConsensusState(cdc, consensusState)
+	clientStore.Set(key, val)
+}
+
+// GetConsensusState retrieves the consensus state from the client prefixed store.
+// If the ConsensusState does not exist in state for the provided height a nil value and false boolean flag is returned
+func GetConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, bool) {
+	bz := clientStore.Get(host.ConsensusStateKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	consensusStateI := clienttypes.MustUnmarshalConsensusState(cdc, bz)
+	consensusState, ok := consensusStateI.(*ConsensusState)
+	return consensusState, ok
+}
+
+var _ storetypes.KVStore = (*migrateClientWrappedStore)(nil)
+
+// migrateClientWrappedStore combines two KVStores into one. It is used when migrating
+// a subject client to a substitute client: keys prefixed with SubjectPrefix are routed
+// to the subject client store and keys prefixed with SubstitutePrefix are routed to the
+// substitute client store. The substitute client store is read-only.
+type migrateClientWrappedStore struct {
+	subjectStore    storetypes.KVStore
+	substituteStore storetypes.KVStore
+}
+
+func newMigrateClientWrappedStore(subjectStore, substituteStore storetypes.KVStore) migrateClientWrappedStore {
+	return migrateClientWrappedStore{
+		subjectStore:    subjectStore,
+		substituteStore: substituteStore,
+	}
+}
+
+// Get implements the storetypes.KVStore interface. It panics if the key is not prefixed
+// with SubjectPrefix or SubstitutePrefix.
+func (ws migrateClientWrappedStore) Get(key []byte) []byte {
+	prefix, key := splitPrefix(key)
+	return ws.getStore(prefix).Get(key)
+}
+
+// Has implements the storetypes.KVStore interface.
+func (ws migrateClientWrappedStore) Has(key []byte) bool {
+	prefix, key := splitPrefix(key)
+	return ws.getStore(prefix).Has(key)
+}
+
+// Set implements the storetypes.KVStore interface. Only keys prefixed with SubjectPrefix
+// may be written.
+func (ws migrateClientWrappedStore) Set(key, value []byte) {
+	prefix, key := splitPrefix(key)
+	if !bytes.Equal(prefix, SubjectPrefix) {
+		panic(errorsmod.Wrapf(ErrInvalid, "writes only allowed on subject store; key must be prefixed with \"%s\"", SubjectPrefix))
+	}
+	ws.subjectStore.Set(key, value)
+}
+
+// Delete implements the storetypes.KVStore interface. Only keys prefixed with SubjectPrefix
+// may be deleted.
+func (ws migrateClientWrappedStore) Delete(key []byte) {
+	prefix, key := splitPrefix(key)
+	if !bytes.Equal(prefix, SubjectPrefix) {
+		panic(errorsmod.Wrapf(ErrInvalid, "writes only allowed on subject store; key must be prefixed with \"%s\"", SubjectPrefix))
+	}
+	ws.subjectStore.Delete(key)
+}
+
+// Iterator implements the storetypes.KVStore interface. Both start and end must use the same prefix.
+func (ws migrateClientWrappedStore) Iterator(start, end []byte) storetypes.Iterator {
+	prefixStart, start := splitPrefix(start)
+	prefixEnd, end := splitPrefix(end)
+	if end == nil {
+		// an unbounded iteration covers the remainder of the store selected by the start key
+		prefixEnd = prefixStart
+	}
+	if !bytes.Equal(prefixStart, prefixEnd) {
+		panic(errorsmod.Wrap(ErrInvalid, "start and end keys must be prefixed with the same prefix"))
+	}
+	return ws.getStore(prefixStart).Iterator(start, end)
+}
+
+// ReverseIterator implements the storetypes.KVStore interface. Both start and end must use the same prefix.
+func (ws migrateClientWrappedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	prefixStart, start := splitPrefix(start)
+	prefixEnd, end := splitPrefix(end)
+	if end == nil {
+		// an unbounded iteration covers the remainder of the store selected by the start key
+		prefixEnd = prefixStart
+	}
+	if !bytes.Equal(prefixStart, prefixEnd) {
+		panic(errorsmod.Wrap(ErrInvalid, "start and end keys must be prefixed with the same prefix"))
+	}
+	return ws.getStore(prefixStart).ReverseIterator(start, end)
+}
+
+// GetStoreType implements the storetypes.KVStore interface.
+func (ws migrateClientWrappedStore) GetStoreType() storetypes.StoreType {
+	return ws.subjectStore.GetStoreType()
+}
+
+// CacheWrap implements the storetypes.KVStore interface.
+func (ws migrateClientWrappedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(ws)
+}
+
+// CacheWrapWithTrace implements the storetypes.KVStore interface.
+func (ws migrateClientWrappedStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ws, w, tc))
+}
+
+// getStore returns the store to be used for the given prefix.
+func (ws migrateClientWrappedStore) getStore(prefix []byte) storetypes.KVStore {
+	switch {
+	case bytes.Equal(prefix, SubjectPrefix):
+		return ws.subjectStore
+	case bytes.Equal(prefix, SubstitutePrefix):
+		return ws.substituteStore
+	default:
+		panic(errorsmod.Wrapf(ErrInvalid, "key must be prefixed with either \"%s\" or \"%s\"", SubjectPrefix, SubstitutePrefix))
+	}
+}
+
+// splitPrefix splits the key into the prefix and the remaining key. If the key is not
+// prefixed with SubjectPrefix or SubstitutePrefix a nil prefix is returned.
+func splitPrefix(key []byte) ([]byte, []byte) {
+	switch {
+	case bytes.HasPrefix(key, SubjectPrefix):
+		return SubjectPrefix, bytes.TrimPrefix(key, SubjectPrefix)
+	case bytes.HasPrefix(key, SubstitutePrefix):
+		return SubstitutePrefix, bytes.TrimPrefix(key, SubstitutePrefix)
+	default:
+		return nil, key
+	}
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+var (
+	// defaultRoot is the commitment root of the consensus state the test clients are created with
+	defaultRoot   = []byte("root")
+	defaultHeight = clienttypes.NewHeight(1, 10)
+)
+
+type TypesTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	checksum []byte
+}
+
+func TestWasmTestSuite(t *testing.T) {
+	suite.Run(t, new(TypesTestSuite))
+}
+
+func (suite *TypesTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	params := clienttypes.NewParams(exported.Wasm)
+	suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	msg := types.NewMsgStoreCode(authtypes.NewModuleAddress(govtypes.ModuleName).String(), wasmtesting.Code)
+	res, err := suite.chainA.GetSimApp().WasmClientKeeper.StoreCode(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	suite.checksum = res.Checksum
+}
+
+// createClient creates a wasm client backed by the test light client contract and returns its client identifier.
+func (suite *TypesTestSuite) createClient() string {
+	clientState := types.NewClientState(mustMarshalJSON(wasmtesting.ClientStateData{}), suite.checksum, defaultHeight)
+	consensusState := types.NewConsensusState(mustMarshalJSON(wasmtesting.ConsensusStateData{Timestamp: 1, Root: defaultRoot}))
+
+	clientID, err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.CreateClient(suite.chainA.GetContext(), clientState, consensusState)
+	suite.Require().NoError(err)
+
+	return clientID
+}
+
+func (suite *TypesTestSuite) getClientState(clientID string) *types.ClientState {
+	clientState, found := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+
+	return clientState.(*types.ClientState)
+}
+
+func mustMarshalJSON(v interface{}) []byte {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func newHeader(height clienttypes.Height, timestamp uint64, root []byte) *types.ClientMessage {
+	return &types.ClientMessage{
+		Data: mustMarshalJSON(wasmtesting.Header{Height: height, Timestamp: timestamp, Root: root}),
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// VerifyClientMessage must verify a ClientMessage. A ClientMessage could be a Header, Misbehaviour, or batch update.
+// It must handle each type of ClientMessage appropriately. Calls to CheckForMisbehaviour, UpdateState, and UpdateStateOnMisbehaviour
+// will assume that the content of the ClientMessage has been verified and can be trusted. An error should be returned
+// if the ClientMessage fails to verify.
+func (cs ClientState) VerifyClientMessage(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) error {
+	clientMessage, ok := clientMsg.(*ClientMessage)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidClientMessage, "expected type: %T, got: %T", &ClientMessage{}, clientMsg)
+	}
+
+	payload := QueryMsg{
+		VerifyClientMessage: &VerifyClientMessageMsg{ClientMessage: clientMessage.Data},
+	}
+	_, err := wasmQuery[EmptyResult](ctx, clientStore, &cs, payload)
+	return err
+}
+
+// CheckForMisbehaviour detects misbehaviour in a submitted client message. It assumes the
+// client message has already been verified.
+func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) bool {
+	clientMessage, ok := clientMsg.(*ClientMessage)
+	if !ok {
+		return false
+	}
+
+	payload := QueryMsg{
+		CheckForMisbehaviour: &CheckForMisbehaviourMsg{ClientMessage: clientMessage.Data},
+	}
+
+	result, err := wasmQuery[CheckForMisbehaviourResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		panic(err)
+	}
+
+	return result.FoundMisbehaviour
+}
+
+// UpdateStateOnMisbehaviour should perform appropriate state changes on a client state given that misbehaviour has been detected and verified.
+// Client state is updated in the store by the contract.
+func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
+	clientMessage, ok := clientMsg.(*ClientMessage)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &ClientMessage{}, clientMsg))
+	}
+
+	payload := SudoMsg{
+		UpdateStateOnMisbehaviour: &UpdateStateOnMisbehaviourMsg{ClientMessage: clientMessage.Data},
+	}
+
+	_, err := wasmSudo[EmptyResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// UpdateState must only be called after a successful call to VerifyClientMessage. The contract
+// updates the client state and stores the consensus states in the client store. The heights of
+// the stored consensus states are returned.
+func (cs ClientState) UpdateState(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	clientMessage, ok := clientMsg.(*ClientMessage)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &ClientMessage{}, clientMsg))
+	}
+
+	payload := SudoMsg{
+		UpdateState: &UpdateStateMsg{ClientMessage: clientMessage.Data},
+	}
+
+	result, err := wasmSudo[UpdateStateResult](ctx, clientStore, &cs, payload)
+	if err != nil {
+		panic(err)
+	}
+
+	heights := make([]exported.Height, len(result.Heights))
+	for i, height := range result.Heights {
+		heights[i] = height
+	}
+
+	return heights
+}
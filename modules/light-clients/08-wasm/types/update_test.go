@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *TypesTestSuite) TestUpdateClient() {
+	var (
+		clientID  string
+		clientMsg exported.ClientMessage
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expStatus exported.Status
+		expHeight clienttypes.Height
+	}{
+		{
+			"success: update with new header",
+			func() {},
+			true,
+			exported.Active,
+			defaultHeight.Increment().(clienttypes.Height),
+		},
+		{
+			"success: misbehaviour freezes client",
+			func() {
+				clientMsg = newHeader(defaultHeight, 2, []byte("conflicting root"))
+			},
+			true,
+			exported.Frozen,
+			defaultHeight,
+		},
+		{
+			"failure: contract rejects client message",
+			func() {
+				clientMsg = newHeader(clienttypes.ZeroHeight(), 2, []byte("root"))
+			},
+			false,
+			exported.Active,
+			defaultHeight,
+		},
+		{
+			"failure: invalid client message data",
+			func() {
+				clientMsg = &types.ClientMessage{Data: []byte("invalid json")}
+			},
+			false,
+			exported.Active,
+			defaultHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			clientMsg = newHeader(defaultHeight.Increment().(clienttypes.Height), 2, []byte("new root"))
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, clientMsg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			clientState := suite.getClientState(clientID)
+			suite.Require().Equal(tc.expHeight, clientState.LatestHeight)
+
+			status := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientStatus(suite.chainA.GetContext(), clientState, clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestCheckSubstituteAndUpdateState() {
+	subjectClientID := suite.createClient()
+	substituteClientID := suite.createClient()
+
+	substituteHeight := defaultHeight.Increment().(clienttypes.Height)
+	err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.UpdateClient(suite.chainA.GetContext(), substituteClientID, newHeader(substituteHeight, 2, []byte("new root")))
+	suite.Require().NoError(err)
+
+	// freeze the subject client
+	err = suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.UpdateClient(suite.chainA.GetContext(), subjectClientID, newHeader(defaultHeight, 2, []byte("conflicting root")))
+	suite.Require().NoError(err)
+
+	subjectClientState := suite.getClientState(subjectClientID)
+	subjectClientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectClientID)
+	substituteClientStore := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), substituteClientID)
+
+	err = subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, subjectClientStore, substituteClientStore, suite.getClientState(substituteClientID))
+	suite.Require().NoError(err)
+
+	subjectClientState = suite.getClientState(subjectClientID)
+	suite.Require().Equal(substituteHeight, subjectClientState.LatestHeight)
+
+	status := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientStatus(suite.chainA.GetContext(), subjectClientState, subjectClientID)
+	suite.Require().Equal(exported.Active, status)
+
+	// substitute with a different checksum is rejected
+	substituteClientState := suite.getClientState(substituteClientID)
+	substituteClientState.Checksum = types.CreateChecksum([]byte("other code"))
+	err = subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, subjectClientStore, substituteClientStore, substituteClientState)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidSubstitute)
+}
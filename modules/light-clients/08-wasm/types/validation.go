@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const maxWasmSize = 3 * 1024 * 1024
+
+var (
+	wasmIdent = []byte("\x00\x61\x73\x6D")
+	gzipIdent = []byte("\x1F\x8B\x08")
+)
+
+// MaxWasmByteSize returns the maximum allowed number of bytes for wasm bytecode
+func MaxWasmByteSize() uint64 {
+	return maxWasmSize
+}
+
+// ValidateWasmCode valides that the size of the wasm code is in the allowed range
+// and that the contents are those of a wasm binary.
+func ValidateWasmCode(code []byte) error {
+	if len(code) == 0 {
+		return ErrWasmEmptyCode
+	}
+	if uint64(len(code)) > MaxWasmByteSize() {
+		return ErrWasmCodeTooLarge
+	}
+	if !IsWasm(code) {
+		return errorsmod.Wrap(ErrInvalidData, "code is not a wasm binary")
+	}
+
+	return nil
+}
+
+// ValidateWasmChecksum validates that the checksum is of the correct length
+func ValidateWasmChecksum(checksum []byte) error {
+	lenChecksum := len(checksum)
+	if lenChecksum == 0 {
+		return errorsmod.Wrap(ErrInvalidChecksum, "checksum cannot be empty")
+	}
+	if lenChecksum != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "expected length of %d bytes, got %d", sha256.Size, lenChecksum)
+	}
+
+	return nil
+}
+
+// CreateChecksum returns the sha256 checksum of the provided wasm code.
+func CreateChecksum(code []byte) []byte {
+	hash := sha256.Sum256(code)
+	return hash[:]
+}
+
+// IsWasm checks if the file contents are of wasm binary
+func IsWasm(input []byte) bool {
+	return bytes.Equal(input[:min(len(input), len(wasmIdent))], wasmIdent)
+}
+
+// IsGzip returns checks if the file contents are gzip compressed
+func IsGzip(input []byte) bool {
+	return bytes.Equal(input[:min(len(input), len(gzipIdent))], gzipIdent)
+}
+
+// Uncompress expects a valid gzip source to unpack or fails. The returned bytes are
+// limited to the maximum wasm code size.
+func Uncompress(gzipSrc []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(gzipSrc))
+	if err != nil {
+		return nil, err
+	}
+	zr.Multistream(false)
+	defer zr.Close()
+
+	bz, err := io.ReadAll(io.LimitReader(zr, int64(MaxWasmByteSize())+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(bz)) > MaxWasmByteSize() {
+		return nil, ErrWasmCodeTooLarge
+	}
+
+	return bz, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
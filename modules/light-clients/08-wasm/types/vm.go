@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/internal/ibcwasm"
+)
+
+type (
+	// WasmEngine defines the virtual machine used to execute light client contracts.
+	WasmEngine = ibcwasm.WasmEngine
+	// Env defines the environment passed to light client contract calls.
+	Env = ibcwasm.Env
+	// BlockInfo defines the block information passed to light client contract calls.
+	BlockInfo = ibcwasm.BlockInfo
+)
+
+// GasConsumptionDescriptor is the descriptor used when consuming gas for contract calls.
+const GasConsumptionDescriptor = "08-wasm contract call"
+
+// HasChecksum returns true if the given checksum has been stored through MsgStoreCode.
+func HasChecksum(ctx sdk.Context, checksum []byte) bool {
+	return ctx.KVStore(ibcwasm.GetStoreKey()).Has(ChecksumKey(checksum))
+}
+
+// wasmInstantiate calls the instantiate entry point of the contract referenced by the
+// client state checksum.
+func wasmInstantiate(ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload InstantiateMessage) error {
+	encodedData, err := json.Marshal(payload)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal payload for wasm contract instantiation")
+	}
+
+	_, gasUsed, err := ibcwasm.GetVM().Instantiate(cs.Checksum, getEnv(ctx), encodedData, clientStore, gasLimit(ctx))
+	ctx.GasMeter().ConsumeGas(gasUsed, GasConsumptionDescriptor)
+	if err != nil {
+		return errorsmod.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	return nil
+}
+
+// wasmSudo calls the sudo entry point of the contract and unmarshals the returned data into
+// the expected result type.
+func wasmSudo[T ContractResult](ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload SudoMsg) (T, error) {
+	var result T
+
+	encodedData, err := json.Marshal(payload)
+	if err != nil {
+		return result, errorsmod.Wrap(err, "failed to marshal payload for wasm execution")
+	}
+
+	resp, gasUsed, err := ibcwasm.GetVM().Sudo(cs.Checksum, getEnv(ctx), encodedData, clientStore, gasLimit(ctx))
+	ctx.GasMeter().ConsumeGas(gasUsed, GasConsumptionDescriptor)
+	if err != nil {
+		return result, errorsmod.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return result, errorsmod.Wrapf(ErrWasmInvalidResponseData, "failed to unmarshal result of wasm execution: %v", err)
+	}
+
+	return result, nil
+}
+
+// wasmQuery calls the query entry point of the contract and unmarshals the returned data into
+// the expected result type. Any writes performed by the contract are discarded.
+func wasmQuery[T ContractResult](ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload QueryMsg) (T, error) {
+	var result T
+
+	encodedData, err := json.Marshal(payload)
+	if err != nil {
+		return result, errorsmod.Wrap(err, "failed to marshal payload for wasm query")
+	}
+
+	resp, gasUsed, err := ibcwasm.GetVM().Query(cs.Checksum, getEnv(ctx), encodedData, cachekv.NewStore(clientStore), gasLimit(ctx))
+	ctx.GasMeter().ConsumeGas(gasUsed, GasConsumptionDescriptor)
+	if err != nil {
+		return result, errorsmod.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return result, errorsmod.Wrapf(ErrWasmInvalidResponseData, "failed to unmarshal result of wasm query: %v", err)
+	}
+
+	return result, nil
+}
+
+// getEnv returns the environment passed to contract calls.
+func getEnv(ctx sdk.Context) Env {
+	return Env{
+		Block: BlockInfo{
+			Height:  uint64(ctx.BlockHeight()),
+			Time:    uint64(ctx.BlockTime().UnixNano()),
+			ChainID: ctx.ChainID(),
+		},
+	}
+}
+
+// gasLimit returns the gas available to a contract call.
+func gasLimit(ctx sdk.Context) uint64 {
+	return ctx.GasMeter().GasRemaining()
+}
@@ -0,0 +1,176 @@
+package localhost
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC exported.LightClientModule interface for the 09-localhost client.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	key           storetypes.StoreKey
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new 09-localhost LightClientModule. The IBC store key is used
+// to verify proofs against the state of the running chain.
+func NewLightClientModule(cdc codec.BinaryCodec, key storetypes.StoreKey) *LightClientModule {
+	return &LightClientModule{
+		cdc: cdc,
+		key: key,
+	}
+}
+
+// RegisterStoreProvider is called by core IBC when a LightClientModule is added to the router.
+// It allows the LightClientModule to set a ClientStoreProvider which supplies isolated prefix client stores
+// to IBC light client instances.
+func (l *LightClientModule) RegisterStoreProvider(storeProvider exported.ClientStoreProvider) {
+	l.storeProvider = storeProvider
+}
+
+// Initialize ensures that initial consensus state for localhost is nil and sets the 09-localhost client state
+// at the current height of the running chain. The provided client state is ignored.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, _ exported.ClientState, consensusState exported.ConsensusState) error {
+	var clientState ClientState
+	return clientState.Initialize(ctx, l.cdc, l.storeProvider.ClientStore(ctx, clientID), consensusState)
+}
+
+// VerifyClientMessage is unsupported by the 09-localhost client type and returns an error.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "client message verification is unsupported by the localhost client")
+}
+
+// CheckForMisbehaviour is unsupported by the 09-localhost client type and performs a no-op, returning false.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	return false
+}
+
+// UpdateStateOnMisbehaviour is unsupported by the 09-localhost client type and performs a no-op.
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) {
+}
+
+// UpdateState updates the 09-localhost client to the latest block height of the running chain.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership verifies the existence of the value stored under the provided path within the IBC store.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyMembership(ctx, ctx.KVStore(l.key), l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership verifies the absence of the provided path within the IBC store.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyNonMembership(ctx, ctx.KVStore(l.key), l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// VerifyBatchMembership returns an error. The 09-localhost client does not support batch proof verification.
+func (l LightClientModule) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	prefix exported.Prefix,
+	items map[string][]byte,
+) error {
+	return errorsmod.Wrapf(clienttypes.ErrBatchVerificationNotSupported, "client type %s", exported.Localhost)
+}
+
+// PruneExpiredConsensusStates returns an error. The 09-localhost client does not support consensus state pruning.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStatePruningNotSupported, "client type %s", exported.Localhost)
+}
+
+// Status always returns Active. The 09-localhost status cannot be changed.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	return exported.Active
+}
+
+// LatestHeight returns the latest height of the 09-localhost client. A zero value height is returned
+// if the client state is not found.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clientState.LatestHeight
+}
+
+// TimestampAtHeight returns the current block time retrieved from the application context. The localhost client does not store
+// consensus states and thus cannot provide a timestamp for the provided height.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	return uint64(ctx.BlockTime().UnixNano()), nil
+}
+
+// RecoverClient returns an error. The localhost cannot be modified by proposals.
+func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update localhost client with a proposal")
+}
+
+// VerifyUpgradeAndUpdateState returns an error since localhost cannot be upgraded.
+func (l LightClientModule) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context,
+	clientID string,
+	newClient exported.ClientState,
+	newConsState exported.ConsensusState,
+	proofUpgradeClient,
+	proofUpgradeConsState []byte,
+) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
+}
+
+// getClientState retrieves the 09-localhost client state from the client prefixed store.
+func getClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientState, ok := clienttypes.MustUnmarshalClientState(cdc, bz).(*ClientState)
+	return clientState, ok
+}
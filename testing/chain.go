@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
@@ -205,6 +206,22 @@ func (chain *TestChain) GetSimApp() *simapp.SimApp {
 	return app
 }
 
+// GetSimulationAccounts returns the sender accounts of the chain other than the default sender account
+// as simulation accounts. Transactions delivered by simulation operations are signed by these accounts,
+// such that the account sequence of the default sender account used by SendMsgs is left untouched.
+func (chain *TestChain) GetSimulationAccounts() []simtypes.Account {
+	var accs []simtypes.Account
+	for _, senderAccount := range chain.SenderAccounts[1:] {
+		accs = append(accs, simtypes.Account{
+			PrivKey: senderAccount.SenderPrivKey,
+			PubKey:  senderAccount.SenderPrivKey.PubKey(),
+			Address: senderAccount.SenderAccount.GetAddress(),
+		})
+	}
+
+	return accs
+}
+
 // QueryProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryProof(key []byte) ([]byte, clienttypes.Height) {
@@ -242,6 +259,49 @@ func (chain *TestChain) QueryProofForStore(storeKey string, key []byte, height i
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryBatchProof performs an abci query for each of the given keys and returns the proto encoded
+// merkle proof combining the proofs of all keys and the height at which the proof will succeed on
+// a tendermint verifier. Only the IBC store is supported.
+func (chain *TestChain) QueryBatchProof(keys [][]byte) ([]byte, clienttypes.Height) {
+	return chain.QueryBatchProofAtHeight(keys, chain.App.LastBlockHeight())
+}
+
+// QueryBatchProofAtHeight performs an abci query for each of the given keys and returns the proto
+// encoded merkle proof combining the proofs of all keys and the height at which the proof will
+// succeed on a tendermint verifier. Only the IBC store is supported.
+func (chain *TestChain) QueryBatchProofAtHeight(keys [][]byte, height int64) ([]byte, clienttypes.Height) {
+	var (
+		merkleProofs = make([]commitmenttypes.MerkleProof, len(keys))
+		proofHeight  int64
+	)
+	for i, key := range keys {
+		res := chain.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", exported.StoreKey),
+			Height: height - 1,
+			Data:   key,
+			Prove:  true,
+		})
+
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		require.NoError(chain.TB, err)
+
+		merkleProofs[i] = merkleProof
+		proofHeight = res.Height
+	}
+
+	batchProof, err := commitmenttypes.CombineProofs(merkleProofs)
+	require.NoError(chain.TB, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&batchProof)
+	require.NoError(chain.TB, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree.
+	return proof, clienttypes.NewHeight(revision, uint64(proofHeight)+1)
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
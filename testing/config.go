@@ -50,6 +50,10 @@ type ChannelConfig struct {
 	PortID  string
 	Version string
 	Order   channeltypes.Order
+
+	// ProposedUpgrade defines the upgrade fields used when performing a channel upgrade.
+	// Any empty fields are filled in with the existing channel configuration.
+	ProposedUpgrade channeltypes.Upgrade
 }
 
 func NewChannelConfig() *ChannelConfig {
@@ -167,6 +167,37 @@ func (coord *Coordinator) CreateChannels(path *Path) {
 	require.NoError(coord.T, err)
 }
 
+// SetupMultihop constructs a client and connection between each pair of consecutive
+// chains of the multi-hop path and then creates a multi-hop channel between the
+// first and last chain using the mock application module.
+func (coord *Coordinator) SetupMultihop(path *MultihopPath) {
+	for _, hop := range path.Paths {
+		coord.SetupConnections(hop)
+	}
+
+	path.EndpointA.ChannelConfig.PortID = MockPort
+	path.EndpointB.ChannelConfig.PortID = MockPort
+
+	coord.CreateMultihopChannels(path)
+}
+
+// CreateMultihopChannels constructs and executes channel handshake messages in order to
+// create an OPEN multi-hop channel between the first and last chain of the path. The
+// function expects the channels to be successfully opened otherwise testing will fail.
+func (coord *Coordinator) CreateMultihopChannels(path *MultihopPath) {
+	err := path.EndpointA.ChanOpenInit()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointB.ChanOpenTry()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointA.ChanOpenAck()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	require.NoError(coord.T, err)
+}
+
 // GetChain returns the TestChain using the given chainID and returns an error if it does
 // not exist.
 func (coord *Coordinator) GetChain(chainID string) *TestChain {
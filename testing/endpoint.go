@@ -420,6 +420,227 @@ func (endpoint *Endpoint) ChanCloseInit() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ChanUpgradeInit initializes a channel upgrade on the associated endpoint.
+// The msg is submitted directly to the msg server using the ibc module authority as the signer.
+func (endpoint *Endpoint) ChanUpgradeInit() error {
+	upgrade := endpoint.GetProposedUpgrade()
+
+	msg := channeltypes.NewMsgChannelUpgradeInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		upgrade.Fields,
+		endpoint.Chain.App.GetIBCKeeper().GetAuthority(),
+	)
+
+	if _, err := endpoint.Chain.App.GetIBCKeeper().ChannelUpgradeInit(endpoint.Chain.GetContext(), msg); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// ChanUpgradeTry sends a MsgChannelUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	upgrade := endpoint.GetProposedUpgrade()
+	channelProof, upgradeProof, height := endpoint.QueryChannelUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := channeltypes.NewMsgChannelUpgradeTry(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		upgrade.Fields.ConnectionHops,
+		counterpartyUpgrade.Fields,
+		endpoint.Counterparty.GetChannel().UpgradeSequence,
+		channelProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeAck sends a MsgChannelUpgradeAck to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	channelProof, upgradeProof, height := endpoint.QueryChannelUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := channeltypes.NewMsgChannelUpgradeAck(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		counterpartyUpgrade,
+		channelProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeConfirm sends a MsgChannelUpgradeConfirm to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	channelProof, upgradeProof, height := endpoint.QueryChannelUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := channeltypes.NewMsgChannelUpgradeConfirm(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel().State,
+		counterpartyUpgrade,
+		channelProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.updateChannelConfig()
+
+	return nil
+}
+
+// ChanUpgradeOpen sends a MsgChannelUpgradeOpen to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeOpen() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofChannel, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	counterpartyChannel := endpoint.Counterparty.GetChannel()
+	msg := channeltypes.NewMsgChannelUpgradeOpen(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		counterpartyChannel.State,
+		counterpartyChannel.UpgradeSequence,
+		proofChannel,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.updateChannelConfig()
+
+	return nil
+}
+
+// ChanUpgradeTimeout sends a MsgChannelUpgradeTimeout to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofChannel, height := endpoint.Counterparty.Chain.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeTimeout(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel(),
+		proofChannel,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeCancel sends a MsgChannelUpgradeCancel to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeCancel() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	errorReceiptKey := host.ChannelUpgradeErrorKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofErrorReceipt, height := endpoint.Counterparty.Chain.QueryProof(errorReceiptKey)
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := channeltypes.NewMsgChannelUpgradeCancel(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		errorReceipt,
+		proofErrorReceipt,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// QueryChannelUpgradeProof returns all the proofs necessary to execute UpgradeTry/UpgradeAck/UpgradeConfirm.
+// It assumes that the counterparty channel is already in the correct state.
+func (endpoint *Endpoint) QueryChannelUpgradeProof() ([]byte, []byte, clienttypes.Height) {
+	counterpartyPortID := endpoint.Counterparty.ChannelConfig.PortID
+	counterpartyChannelID := endpoint.Counterparty.ChannelID
+
+	channelKey := host.ChannelKey(counterpartyPortID, counterpartyChannelID)
+	proofChannel, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	upgradeKey := host.ChannelUpgradeKey(counterpartyPortID, counterpartyChannelID)
+	proofUpgrade, _ := endpoint.Counterparty.QueryProof(upgradeKey)
+
+	return proofChannel, proofUpgrade, height
+}
+
+// GetProposedUpgrade returns the upgrade which is proposed by the endpoint's channel configuration.
+// Any unset upgrade fields are defaulted to the current channel configuration of the endpoint.
+func (endpoint *Endpoint) GetProposedUpgrade() channeltypes.Upgrade {
+	upgrade := channeltypes.Upgrade{
+		Fields:  endpoint.ChannelConfig.ProposedUpgrade.Fields,
+		Timeout: endpoint.ChannelConfig.ProposedUpgrade.Timeout,
+	}
+
+	if upgrade.Fields.Ordering == channeltypes.NONE {
+		upgrade.Fields.Ordering = endpoint.ChannelConfig.Order
+	}
+
+	if len(upgrade.Fields.ConnectionHops) == 0 {
+		upgrade.Fields.ConnectionHops = []string{endpoint.ConnectionID}
+	}
+
+	if upgrade.Fields.Version == "" {
+		upgrade.Fields.Version = endpoint.ChannelConfig.Version
+	}
+
+	return upgrade
+}
+
+// updateChannelConfig updates the endpoint channel configuration with the
+// channel version and ordering once the channel has been reopened after an upgrade.
+func (endpoint *Endpoint) updateChannelConfig() {
+	channel := endpoint.GetChannel()
+	if channel.State != channeltypes.OPEN {
+		return
+	}
+
+	endpoint.ChannelConfig.Version = channel.Version
+	endpoint.ChannelConfig.Order = channel.Ordering
+}
+
 // SendPacket sends a packet through the channel keeper using the associated endpoint
 // The counterparty client is updated so proofs can be sent to the counterparty chain.
 // The packet sequence generated for the packet to be sent is returned. An error
@@ -481,6 +702,31 @@ func (endpoint *Endpoint) RecvPacketWithResult(packet channeltypes.Packet) (*sdk
 	return res, nil
 }
 
+// RecvPackets receives a batch of packets on the associated endpoint with a single proof
+// and the result of the transaction is returned. The counterparty client is updated.
+func (endpoint *Endpoint) RecvPackets(packets []channeltypes.Packet) (*sdk.Result, error) {
+	// get proof of all packet commitments on source
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryBatchProof(keys)
+
+	recvMsg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	// receive on counterparty and update source client
+	res, err := endpoint.Chain.SendMsgs(recvMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.Counterparty.UpdateClient(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // WriteAcknowledgement writes an acknowledgement on the channel associated with the endpoint.
 // The counterparty client is updated.
 func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, packet exported.PacketI) error {
@@ -509,6 +755,20 @@ func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []by
 	return endpoint.Chain.sendMsgs(ackMsg)
 }
 
+// AcknowledgePackets sends a MsgAcknowledgements to the channel associated with the endpoint.
+func (endpoint *Endpoint) AcknowledgePackets(packets []channeltypes.Packet, acks [][]byte) error {
+	// get proof of all acknowledgements on counterparty
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryBatchProof(keys)
+
+	ackMsg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	// get proof for timeout based on channel order
@@ -517,14 +777,14 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
 
 	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
-	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
 	require.True(endpoint.Chain.TB, found)
 
 	timeoutMsg := channeltypes.NewMsgTimeout(
@@ -541,7 +801,7 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	var packetKey []byte
 
 	switch endpoint.ChannelConfig.Order {
-	case channeltypes.ORDERED:
+	case channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
@@ -557,9 +817,12 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
 	require.True(endpoint.Chain.TB, found)
 
+	counterpartyUpgradeSequence := endpoint.Counterparty.GetChannel().UpgradeSequence
+
 	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnClose(
 		packet, nextSeqRecv,
 		proof, proofClosed, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+		counterpartyUpgradeSequence,
 	)
 
 	return endpoint.Chain.sendMsgs(timeoutOnCloseMsg)
@@ -0,0 +1,57 @@
+package mock
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ clienttypes.ConsensusHost = (*ConsensusHost)(nil)
+
+// ConsensusHost is a mock implementation of the 02-client ConsensusHost interface. The callbacks of the
+// consensus host may be overridden, otherwise the calls are forwarded to the wrapped consensus host.
+type ConsensusHost struct {
+	ConsensusHost clienttypes.ConsensusHost
+
+	GetSelfConsensusStateFn func(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error)
+
+	ValidateSelfClientFn func(ctx sdk.Context, clientState exported.ClientState) error
+}
+
+// NewConsensusHost creates a new mock consensus host wrapping the given consensus host.
+func NewConsensusHost(consensusHost clienttypes.ConsensusHost) *ConsensusHost {
+	return &ConsensusHost{
+		ConsensusHost: consensusHost,
+	}
+}
+
+// GetSelfConsensusState implements the ConsensusHost interface. If no callback is set and no consensus host
+// is wrapped, an error is returned.
+func (ch *ConsensusHost) GetSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error) {
+	if ch.GetSelfConsensusStateFn != nil {
+		return ch.GetSelfConsensusStateFn(ctx, height)
+	}
+
+	if ch.ConsensusHost == nil {
+		return nil, errorsmod.Wrapf(clienttypes.ErrSelfConsensusStateNotFound, "mock consensus host has no callback or wrapped consensus host for height %s", height)
+	}
+
+	return ch.ConsensusHost.GetSelfConsensusState(ctx, height)
+}
+
+// ValidateSelfClient implements the ConsensusHost interface. If no callback is set and no consensus host
+// is wrapped, the client state is considered valid.
+func (ch *ConsensusHost) ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error {
+	if ch.ValidateSelfClientFn != nil {
+		return ch.ValidateSelfClientFn(ctx, clientState)
+	}
+
+	if ch.ConsensusHost == nil {
+		return nil
+	}
+
+	return ch.ConsensusHost.ValidateSelfClient(ctx, clientState)
+}
@@ -30,6 +30,8 @@ const (
 	PortID = ModuleName
 
 	Version = "mock-version"
+
+	UpgradeVersion = "mock-version-v2"
 )
 
 var (
@@ -41,9 +43,13 @@ var (
 	MockRecvCanaryCapabilityName    = "mock receive canary capability name"
 	MockAckCanaryCapabilityName     = "mock acknowledgement canary capability name"
 	MockTimeoutCanaryCapabilityName = "mock timeout canary capability name"
+	MockApplicationCallbackError    = fmt.Errorf("mock application callback failed")
 )
 
-var _ porttypes.IBCModule = IBCModule{}
+var (
+	_ porttypes.IBCModule        = IBCModule{}
+	_ porttypes.UpgradableModule = IBCModule{}
+)
 
 // Expected Interface
 // PortKeeper defines the expected IBC port keeper
@@ -0,0 +1,359 @@
+package ibctesting
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v7/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// MultihopPath contains two multi-hop endpoints representing two chains connected over a
+// multi-hop channel. Each pair of consecutive chains is connected by a single-hop Path,
+// Paths[i] connecting the i-th and (i+1)-th chains.
+type MultihopPath struct {
+	EndpointA *MultihopEndpoint
+	EndpointB *MultihopEndpoint
+	Paths     []*Path
+}
+
+// MultihopEndpoint represents one end of a multi-hop channel. Hops contains the single-hop
+// endpoints along the channel, ordered from the chain of this endpoint towards the counterparty
+// chain. The connection hops of the channel are the connections of these endpoints.
+type MultihopEndpoint struct {
+	Chain        *TestChain
+	Counterparty *MultihopEndpoint
+	ChannelID    string
+	Hops         []*Endpoint
+
+	ChannelConfig *ChannelConfig
+}
+
+// NewMultihopPath constructs a single-hop path between each pair of consecutive chains and a
+// multi-hop endpoint on the first and last chain using the default values for the endpoints.
+// At least three chains must be provided.
+func NewMultihopPath(chains ...*TestChain) *MultihopPath {
+	if len(chains) < 3 {
+		panic(fmt.Errorf("multi-hop paths require at least 3 chains, got %d", len(chains)))
+	}
+
+	paths := make([]*Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = NewPath(chains[i], chains[i+1])
+	}
+
+	hopsA := make([]*Endpoint, len(paths))
+	hopsB := make([]*Endpoint, len(paths))
+	for i, path := range paths {
+		hopsA[i] = path.EndpointA
+		hopsB[len(paths)-1-i] = path.EndpointB
+	}
+
+	endpointA := &MultihopEndpoint{Chain: chains[0], Hops: hopsA, ChannelConfig: NewChannelConfig()}
+	endpointB := &MultihopEndpoint{Chain: chains[len(chains)-1], Hops: hopsB, ChannelConfig: NewChannelConfig()}
+
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+
+	return &MultihopPath{
+		EndpointA: endpointA,
+		EndpointB: endpointB,
+		Paths:     paths,
+	}
+}
+
+// SetChannelOrdered sets the channel order for both endpoints to ORDERED.
+func (path *MultihopPath) SetChannelOrdered() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+}
+
+// RelayPacket relays the packet sent on EndpointA to EndpointB and acknowledges it on EndpointA.
+// An error is returned if a relay step fails.
+func (path *MultihopPath) RelayPacket(packet channeltypes.Packet) error {
+	res, err := path.EndpointB.RecvPacket(packet)
+	if err != nil {
+		return err
+	}
+
+	ack, err := ParseAckFromEvents(res.GetEvents())
+	if err != nil {
+		return err
+	}
+
+	return path.EndpointA.AcknowledgePacket(packet, ack)
+}
+
+// ConnectionHops returns the connection hops of the multi-hop channel on the chain of the endpoint.
+func (endpoint *MultihopEndpoint) ConnectionHops() []string {
+	connectionHops := make([]string, len(endpoint.Hops))
+	for i, hop := range endpoint.Hops {
+		connectionHops[i] = hop.ConnectionID
+	}
+
+	return connectionHops
+}
+
+// QueryMultihopProof queries a proof of the provided key on the counterparty chain along with the
+// connection and consensus state proofs of each intermediate chain. The clients along the connection
+// hops are updated so that the proofs can be verified by the chain of the endpoint. The proto encoded
+// multi-hop proofs are returned along with the height of the client of the first connection hop at
+// which they are verified.
+func (endpoint *MultihopEndpoint) QueryMultihopProof(key []byte) ([]byte, clienttypes.Height) {
+	numIntermediateChains := len(endpoint.Hops) - 1
+
+	// NOTE: updating a client commits a block on its counterparty chain, thus each client
+	// must be updated before querying the proofs it is used to verify
+	err := endpoint.Hops[numIntermediateChains].UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	keyProof, height := queryMultihopProof(endpoint.Counterparty.Chain, key)
+
+	proofs := multihoptypes.MultihopProofs{
+		KeyProof:         keyProof,
+		ConnectionProofs: make([]multihoptypes.MultihopProof, numIntermediateChains),
+		ConsensusProofs:  make([]multihoptypes.MultihopProof, numIntermediateChains),
+	}
+
+	// prove the state of each intermediate chain, starting from the chain adjacent to the counterparty
+	for i := numIntermediateChains; i > 0; i-- {
+		hop := endpoint.Hops[i]
+
+		err := endpoint.Hops[i-1].UpdateClient()
+		require.NoError(endpoint.Chain.TB, err)
+
+		proofs.ConsensusProofs[i-1], _ = queryMultihopProof(hop.Chain, host.FullConsensusStateKey(hop.ClientID, height))
+		proofs.ConnectionProofs[i-1], height = queryMultihopProof(hop.Chain, host.ConnectionKey(hop.ConnectionID))
+	}
+
+	bz, err := endpoint.Chain.Codec.Marshal(&proofs)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return bz, height
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenInit() error {
+	msg := channeltypes.NewMsgChannelOpenInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+	require.NoError(endpoint.Chain.TB, err)
+
+	// update version to selected app version
+	// NOTE: this update must be performed after SendMsgs()
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenTry() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.ChannelID == "" {
+		endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+		require.NoError(endpoint.Chain.TB, err)
+	}
+
+	// update version to selected app version
+	// NOTE: this update must be performed after the endpoint channelID is set
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenAck() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanCloseInit will construct and execute a MsgChannelCloseInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanCloseInit() error {
+	msg := channeltypes.NewMsgChannelCloseInit(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanCloseConfirm will construct and execute a MsgChannelCloseConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanCloseConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelCloseConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.GetChannel().UpgradeSequence,
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SendPacket sends a packet through the channel keeper using the associated endpoint.
+// The packet sequence generated for the packet to be sent is returned. An error
+// is returned if one occurs.
+func (endpoint *MultihopEndpoint) SendPacket(
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	channelCap := endpoint.Chain.GetChannelCapability(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+
+	// no need to send message, acting as a module
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return sequence, nil
+}
+
+// RecvPacket receives a packet on the associated endpoint and the result of the transaction is returned.
+func (endpoint *MultihopEndpoint) RecvPacket(packet channeltypes.Packet) (*sdk.Result, error) {
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, height := endpoint.QueryMultihopProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, height, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.SendMsgs(recvMsg)
+}
+
+// AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, height := endpoint.QueryMultihopProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, height, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	// get proof for timeout based on channel order
+	var packetKey []byte
+
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+
+	proof, height := endpoint.QueryMultihopProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	require.True(endpoint.Chain.TB, found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv,
+		proof, height, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
+
+// GetChannel retrieves an IBC Channel for the endpoint. The channel
+// is expected to exist otherwise testing will fail.
+func (endpoint *MultihopEndpoint) GetChannel() channeltypes.Channel {
+	channel, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	return channel
+}
+
+// queryMultihopProof queries a proof of the provided key in the IBC store of the chain along with the stored value.
+// The height at which the proof will succeed on a tendermint verifier is returned.
+func queryMultihopProof(chain *TestChain, key []byte) (multihoptypes.MultihopProof, clienttypes.Height) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", exported.StoreKey),
+		Height: chain.App.LastBlockHeight() - 1,
+		Data:   key,
+		Prove:  true,
+	})
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(chain.TB, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	require.NoError(chain.TB, err)
+
+	prefixedKey, err := commitmenttypes.ApplyPrefix(chain.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+	require.NoError(chain.TB, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree. Tendermint and subsequently the clients that rely on it
+	// have heights 1 above the IAVL tree. Thus we return proof height + 1
+	return multihoptypes.MultihopProof{
+		Proof:       proof,
+		Value:       res.Value,
+		PrefixedKey: prefixedKey,
+	}, clienttypes.NewHeight(revision, uint64(res.Height)+1)
+}
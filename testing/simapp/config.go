@@ -9,6 +9,7 @@ import (
 // List of available flags for the simulator
 var (
 	FlagGenesisFileValue        string
+	FlagDBBackendValue          string
 	FlagParamsFileValue         string
 	FlagExportParamsPathValue   string
 	FlagExportParamsHeightValue int
@@ -33,6 +34,7 @@ var (
 func GetSimulatorFlags() {
 	// config fields
 	flag.StringVar(&FlagGenesisFileValue, "Genesis", "", "custom simulation genesis file; cannot be used with params file")
+	flag.StringVar(&FlagDBBackendValue, "DBBackend", "goleveldb", "custom database backend type")
 	flag.StringVar(&FlagParamsFileValue, "Params", "", "custom simulation params file which overrides any random params; cannot be used with genesis")
 	flag.StringVar(&FlagExportParamsPathValue, "ExportParamsPath", "", "custom file path to save the exported params JSON")
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
@@ -59,6 +61,7 @@ func NewConfigFromFlags() simulation.Config {
 	return simulation.Config{
 		GenesisFile:        FlagGenesisFileValue,
 		ParamsFile:         FlagParamsFileValue,
+		DBBackend:          FlagDBBackendValue,
 		ExportParamsPath:   FlagExportParamsPathValue,
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
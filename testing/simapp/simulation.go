@@ -0,0 +1,30 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// simulationModule overrides the weighted operations of an app module registered in the simulation manager.
+// The ibc app modules are constructed without the account and bank keepers which their simulation operations
+// require, so the operations are built by the app instead.
+type simulationModule struct {
+	module.AppModuleSimulation
+
+	weightedOperations func(simState module.SimulationState) []simtypes.WeightedOperation
+}
+
+// newSimulationModule returns the app module with the provided weighted operations.
+func newSimulationModule(
+	am module.AppModuleSimulation, weightedOperations func(simState module.SimulationState) []simtypes.WeightedOperation,
+) simulationModule {
+	return simulationModule{
+		AppModuleSimulation: am,
+		weightedOperations:  weightedOperations,
+	}
+}
+
+// WeightedOperations implements the AppModuleSimulation interface.
+func (sm simulationModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return sm.weightedOperations(simState)
+}
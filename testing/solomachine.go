@@ -359,6 +359,7 @@ func (solo *Solomachine) ChanCloseConfirm(chain *TestChain, portID, channelID st
 		proofInit,
 		clienttypes.ZeroHeight(),
 		chain.SenderAccount.GetAddress().String(),
+		0,
 	)
 
 	res, err := chain.SendMsgs(msgChanCloseConfirm)
@@ -450,6 +451,7 @@ func (solo *Solomachine) TimeoutPacketOnClose(chain *TestChain, packet channelty
 		proofClosed,
 		clienttypes.ZeroHeight(),
 		chain.SenderAccount.GetAddress().String(),
+		0,
 	)
 
 	res, err := chain.SendMsgs(msgTimeout)
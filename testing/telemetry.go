@@ -0,0 +1,47 @@
+package ibctesting
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// TelemetryServiceName is the service name prefixed to the metrics collected by SetupInmemTelemetry.
+const TelemetryServiceName = "ibc-testing"
+
+// SetupInmemTelemetry replaces the global metrics with an in-memory sink, which is returned to inspect the
+// metrics emitted through the telemetry package.
+func SetupInmemTelemetry(tb testing.TB) *metrics.InmemSink {
+	tb.Helper()
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+
+	cfg := metrics.DefaultConfig(TelemetryServiceName)
+	cfg.EnableRuntimeMetrics = false
+
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(tb, err)
+
+	return sink
+}
+
+// GetTelemetryCounter returns the value of the counter with the given keys and labels collected by the given sink.
+// Zero is returned if the counter was not incremented.
+func GetTelemetryCounter(sink *metrics.InmemSink, keys []string, labels []metrics.Label) float64 {
+	name := strings.Join(append([]string{TelemetryServiceName}, keys...), ".")
+
+	var value float64
+	for _, interval := range sink.Data() {
+		for _, counter := range interval.Counters {
+			if counter.Name == name && reflect.DeepEqual(counter.Labels, labels) {
+				value += counter.Sum
+			}
+		}
+	}
+
+	return value
+}